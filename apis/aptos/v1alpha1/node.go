@@ -18,6 +18,7 @@ type Peer struct {
 	// ID is peer identifier
 	ID string `json:"id"`
 	// Addresses is array of peer multiaddress
+	// address is either multiaddress, or kotal Aptos node reference in the format of name or name.namespace
 	// +listType=set
 	// +kubebuilder:validation:MinItems=1
 	Addresses []string `json:"addresses"`
@@ -54,9 +55,14 @@ type NodeSpec struct {
 
 // NodeStatus defines the observed state of Node
 type NodeStatus struct {
+	// PeerAddress is the node peer multiaddress
+	PeerAddress string `json:"peerAddress,omitempty"`
+	// UnresolvedReferences is seed peers addresses references that couldn't be resolved
+	UnresolvedReferences []string `json:"unresolvedReferences,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // Node is the Schema for the nodes API
 type Node struct {
@@ -16,6 +16,8 @@ const (
 	DefaultGethImage = "kotalco/geth:v1.10.26"
 	// DefaultNethermindImage is nethermind image
 	DefaultNethermindImage = "kotalco/nethermind:v1.14.5"
+	// DefaultTesseraImage is tessera transaction manager image
+	DefaultTesseraImage = "quorumengineering/tessera:22.1.7"
 )
 
 // Node defaults
@@ -38,6 +40,28 @@ const (
 	DefaultGraphQLPort uint = 8547
 )
 
+// Network defaults
+const (
+	// DefaultNetworkClient is the default client of private network nodes
+	DefaultNetworkClient = BesuClient
+)
+
+// Transaction manager defaults
+const (
+	// DefaultTransactionManagerClient is the default transaction manager client
+	DefaultTransactionManagerClient = TesseraTransactionManager
+	// DefaultTransactionManagerCPURequest is the cpu requested by transaction manager
+	DefaultTransactionManagerCPURequest = "1"
+	// DefaultTransactionManagerCPULimit is the cpu limit for transaction manager
+	DefaultTransactionManagerCPULimit = "2"
+	// DefaultTransactionManagerMemoryRequest is the memory requested by transaction manager
+	DefaultTransactionManagerMemoryRequest = "1Gi"
+	// DefaultTransactionManagerMemoryLimit is the memory limit for transaction manager
+	DefaultTransactionManagerMemoryLimit = "2Gi"
+	// DefaultTransactionManagerStorageRequest is the storage requested by transaction manager
+	DefaultTransactionManagerStorageRequest = "1Gi"
+)
+
 // Genesis block defaults
 const (
 	// DefaultCoinbase is the default coinbase
@@ -54,6 +78,18 @@ const (
 	DefaultTimestamp = HexString("0x0")
 )
 
+// Development chain defaults
+const (
+	// DefaultDevAccounts is the default number of development chain pre-funded accounts
+	DefaultDevAccounts uint = 10
+	// DefaultDevBalance is the default development chain accounts balance (10000 ether)
+	DefaultDevBalance = HexString("0x21e19e0c9bab2400000")
+	// DefaultDevMnemonic is well known test mnemonic used if no mnemonic is provided
+	DefaultDevMnemonic = "test test test test test test test test test test test junk"
+	// DefaultDevChainID is development chain id and network id
+	DefaultDevChainID uint = 1337
+)
+
 // Ethash engine defaults
 const (
 	// DefaultEthashFixedDifficulty is the default ethash fixed difficulty
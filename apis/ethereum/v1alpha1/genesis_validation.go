@@ -20,7 +20,11 @@ var (
 		6:        KottiNetwork,
 		61:       ClassicNetwork,
 		63:       MordorNetwork,
+		100:      XDaiNetwork,
 		2018:     DevNetwork,
+		10200:    ChiadoNetwork,
+		17000:    HoleskyNetwork,
+		560048:   HoodiNetwork,
 		11155111: SepoliaNetwork,
 	}
 )
@@ -108,7 +112,7 @@ func (g *Genesis) ValidateForksOrder() field.ErrorList {
 		"homestead",
 		"eip150",
 		"eip155",
-		"eip155",
+		"eip158",
 		"byzantium",
 		"constantinople",
 		"petersburg",
@@ -124,7 +128,7 @@ func (g *Genesis) ValidateForksOrder() field.ErrorList {
 		forks.Homestead,
 		forks.EIP150,
 		forks.EIP155,
-		forks.EIP155,
+		forks.EIP158,
 		forks.Byzantium,
 		forks.Constantinople,
 		forks.Petersburg,
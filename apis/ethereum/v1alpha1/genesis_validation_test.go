@@ -51,6 +51,27 @@ var _ = Describe("Genesis Block validation", func() {
 				},
 			},
 		},
+		{
+			Title: "bad state trie clearing fork activation order",
+			Genesis: &Genesis{
+				ChainID:   55555,
+				NetworkID: 55555,
+				Ethash:    &Ethash{},
+				Forks: &Forks{
+					EIP155:    2,
+					EIP158:    1,
+					Byzantium: 2,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis.forks.eip158",
+					BadValue: "1",
+					Detail:   "Fork eip158 can't be activated (at block 1) before fork eip155 (at block 2)",
+				},
+			},
+		},
 		{
 			Title: "consensus configuration is missing",
 			Genesis: &Genesis{
@@ -1,5 +1,7 @@
 package v1alpha1
 
+import "fmt"
+
 const (
 	// MainNetwork is ethereum main network
 	MainNetwork = "mainnet"
@@ -11,8 +13,14 @@ const (
 	GoerliNetwork = "goerli"
 	// SepoliaNetwork is sepolia pos network
 	SepoliaNetwork = "sepolia"
+	// HoleskyNetwork is holesky pos staking and infrastructure test network
+	HoleskyNetwork = "holesky"
+	// HoodiNetwork is hoodi pos validators test network
+	HoodiNetwork = "hoodi"
 	// XDaiNetwork is xdai pos network
 	XDaiNetwork = "xdai"
+	// ChiadoNetwork is chiado pos gnosis chain test network
+	ChiadoNetwork = "chiado"
 	// KottiNetwork is kotti poa ethereum classic test network
 	KottiNetwork = "kotti"
 	// ClassicNetwork is ethereum classic network
@@ -23,6 +31,19 @@ const (
 	DevNetwork = "dev"
 )
 
+// DeprecatedNetworks is retired networks that are no longer running
+var DeprecatedNetworks = map[string]bool{
+	RopstenNetwork: true,
+	RinkebyNetwork: true,
+	GoerliNetwork:  true,
+	KottiNetwork:   true,
+}
+
+// DeprecatedNetworkWarning returns warning of joining retired network
+func DeprecatedNetworkWarning(network string) string {
+	return fmt.Sprintf("network %s is deprecated and no longer running, use %s or %s test networks", network, SepoliaNetwork, HoodiNetwork)
+}
+
 // HexString is String in hexadecial format
 // +kubebuilder:validation:Pattern="^0[xX][0-9a-fA-F]+$"
 type HexString string
@@ -0,0 +1,43 @@
+package v1alpha1
+
+import "sigs.k8s.io/controller-runtime/pkg/webhook"
+
+// +kubebuilder:webhook:path=/mutate-ethereum-kotal-io-v1alpha1-network,mutating=true,failurePolicy=fail,groups=ethereum.kotal.io,resources=networks,verbs=create;update,versions=v1alpha1,name=mutate-ethereum-v1alpha1-network.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Network{}
+
+// DefaultResources defaults network nodes resources
+func (r *Network) DefaultResources() {
+	if r.Spec.Resources.CPU == "" {
+		r.Spec.Resources.CPU = DefaultPrivateNetworkNodeCPURequest
+	}
+
+	if r.Spec.Resources.CPULimit == "" {
+		r.Spec.Resources.CPULimit = DefaultPrivateNetworkNodeCPULimit
+	}
+
+	if r.Spec.Resources.Memory == "" {
+		r.Spec.Resources.Memory = DefaultPrivateNetworkNodeMemoryRequest
+	}
+
+	if r.Spec.Resources.MemoryLimit == "" {
+		r.Spec.Resources.MemoryLimit = DefaultPrivateNetworkNodeMemoryLimit
+	}
+
+	if r.Spec.Resources.Storage == "" {
+		r.Spec.Resources.Storage = DefaultPrivateNetworkNodeStorageRequest
+	}
+}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Network) Default() {
+	networklog.Info("default", "name", r.Name)
+
+	if len(r.Spec.Clients) == 0 {
+		r.Spec.Clients = []EthereumClient{DefaultNetworkClient}
+	}
+
+	r.Spec.Genesis.Default()
+
+	r.DefaultResources()
+}
@@ -0,0 +1,58 @@
+package v1alpha1
+
+import (
+	"github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Ethereum network defaulting", func() {
+	It("Should default network", func() {
+		network := Network{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: NetworkSpec{
+				Consensus:  CliqueConsensus,
+				Validators: 3,
+				Genesis: Genesis{
+					ChainID:   55555,
+					NetworkID: 55555,
+				},
+			},
+		}
+
+		network.Default()
+
+		Expect(network.Spec.Clients).To(ConsistOf(DefaultNetworkClient))
+		Expect(network.Spec.Genesis.Coinbase).To(Equal(DefaultCoinbase))
+		Expect(network.Spec.Genesis.GasLimit).To(Equal(DefaultGasLimit))
+		Expect(network.Spec.Resources.CPU).To(Equal(DefaultPrivateNetworkNodeCPURequest))
+		Expect(network.Spec.Resources.CPULimit).To(Equal(DefaultPrivateNetworkNodeCPULimit))
+		Expect(network.Spec.Resources.Memory).To(Equal(DefaultPrivateNetworkNodeMemoryRequest))
+		Expect(network.Spec.Resources.MemoryLimit).To(Equal(DefaultPrivateNetworkNodeMemoryLimit))
+		Expect(network.Spec.Resources.Storage).To(Equal(DefaultPrivateNetworkNodeStorageRequest))
+	})
+
+	It("Should compute node genesis", func() {
+		network := Network{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: NetworkSpec{
+				Consensus:  IBFT2Consensus,
+				Validators: 1,
+				Genesis: Genesis{
+					ChainID:   55555,
+					NetworkID: 55555,
+				},
+			},
+		}
+
+		validators := []shared.EthereumAddress{"0x427e2c7cecd72bc4cdd4f7ebb8bb6e49789c8044"}
+		genesis := network.NodeGenesis(validators)
+
+		Expect(genesis.Clique).To(BeNil())
+		Expect(genesis.IBFT2.Validators).To(Equal(validators))
+		Expect(genesis.IBFT2.BlockPeriod).To(Equal(DefaultIBFT2BlockPeriod))
+		// network genesis is not modified
+		Expect(network.Spec.Genesis.IBFT2).To(BeNil())
+	})
+})
@@ -0,0 +1,103 @@
+package v1alpha1
+
+import (
+	"github.com/kotalco/kotal/apis/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkSpec defines the desired state of Network
+type NetworkSpec struct {
+	// Consensus is network consensus algorithm
+	Consensus NetworkConsensus `json:"consensus"`
+
+	// Validators is number of signers or validators nodes
+	// validators added or removed after genesis are voted on by existing validators
+	// removed validators nodes are deleted one at a time, once their removal has been applied on chain
+	// +kubebuilder:validation:Minimum=1
+	Validators int `json:"validators"`
+
+	// Clients is ethereum clients assigned to validator nodes in round robin order
+	Clients []EthereumClient `json:"clients,omitempty"`
+
+	// Genesis is genesis block shared by all network nodes
+	// clique signers or ibft2 validators are computed from generated validators keys
+	Genesis Genesis `json:"genesis"`
+
+	// Resources is compute and storage resources of each network node
+	shared.Resources `json:"resources,omitempty"`
+}
+
+// NetworkConsensus is private network consensus algorithm
+// +kubebuilder:validation:Enum=clique;ibft2
+type NetworkConsensus string
+
+const (
+	// CliqueConsensus is clique proof of authority consensus
+	CliqueConsensus NetworkConsensus = "clique"
+	// IBFT2Consensus is istanbul byzantine fault tolerant proof of authority consensus
+	IBFT2Consensus NetworkConsensus = "ibft2"
+)
+
+// NodeGenesis returns genesis block used by network nodes with the given signers or validators
+func (n *Network) NodeGenesis(validators []shared.EthereumAddress) *Genesis {
+	genesis := n.Spec.Genesis.DeepCopy()
+
+	switch n.Spec.Consensus {
+	case CliqueConsensus:
+		if genesis.Clique == nil {
+			genesis.Clique = &Clique{}
+		}
+		genesis.Clique.Signers = validators
+	case IBFT2Consensus:
+		if genesis.IBFT2 == nil {
+			genesis.IBFT2 = &IBFT2{}
+		}
+		genesis.IBFT2.Validators = validators
+	}
+
+	genesis.Default()
+
+	return genesis
+}
+
+// NetworkStatus defines the observed state of Network
+type NetworkStatus struct {
+	// Consensus is network consensus algorithm
+	Consensus string `json:"consensus,omitempty"`
+	// GenesisValidators is number of signers or validators at genesis block
+	GenesisValidators int `json:"genesisValidators,omitempty"`
+	// Validators is validator nodes addresses
+	Validators []string `json:"validators,omitempty"`
+	// Nodes is number of network nodes
+	Nodes int `json:"nodes,omitempty"`
+	// ReadyNodes is number of network nodes with ready pods
+	ReadyNodes int `json:"readyNodes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Network is the Schema for the networks API
+// +kubebuilder:printcolumn:name="Consensus",type=string,JSONPath=".spec.consensus"
+// +kubebuilder:printcolumn:name="Validators",type=integer,JSONPath=".spec.validators"
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=".status.readyNodes"
+type Network struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkSpec   `json:"spec,omitempty"`
+	Status NetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkList contains a list of Network
+type NetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Network `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Network{}, &NetworkList{})
+}
@@ -0,0 +1,150 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ethereum-kotal-io-v1alpha1-network,mutating=false,failurePolicy=fail,groups=ethereum.kotal.io,resources=networks,versions=v1alpha1,name=validate-ethereum-v1alpha1-network.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Network{}
+
+// validate validates network
+func (r *Network) validate() field.ErrorList {
+	var networkErrors field.ErrorList
+
+	path := field.NewPath("spec")
+	genesisPath := path.Child("genesis")
+	genesis := r.Spec.Genesis
+
+	// validate at least one validator node
+	if r.Spec.Validators < 1 {
+		err := field.Invalid(path.Child("validators"), r.Spec.Validators, "must be at least 1")
+		networkErrors = append(networkErrors, err)
+	}
+
+	// validate clients support network consensus
+	if r.Spec.Consensus == IBFT2Consensus {
+		for i, client := range r.Spec.Clients {
+			if client != BesuClient {
+				err := field.Invalid(path.Child("clients").Index(i), client, "client doesn't support ibft2 consensus")
+				networkErrors = append(networkErrors, err)
+			}
+		}
+	}
+
+	// validate genesis doesn't enable other consensus configuration
+	if genesis.Ethash != nil {
+		err := field.Invalid(genesisPath.Child("ethash"), "", fmt.Sprintf("must be none if consensus is %s", r.Spec.Consensus))
+		networkErrors = append(networkErrors, err)
+	}
+
+	if genesis.Clique != nil && r.Spec.Consensus != CliqueConsensus {
+		err := field.Invalid(genesisPath.Child("clique"), "", fmt.Sprintf("must be none if consensus is %s", r.Spec.Consensus))
+		networkErrors = append(networkErrors, err)
+	}
+
+	if genesis.IBFT2 != nil && r.Spec.Consensus != IBFT2Consensus {
+		err := field.Invalid(genesisPath.Child("ibft2"), "", fmt.Sprintf("must be none if consensus is %s", r.Spec.Consensus))
+		networkErrors = append(networkErrors, err)
+	}
+
+	// validate signers and validators are not provided, they're computed from generated keys
+	if genesis.Clique != nil && len(genesis.Clique.Signers) != 0 {
+		err := field.Invalid(genesisPath.Child("clique").Child("signers"), "", "must be none, signers are generated")
+		networkErrors = append(networkErrors, err)
+	}
+
+	if genesis.IBFT2 != nil && len(genesis.IBFT2.Validators) != 0 {
+		err := field.Invalid(genesisPath.Child("ibft2").Child("validators"), "", "must be none, validators are generated")
+		networkErrors = append(networkErrors, err)
+	}
+
+	return networkErrors
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Network) ValidateCreate() error {
+	var allErrors field.ErrorList
+
+	networklog.Info("validate create", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.Spec.Resources.ValidateCreate()...)
+
+	// validate genesis block used by network nodes
+	allErrors = append(allErrors, r.NodeGenesis(nil).ValidateCreate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Network) ValidateUpdate(old runtime.Object) error {
+	var allErrors field.ErrorList
+	oldNetwork := old.(*Network)
+
+	networklog.Info("validate update", "name", r.Name)
+
+	if oldNetwork.Spec.Consensus != r.Spec.Consensus {
+		err := field.Invalid(field.NewPath("spec").Child("consensus"), r.Spec.Consensus, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	// node clients are immutable, changing clients would change existing nodes clients
+	if !reflect.DeepEqual(oldNetwork.Spec.Clients, r.Spec.Clients) {
+		err := field.Invalid(field.NewPath("spec").Child("clients"), "", "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	// validators are added or removed after genesis by besu nodes votes only
+	// geth and nethermind nodes can't enable rpc while importing signer account
+	if oldNetwork.Spec.Validators != r.Spec.Validators && !r.hasVoter(oldNetwork.Spec.Validators) {
+		err := field.Invalid(field.NewPath("spec").Child("validators"), r.Spec.Validators, "can't be changed, network has no besu validator nodes to vote on validators")
+		allErrors = append(allErrors, err)
+	}
+
+	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.Spec.Resources.ValidateUpdate(&oldNetwork.Spec.Resources)...)
+
+	// validate genesis block used by network nodes
+	allErrors = append(allErrors, r.NodeGenesis(nil).ValidateUpdate(oldNetwork.NodeGenesis(nil))...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// hasVoter returns true if any of the first n network nodes is besu node
+func (r *Network) hasVoter(n int) bool {
+	if len(r.Spec.Clients) == 0 {
+		return false
+	}
+	if n > r.Spec.Validators {
+		n = r.Spec.Validators
+	}
+	for i := 0; i < n; i++ {
+		if r.Spec.Clients[i%len(r.Spec.Clients)] == BesuClient {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Network) ValidateDelete() error {
+	networklog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Ethereum network validation", func() {
+	genesis := Genesis{
+		ChainID:   55555,
+		NetworkID: 55555,
+	}
+
+	createCases := []struct {
+		Title   string
+		Network *Network
+		Errors  field.ErrorList
+	}{
+		{
+			Title: "network #1",
+			Network: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-1",
+				},
+				Spec: NetworkSpec{
+					Consensus:  IBFT2Consensus,
+					Validators: 2,
+					Clients:    []EthereumClient{BesuClient, GethClient},
+					Genesis:    genesis,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.clients[1]",
+					BadValue: GethClient,
+					Detail:   "client doesn't support ibft2 consensus",
+				},
+			},
+		},
+		{
+			Title: "network #2",
+			Network: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-2",
+				},
+				Spec: NetworkSpec{
+					Consensus:  CliqueConsensus,
+					Validators: 2,
+					Genesis: Genesis{
+						ChainID:   55555,
+						NetworkID: 55555,
+						IBFT2:     &IBFT2{},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis.ibft2",
+					BadValue: "",
+					Detail:   "must be none if consensus is clique",
+				},
+			},
+		},
+		{
+			Title: "network #3",
+			Network: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-3",
+				},
+				Spec: NetworkSpec{
+					Consensus:  CliqueConsensus,
+					Validators: 2,
+					Genesis: Genesis{
+						ChainID:   55555,
+						NetworkID: 55555,
+						Clique: &Clique{
+							Signers: []shared.EthereumAddress{"0xcF2C3fB8F36A863FD1A8c72E2473f81744B4CA6C"},
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis.clique.signers",
+					BadValue: "",
+					Detail:   "must be none, signers are generated",
+				},
+			},
+		},
+		{
+			Title: "network #4",
+			Network: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-4",
+				},
+				Spec: NetworkSpec{
+					Consensus:  IBFT2Consensus,
+					Validators: 0,
+					Genesis: Genesis{
+						ChainID:   5,
+						NetworkID: 55555,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.validators",
+					BadValue: 0,
+					Detail:   "must be at least 1",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis.chainId",
+					BadValue: "5",
+					Detail:   "can't use chain id of goerli network to avoid tx replay",
+				},
+			},
+		},
+	}
+
+	updateCases := []struct {
+		Title      string
+		OldNetwork *Network
+		NewNetwork *Network
+		Errors     field.ErrorList
+	}{
+		{
+			Title: "network #1",
+			OldNetwork: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-1",
+				},
+				Spec: NetworkSpec{
+					Consensus:  CliqueConsensus,
+					Validators: 2,
+					Genesis:    genesis,
+				},
+			},
+			NewNetwork: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-1",
+				},
+				Spec: NetworkSpec{
+					Consensus:  IBFT2Consensus,
+					Validators: 2,
+					Genesis:    genesis,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.consensus",
+					BadValue: IBFT2Consensus,
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "network #2",
+			OldNetwork: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-2",
+				},
+				Spec: NetworkSpec{
+					Consensus:  CliqueConsensus,
+					Validators: 2,
+					Clients:    []EthereumClient{BesuClient},
+					Genesis:    genesis,
+				},
+			},
+			NewNetwork: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-2",
+				},
+				Spec: NetworkSpec{
+					Consensus:  CliqueConsensus,
+					Validators: 3,
+					Clients:    []EthereumClient{BesuClient, GethClient},
+					Genesis: Genesis{
+						ChainID:   55555,
+						NetworkID: 55555,
+						GasLimit:  "0x1234",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.clients",
+					BadValue: "",
+					Detail:   "field is immutable",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis.gasLimit",
+					BadValue: HexString("0x1234"),
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "network #3",
+			OldNetwork: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-3",
+				},
+				Spec: NetworkSpec{
+					Consensus:  CliqueConsensus,
+					Validators: 2,
+					Clients:    []EthereumClient{GethClient, NethermindClient},
+					Genesis:    genesis,
+				},
+			},
+			NewNetwork: &Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "network-3",
+				},
+				Spec: NetworkSpec{
+					Consensus:  CliqueConsensus,
+					Validators: 3,
+					Clients:    []EthereumClient{GethClient, NethermindClient},
+					Genesis:    genesis,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.validators",
+					BadValue: 3,
+					Detail:   "can't be changed, network has no besu validator nodes to vote on validators",
+				},
+			},
+		},
+	}
+
+	Context("While creating network", func() {
+		for _, c := range createCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.Network.Default()
+					err := cc.Network.ValidateCreate()
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+
+	Context("While updating network", func() {
+		for _, c := range updateCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.OldNetwork.Default()
+					cc.NewNetwork.Default()
+					err := cc.NewNetwork.ValidateUpdate(cc.OldNetwork)
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+})
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"github.com/kotalco/kotal/apis/shared"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -13,6 +14,20 @@ type NodeStatus struct {
 	Network string `json:"network,omitempty"`
 	// EnodeURL is the node URL
 	EnodeURL string `json:"enodeURL,omitempty"`
+	// UnresolvedReferences is static nodes and bootnodes references that couldn't be resolved
+	UnresolvedReferences []string `json:"unresolvedReferences,omitempty"`
+	// JWTSecretName is kubernetes secret name holding engine API JWT secret
+	JWTSecretName string `json:"jwtSecretName,omitempty"`
+	// BeaconNodes is Ethereum beacon nodes using this node as execution engine
+	BeaconNodes []string `json:"beaconNodes,omitempty"`
+	// GenesisHash is genesis block hash computed from genesis spec
+	GenesisHash string `json:"genesisHash,omitempty"`
+	// GenesisConfigMapName is kubernetes configmap name holding rendered genesis and its hash
+	GenesisConfigMapName string `json:"genesisConfigMapName,omitempty"`
+	// GenesisMismatch is static nodes and bootnodes references reporting different genesis hash
+	GenesisMismatch []string `json:"genesisMismatch,omitempty"`
+	// DevAccounts is development chain pre-funded accounts addresses
+	DevAccounts []string `json:"devAccounts,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -23,6 +38,7 @@ type NodeStatus struct {
 // +kubebuilder:printcolumn:name="Consensus",type=string,JSONPath=".status.consensus"
 // +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".status.network"
 // +kubebuilder:printcolumn:name="enodeURL",type=string,JSONPath=".status.enodeURL",priority=10
+// +kubebuilder:printcolumn:name="Genesis",type=string,JSONPath=".status.genesisHash",priority=10
 type Node struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -52,12 +68,18 @@ type NodeSpec struct {
 	// Network specifies the network to join
 	Network string `json:"network,omitempty"`
 
+	// Dev is single node development chain configuration, used if network is dev
+	Dev *Dev `json:"dev,omitempty"`
+
 	// Client is ethereum client running on the node
 	Client EthereumClient `json:"client"`
 
 	// import is account to import
 	Import *ImportedAccount `json:"import,omitempty"`
 
+	// Imports is additional accounts to import and unlock, like clique signers or faucet accounts
+	Imports []ImportedAccount `json:"imports,omitempty"`
+
 	// Bootnodes is set of ethereum node URLS for p2p discovery bootstrap
 	// +listType=set
 	Bootnodes []Enode `json:"bootnodes,omitempty"`
@@ -72,6 +94,9 @@ type NodeSpec struct {
 	// P2PPort is port used for peer to peer communication
 	P2PPort uint `json:"p2pPort,omitempty"`
 
+	// P2P is peer to peer discovery and address advertisement configuration
+	P2P *P2P `json:"p2p,omitempty"`
+
 	// SyncMode is the node synchronization mode
 	SyncMode SynchronizationMode `json:"syncMode,omitempty"`
 
@@ -100,6 +125,7 @@ type NodeSpec struct {
 	EnginePort uint `json:"enginePort,omitempty"`
 
 	// JWTSecretName is kubernetes secret name holding JWT secret
+	// JWT secret is generated if engine is enabled and no JWT secret name is provided
 	JWTSecretName string `json:"jwtSecretName,omitempty"`
 
 	// RPC is whether HTTP-RPC server is enabled or not
@@ -128,6 +154,25 @@ type NodeSpec struct {
 	// GraphQLPort is the GraphQL server listening port
 	GraphQLPort uint `json:"graphqlPort,omitempty"`
 
+	// Gateway is authenticated gateway in front of JSON-RPC and web socket servers
+	// servers are bound to localhost, and are reachable only through the gateway
+	Gateway *shared.RPCGateway `json:"gateway,omitempty"`
+
+	// Privacy is private transactions configuration
+	Privacy *Privacy `json:"privacy,omitempty"`
+
+	// Performance is database and state storage tuning
+	Performance *Performance `json:"performance,omitempty"`
+
+	// Serve is chain data serving to light clients and syncing peers
+	Serve *Serve `json:"serve,omitempty"`
+
+	// TxPool is transaction pool limits
+	TxPool *TxPool `json:"txpool,omitempty"`
+
+	// Mining is block production settings
+	Mining *Mining `json:"mining,omitempty"`
+
 	// Resources is node compute and storage resources
 	shared.Resources `json:"resources,omitempty"`
 }
@@ -189,7 +234,7 @@ const (
 	PluginsAPI API = "plugins"
 
 	// PrivacyAPI is privacy API
-	PrivacyAPI API = "privacy"
+	PrivacyAPI API = "priv"
 
 	// TransactionPoolAPI is transaction pool API
 	TransactionPoolAPI API = "txpool"
@@ -240,6 +285,186 @@ func (e EthereumClient) SupportsVerbosityLevel(level shared.VerbosityLevel) bool
 	return false
 }
 
+// networkClientVersions is the minimum client versions joining networks launched after client default images
+// networks not listed here aren't validated, they're joined by all clients as before
+var networkClientVersions = map[string]map[EthereumClient]string{
+	HoleskyNetwork: {
+		BesuClient:       "23.7.2",
+		GethClient:       "1.13.0",
+		NethermindClient: "1.21.0",
+	},
+	HoodiNetwork: {
+		BesuClient:       "25.3.0",
+		GethClient:       "1.15.6",
+		NethermindClient: "1.31.0",
+	},
+	ChiadoNetwork: {
+		NethermindClient: "1.15.0",
+	},
+}
+
+// SupportsNetwork returns true if client can join the given public network
+func (e EthereumClient) SupportsNetwork(network string) bool {
+	versions, ok := networkClientVersions[network]
+	if !ok {
+		return true
+	}
+	_, ok = versions[e]
+	return ok
+}
+
+// NetworkMinimumVersion returns minimum client version joining the given public network
+// empty version is returned if network can be joined by any client version
+func (e EthereumClient) NetworkMinimumVersion(network string) string {
+	return networkClientVersions[network][e]
+}
+
+// SupportsDevBlockPeriod returns true if client supports development chain block period
+func (e EthereumClient) SupportsDevBlockPeriod() bool {
+	switch e {
+	case GethClient, NethermindClient:
+		return true
+	}
+	return false
+}
+
+// SupportsDatabaseCache returns true if client supports setting database cache size
+func (e EthereumClient) SupportsDatabaseCache() bool {
+	switch e {
+	case GethClient:
+		return true
+	}
+	return false
+}
+
+// SupportsPruningMode returns true if client supports state pruning mode
+func (e EthereumClient) SupportsPruningMode(mode PruningMode) bool {
+	switch mode {
+	case ArchivePruning, PrunedPruning:
+		return true
+	case FullPruning, HybridPruning:
+		return e == NethermindClient
+	}
+	return false
+}
+
+// SupportsStorageFormat returns true if client supports state storage format
+func (e EthereumClient) SupportsStorageFormat(format StorageFormat) bool {
+	switch e {
+	case BesuClient:
+		return format == BonsaiStorageFormat || format == ForestStorageFormat
+	case GethClient:
+		return format == HashStorageFormat || format == PathStorageFormat
+	}
+	return false
+}
+
+// StorageFormatMinimumVersion returns minimum client version supporting storage format selection
+// empty version is returned if storage format can be selected by any client version
+func (e EthereumClient) StorageFormatMinimumVersion() string {
+	if e == GethClient {
+		return "1.13.0"
+	}
+	return ""
+}
+
+// SnapServingMinimumVersion returns minimum client version supporting snap sync requests serving option
+// empty version is returned if snap sync requests serving option is supported by any client version
+func (e EthereumClient) SnapServingMinimumVersion() string {
+	switch e {
+	case BesuClient:
+		return "25.4.0"
+	case NethermindClient:
+		return "1.26.0"
+	}
+	return ""
+}
+
+// HistoryExpiryMinimumVersion returns minimum client version supporting pre-merge history expiry
+// empty version is returned if history expiry is supported by any client version
+func (e EthereumClient) HistoryExpiryMinimumVersion() string {
+	switch e {
+	case BesuClient:
+		return "25.7.0"
+	case GethClient:
+		return "1.16.0"
+	}
+	return ""
+}
+
+// LightServerMaximumVersion returns the first client version that can't serve light clients
+// empty version is returned if light server isn't removed from any client version
+func (e EthereumClient) LightServerMaximumVersion() string {
+	// geth removed light ethereum subprotocol (LES) server in v1.14.0
+	if e == GethClient {
+		return "1.14.0"
+	}
+	return ""
+}
+
+// DefaultStorageFormat returns storage format used by client image if no storage format is selected
+func (e EthereumClient) DefaultStorageFormat(image string) StorageFormat {
+	switch e {
+	case BesuClient:
+		if shared.ImageVersionAtLeast(image, "23.10.0") {
+			return BonsaiStorageFormat
+		}
+		return ForestStorageFormat
+	case GethClient:
+		if shared.ImageVersionAtLeast(image, "1.14.0") {
+			return PathStorageFormat
+		}
+		return HashStorageFormat
+	}
+	return ""
+}
+
+// SupportsNATMethod returns true if client supports network address translation method
+func (e EthereumClient) SupportsNATMethod(method NATMethod) bool {
+	switch e {
+	case BesuClient:
+		return method == AutoNATMethod || method == NoNATMethod || method == UPnPNATMethod
+	case GethClient:
+		return true
+	case NethermindClient:
+		return method == NoNATMethod || method == UPnPNATMethod
+	}
+	return false
+}
+
+// SupportsExternalHostname returns true if client supports advertising hostname instead of IP address
+func (e EthereumClient) SupportsExternalHostname() bool {
+	return e == BesuClient
+}
+
+// SupportsDiscoveryV5 returns true if client supports node discovery protocol v5
+func (e EthereumClient) SupportsDiscoveryV5() bool {
+	return e == GethClient
+}
+
+// SupportsLightServer returns true if client can serve light clients
+func (e EthereumClient) SupportsLightServer() bool {
+	return e == GethClient
+}
+
+// SupportsTxPoolSenderLimit returns true if client supports limiting pending transactions per sender
+func (e EthereumClient) SupportsTxPoolSenderLimit() bool {
+	switch e {
+	case GethClient, NethermindClient:
+		return true
+	}
+	return false
+}
+
+// SupportsTxPoolLifetime returns true if client supports setting pending transactions lifetime
+func (e EthereumClient) SupportsTxPoolLifetime() bool {
+	switch e {
+	case BesuClient, GethClient:
+		return true
+	}
+	return false
+}
+
 const (
 	// BesuClient is hyperledger besu ethereum client
 	BesuClient EthereumClient = "besu"
@@ -249,14 +474,174 @@ const (
 	NethermindClient EthereumClient = "nethermind"
 )
 
-// ImportedAccount is account derived from private key
+// ImportedAccount is account derived from private key or V3 keystore
 type ImportedAccount struct {
+	// Address is account address, coinbase is used for import account
+	Address shared.EthereumAddress `json:"address,omitempty"`
 	// PrivateKeySecretName is the secret name holding account private key
-	PrivateKeySecretName string `json:"privateKeySecretName"`
+	PrivateKeySecretName string `json:"privateKeySecretName,omitempty"`
+	// KeystoreSecretName is the secret name holding account V3 keystore JSON
+	KeystoreSecretName string `json:"keystoreSecretName,omitempty"`
 	// PasswordSecretName is the secret holding password used to encrypt account private key
 	PasswordSecretName string `json:"passwordSecretName"`
 }
 
+// ImportedAccounts returns coinbase import account followed by additional imported accounts
+func (n *NodeSpec) ImportedAccounts() []ImportedAccount {
+	accounts := []ImportedAccount{}
+
+	if n.Import != nil {
+		account := *n.Import
+		if account.Address == "" {
+			account.Address = n.Coinbase
+		}
+		accounts = append(accounts, account)
+	}
+
+	return append(accounts, n.Imports...)
+}
+
+// Privacy is private transactions configuration
+type Privacy struct {
+	// TransactionManager is the name of kotal transaction manager in the same namespace
+	TransactionManager string `json:"transactionManager"`
+	// MultiTenancy enables multiple tenants to share the node, each with its own privacy public key
+	MultiTenancy bool `json:"multiTenancy,omitempty"`
+	// JWTPublicKeySecretName is k8s secret name holding JWT public key used to authenticate tenants
+	JWTPublicKeySecretName string `json:"jwtPublicKeySecretName,omitempty"`
+}
+
+// P2P is peer to peer discovery and address advertisement configuration
+type P2P struct {
+	// ExternalIP is public IP address advertised to peers, and used in node enode URL
+	ExternalIP string `json:"externalIP,omitempty"`
+	// ExternalHostname is public hostname advertised to peers, and used in node enode URL
+	// external hostname is supported by besu only
+	ExternalHostname string `json:"externalHostname,omitempty"`
+	// NAT is network address translation method used to find node public address
+	// client default is used if no NAT method or external address is provided
+	NAT NATMethod `json:"nat,omitempty"`
+	// DiscoveryV5 enables node discovery protocol v5
+	DiscoveryV5 bool `json:"discoveryV5,omitempty"`
+	// MaxPeers is maximum number of connected peers
+	MaxPeers uint `json:"maxPeers,omitempty"`
+	// ServiceType is p2p service type exposing p2p port outside the cluster
+	// LoadBalancer address is advertised to peers unless external address is provided
+	// NodePort services expose the same p2p port on cluster nodes, and require external address of the cluster node
+	// +kubebuilder:validation:Enum=LoadBalancer;NodePort
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+}
+
+// NATMethod is network address translation method
+// +kubebuilder:validation:Enum=auto;none;upnp;pmp
+type NATMethod string
+
+const (
+	// AutoNATMethod detects network address translation method automatically
+	AutoNATMethod NATMethod = "auto"
+	// NoNATMethod disables network address translation
+	NoNATMethod NATMethod = "none"
+	// UPnPNATMethod uses Universal Plug and Play port mapping
+	UPnPNATMethod NATMethod = "upnp"
+	// PMPNATMethod uses NAT Port Mapping Protocol
+	PMPNATMethod NATMethod = "pmp"
+)
+
+// Performance is database and state storage tuning
+type Performance struct {
+	// Cache is database cache size in megabytes
+	Cache uint `json:"cache,omitempty"`
+	// Pruning is state pruning mode
+	// defaults to archive if sync mode is full, otherwise client default is used
+	// full and hybrid pruning modes are supported by nethermind only
+	Pruning PruningMode `json:"pruning,omitempty"`
+	// StorageFormat is state database storage format
+	// bonsai and forest are supported by besu, hash and path are supported by geth 1.13.0 or later
+	// storage format can't be changed after the node is created
+	StorageFormat StorageFormat `json:"storageFormat,omitempty"`
+}
+
+// PruningMode is state pruning mode
+// +kubebuilder:validation:Enum=archive;pruned;full;hybrid
+type PruningMode string
+
+const (
+	// ArchivePruning keeps all historical state
+	ArchivePruning PruningMode = "archive"
+	// PrunedPruning keeps recent state only
+	PrunedPruning PruningMode = "pruned"
+	// FullPruning prunes state database periodically by copying recent state into a new database
+	FullPruning PruningMode = "full"
+	// HybridPruning prunes recent state in memory and state database periodically
+	HybridPruning PruningMode = "hybrid"
+)
+
+// StorageFormat is state database storage format
+// +kubebuilder:validation:Enum=bonsai;forest;hash;path
+type StorageFormat string
+
+const (
+	// BonsaiStorageFormat is besu flat state storage format keeping recent state only
+	BonsaiStorageFormat StorageFormat = "bonsai"
+	// ForestStorageFormat is besu merkle trie state storage format
+	ForestStorageFormat StorageFormat = "forest"
+	// HashStorageFormat is geth hash-based merkle trie state scheme
+	HashStorageFormat StorageFormat = "hash"
+	// PathStorageFormat is geth path-based state scheme pruning stale state in place
+	PathStorageFormat StorageFormat = "path"
+)
+
+// Serve is chain data serving to light clients and syncing peers
+type Serve struct {
+	// LightServer is maximum percentage of time allowed for serving light clients requests
+	// light server is disabled if no light server percentage is provided
+	// light server is supported by geth images older than v1.14.0 only
+	LightServer uint `json:"lightServer,omitempty"`
+	// LightPeers is maximum number of light clients to serve
+	LightPeers uint `json:"lightPeers,omitempty"`
+	// Snap is whether snap sync requests are served, client default is used if not provided
+	// serving snap sync requests requires besu 25.4.0 or nethermind 1.26.0 or later
+	Snap *bool `json:"snap,omitempty"`
+	// HistoryExpiry drops pre-merge block bodies and receipts, they're not served to peers
+	// history expiry is supported by mainnet and sepolia networks, and requires besu 25.7.0 or geth 1.16.0 or later
+	HistoryExpiry bool `json:"historyExpiry,omitempty"`
+}
+
+// TxPool is transaction pool limits
+type TxPool struct {
+	// Size is maximum number of pending transactions
+	Size uint `json:"size,omitempty"`
+	// SenderLimit is maximum number of pending transactions per sender
+	SenderLimit uint `json:"senderLimit,omitempty"`
+	// Lifetime is maximum time in hours pending transactions are kept in the pool
+	Lifetime uint `json:"lifetime,omitempty"`
+}
+
+// Mining is block production settings
+type Mining struct {
+	// MinGasPrice is minimum gas price in wei for transactions to be mined
+	MinGasPrice uint `json:"minGasPrice,omitempty"`
+	// GasTarget is block gas limit the miner targets
+	GasTarget uint `json:"gasTarget,omitempty"`
+}
+
+// Dev is single node development chain configuration
+type Dev struct {
+	// BlockPeriod is block time in seconds, blocks are produced once transactions are received if no block period is provided
+	// block period is not supported by besu
+	BlockPeriod uint `json:"blockPeriod,omitempty"`
+	// MnemonicSecretName is kubernetes secret name holding BIP-39 english mnemonic in mnemonic key
+	// pre-funded accounts are derived from the mnemonic using m/44'/60'/0'/0/i path
+	// well known test mnemonic is used if no mnemonic secret name is provided
+	MnemonicSecretName string `json:"mnemonicSecretName,omitempty"`
+	// Accounts is number of pre-funded accounts
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Accounts uint `json:"accounts,omitempty"`
+	// Balance is pre-funded accounts balance in wei
+	Balance HexString `json:"balance,omitempty"`
+}
+
 func init() {
 	SchemeBuilder.Register(&Node{}, &NodeList{})
 }
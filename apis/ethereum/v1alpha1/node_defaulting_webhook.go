@@ -34,13 +34,21 @@ func (n *Node) Default() {
 		n.Spec.Genesis.Default()
 	}
 
+	// default development chain
+	if n.Spec.Network == DevNetwork {
+		if n.Spec.Dev == nil {
+			n.Spec.Dev = &Dev{}
+		}
+		n.Spec.Dev.Default()
+	}
+
 	if n.Spec.P2PPort == 0 {
 		n.Spec.P2PPort = DefaultP2PPort
 	}
 
 	if n.Spec.SyncMode == "" {
 		// public network
-		if n.Spec.Genesis == nil {
+		if n.Spec.Genesis == nil && n.Spec.Network != DevNetwork {
 			if n.Spec.Client == GethClient {
 				n.Spec.SyncMode = SnapSynchronization
 			} else {
@@ -88,6 +96,10 @@ func (n *Node) Default() {
 		n.Spec.GraphQLPort = DefaultGraphQLPort
 	}
 
+	if n.Spec.Gateway != nil {
+		n.Spec.Gateway.Default()
+	}
+
 	if n.Spec.Logging == "" {
 		n.Spec.Logging = DefaultLogging
 	}
@@ -97,7 +109,7 @@ func (n *Node) Default() {
 // DefaultNodeResources defaults node cpu, memory and storage resources
 func (n *Node) DefaultNodeResources() {
 	var cpu, cpuLimit, memory, memoryLimit, storage string
-	privateNetwork := n.Spec.Genesis != nil
+	privateNetwork := n.Spec.Genesis != nil || n.Spec.Network == DevNetwork
 	network := n.Spec.Network
 
 	if n.Spec.Resources.CPU == "" {
@@ -155,3 +167,14 @@ func (n *Node) DefaultNodeResources() {
 	}
 
 }
+
+// Default sets development chain default values
+func (d *Dev) Default() {
+	if d.Accounts == 0 {
+		d.Accounts = DefaultDevAccounts
+	}
+
+	if d.Balance == "" {
+		d.Balance = DefaultDevBalance
+	}
+}
@@ -104,6 +104,30 @@ var _ = Describe("Ethereum defaulting", func() {
 		Expect(node.Spec.Logging).To(Equal(DefaultLogging))
 	})
 
+	It("Should default geth node running development chain", func() {
+
+		node := Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node-1",
+			},
+			Spec: NodeSpec{
+				Client:  GethClient,
+				Network: DevNetwork,
+			},
+		}
+
+		node.Default()
+		Expect(node.Spec.Dev.BlockPeriod).To(Equal(uint(0)))
+		Expect(node.Spec.Dev.Accounts).To(Equal(DefaultDevAccounts))
+		Expect(node.Spec.Dev.Balance).To(Equal(DefaultDevBalance))
+		Expect(node.Spec.SyncMode).To(Equal(DefaultPrivateNetworkSyncMode))
+		Expect(node.Spec.Resources.CPU).To(Equal(DefaultPrivateNetworkNodeCPURequest))
+		Expect(node.Spec.Resources.CPULimit).To(Equal(DefaultPrivateNetworkNodeCPULimit))
+		Expect(node.Spec.Resources.Memory).To(Equal(DefaultPrivateNetworkNodeMemoryRequest))
+		Expect(node.Spec.Resources.MemoryLimit).To(Equal(DefaultPrivateNetworkNodeMemoryLimit))
+		Expect(node.Spec.Resources.Storage).To(Equal(DefaultPrivateNetworkNodeStorageRequest))
+	})
+
 	It("Should default nodes joining network pow consensus", func() {
 		node := Node{
 			ObjectMeta: metav1.ObjectMeta{
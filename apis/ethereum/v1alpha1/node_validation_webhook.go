@@ -2,7 +2,11 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net"
+	"strings"
 
+	"github.com/kotalco/kotal/apis/shared"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -19,6 +23,7 @@ func (n *Node) validate() field.ErrorList {
 	var nodeErrors field.ErrorList
 
 	privateNetwork := n.Spec.Genesis != nil
+	importing := n.Spec.Import != nil || len(n.Spec.Imports) != 0
 
 	path := field.NewPath("spec")
 
@@ -34,6 +39,20 @@ func (n *Node) validate() field.ErrorList {
 		nodeErrors = append(nodeErrors, err)
 	}
 
+	// network: client must support joining the public network
+	if n.Spec.Network != "" && !n.Spec.Client.SupportsNetwork(n.Spec.Network) {
+		err := field.Invalid(path.Child("network"), n.Spec.Network, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		nodeErrors = append(nodeErrors, err)
+	} else if version := n.Spec.Client.NetworkMinimumVersion(n.Spec.Network); !shared.ImageVersionAtLeast(n.Spec.Image, version) {
+		// image: networks launched after client image can't be joined
+		err := field.Invalid(path.Child("image"), n.Spec.Image, fmt.Sprintf("must be client %s version %s or later to join network %s", n.Spec.Client, version, n.Spec.Network))
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	if n.Spec.Dev != nil || n.Spec.Network == DevNetwork {
+		nodeErrors = append(nodeErrors, n.validateDev()...)
+	}
+
 	if !n.Spec.Client.SupportsVerbosityLevel(n.Spec.Logging) {
 		err := field.Invalid(path.Child("logging"), n.Spec.Logging, fmt.Sprintf("not supported by client %s", n.Spec.Client))
 		nodeErrors = append(nodeErrors, err)
@@ -45,12 +64,6 @@ func (n *Node) validate() field.ErrorList {
 		nodeErrors = append(nodeErrors, err)
 	}
 
-	// validate jwtSecretName is provided if engine is enabled
-	if n.Spec.Engine && n.Spec.JWTSecretName == "" {
-		err := field.Invalid(path.Child("jwtSecretName"), "", "must provide jwtSecretName if engine is true")
-		nodeErrors = append(nodeErrors, err)
-	}
-
 	// validate coinbase can't be set if miner is not set explicitly as true
 	if n.Spec.Coinbase != "" && !n.Spec.Miner {
 		err := field.Invalid(path.Child("miner"), false, "must set miner to true if coinbase is provided")
@@ -59,7 +72,7 @@ func (n *Node) validate() field.ErrorList {
 
 	// validate that besu doesn't support importing ethereum accounts
 	// Netermind, go-ethereum, and OpenEthereum support importing accounts
-	if n.Spec.Client == BesuClient && n.Spec.Import != nil {
+	if n.Spec.Client == BesuClient && importing {
 		err := field.Invalid(path.Child("client"), n.Spec.Client, "client doesn't support importing accounts")
 		nodeErrors = append(nodeErrors, err)
 	}
@@ -113,26 +126,495 @@ func (n *Node) validate() field.ErrorList {
 	}
 
 	// validate rpc can't be enabled for node with imported account
-	if n.Spec.Client != BesuClient && n.Spec.Import != nil && n.Spec.RPC {
+	if n.Spec.Client != BesuClient && importing && n.Spec.RPC {
 		err := field.Invalid(path.Child("rpc"), n.Spec.RPC, "must be false if import is provided")
 		nodeErrors = append(nodeErrors, err)
 	}
 
 	// validate ws can't be enabled for node with imported account
-	if n.Spec.Client != BesuClient && n.Spec.Import != nil && n.Spec.WS {
+	if n.Spec.Client != BesuClient && importing && n.Spec.WS {
 		err := field.Invalid(path.Child("ws"), n.Spec.WS, "must be false if import is provided")
 		nodeErrors = append(nodeErrors, err)
 	}
 
 	// validate graphql can't be enabled for node with imported account
-	if n.Spec.Client != BesuClient && n.Spec.Import != nil && n.Spec.GraphQL {
+	if n.Spec.Client != BesuClient && importing && n.Spec.GraphQL {
 		err := field.Invalid(path.Child("graphql"), n.Spec.GraphQL, "must be false if import is provided")
 		nodeErrors = append(nodeErrors, err)
 	}
 
+	// validate import account address is the coinbase
+	if n.Spec.Import != nil && n.Spec.Coinbase == "" {
+		err := field.Invalid(path.Child("coinbase"), "", "must provide coinbase if import is provided")
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	if importing {
+		nodeErrors = append(nodeErrors, n.validateImports()...)
+	}
+
+	if n.Spec.Privacy != nil {
+		nodeErrors = append(nodeErrors, n.validatePrivacy()...)
+	}
+
+	if n.Spec.P2P != nil {
+		nodeErrors = append(nodeErrors, n.validateP2P()...)
+	}
+
+	if n.Spec.Performance != nil {
+		nodeErrors = append(nodeErrors, n.validatePerformance()...)
+	}
+
+	if n.Spec.Serve != nil {
+		nodeErrors = append(nodeErrors, n.validateServe()...)
+	}
+
+	if n.Spec.TxPool != nil {
+		nodeErrors = append(nodeErrors, n.validateTxPool()...)
+	}
+
+	if n.Spec.Gateway != nil {
+		nodeErrors = append(nodeErrors, n.Spec.Gateway.Validate()...)
+	}
+
+	// validate gateway has json-rpc or web socket server to proxy
+	if n.Spec.Gateway != nil && !n.Spec.RPC && !n.Spec.WS {
+		err := field.Invalid(path.Child("gateway"), "", "must enable rpc or ws if gateway is provided")
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	// validate gas target can't be set if miner is not set explicitly as true
+	if n.Spec.Mining != nil && n.Spec.Mining.GasTarget != 0 && !n.Spec.Miner {
+		err := field.Invalid(path.Child("miner"), false, "must set miner to true if mining.gasTarget is provided")
+		nodeErrors = append(nodeErrors, err)
+	}
+
 	return nodeErrors
 }
 
+// validateImportedAccount validates account private key or keystore is provided
+func validateImportedAccount(account *ImportedAccount, path *field.Path) field.ErrorList {
+	var accountErrors field.ErrorList
+
+	// validate private key or keystore is provided
+	if account.PrivateKeySecretName == "" && account.KeystoreSecretName == "" {
+		err := field.Invalid(path.Child("privateKeySecretName"), "", "must provide privateKeySecretName or keystoreSecretName")
+		accountErrors = append(accountErrors, err)
+	}
+
+	// validate private key and keystore can't be provided together
+	if account.PrivateKeySecretName != "" && account.KeystoreSecretName != "" {
+		err := field.Invalid(path.Child("keystoreSecretName"), account.KeystoreSecretName, "must be none if privateKeySecretName is provided")
+		accountErrors = append(accountErrors, err)
+	}
+
+	return accountErrors
+}
+
+// validateImports validates imported accounts
+func (n *Node) validateImports() field.ErrorList {
+	var importErrors field.ErrorList
+
+	path := field.NewPath("spec")
+
+	if n.Spec.Import != nil {
+		importErrors = append(importErrors, validateImportedAccount(n.Spec.Import, path.Child("import"))...)
+	}
+
+	addresses := map[string]bool{}
+	if n.Spec.Coinbase != "" {
+		addresses[strings.ToLower(string(n.Spec.Coinbase))] = true
+	}
+
+	for i := range n.Spec.Imports {
+		account := &n.Spec.Imports[i]
+		accountPath := path.Child("imports").Index(i)
+
+		importErrors = append(importErrors, validateImportedAccount(account, accountPath)...)
+
+		// validate account address is provided, it's used to unlock the account
+		if account.Address == "" {
+			err := field.Invalid(accountPath.Child("address"), "", "must provide address")
+			importErrors = append(importErrors, err)
+			continue
+		}
+
+		// validate account is imported once
+		address := strings.ToLower(string(account.Address))
+		if addresses[address] {
+			err := field.Duplicate(accountPath.Child("address"), account.Address)
+			importErrors = append(importErrors, err)
+		}
+		addresses[address] = true
+	}
+
+	return importErrors
+}
+
+// validateP2P validates node peer to peer discovery and address advertisement configuration
+func (n *Node) validateP2P() field.ErrorList {
+	var p2pErrors field.ErrorList
+
+	path := field.NewPath("spec").Child("p2p")
+	p2p := n.Spec.P2P
+
+	// validate external IP is a valid IP address
+	if p2p.ExternalIP != "" && net.ParseIP(p2p.ExternalIP) == nil {
+		err := field.Invalid(path.Child("externalIP"), p2p.ExternalIP, "must be a valid IP address")
+		p2pErrors = append(p2pErrors, err)
+	}
+
+	// validate only one external address is advertised
+	if p2p.ExternalIP != "" && p2p.ExternalHostname != "" {
+		err := field.Invalid(path.Child("externalHostname"), p2p.ExternalHostname, "must be none if externalIP is provided")
+		p2pErrors = append(p2pErrors, err)
+	}
+
+	// validate external hostname is supported by client
+	if p2p.ExternalHostname != "" && !n.Spec.Client.SupportsExternalHostname() {
+		err := field.Invalid(path.Child("externalHostname"), p2p.ExternalHostname, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		p2pErrors = append(p2pErrors, err)
+	}
+
+	// validate NAT method is supported by client
+	if p2p.NAT != "" && !n.Spec.Client.SupportsNATMethod(p2p.NAT) {
+		err := field.Invalid(path.Child("nat"), p2p.NAT, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		p2pErrors = append(p2pErrors, err)
+	}
+
+	// validate NAT method isn't used to find public address if it's provided
+	if p2p.NAT != "" && (p2p.ExternalIP != "" || p2p.ExternalHostname != "") {
+		err := field.Invalid(path.Child("nat"), p2p.NAT, "must be none if externalIP or externalHostname is provided")
+		p2pErrors = append(p2pErrors, err)
+	}
+
+	// validate discovery v5 is supported by client
+	if p2p.DiscoveryV5 && !n.Spec.Client.SupportsDiscoveryV5() {
+		err := field.Invalid(path.Child("discoveryV5"), p2p.DiscoveryV5, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		p2pErrors = append(p2pErrors, err)
+	}
+
+	// validate node port address is provided, cluster node the pod is scheduled on isn't known in advance
+	if p2p.ServiceType == corev1.ServiceTypeNodePort && p2p.ExternalIP == "" && p2p.ExternalHostname == "" {
+		err := field.Invalid(path.Child("serviceType"), p2p.ServiceType, "must provide externalIP or externalHostname if serviceType is NodePort")
+		p2pErrors = append(p2pErrors, err)
+	}
+
+	// validate p2p port can be exposed as node port
+	if p2p.ServiceType == corev1.ServiceTypeNodePort && (n.Spec.P2PPort < 30000 || n.Spec.P2PPort > 32767) {
+		err := field.Invalid(field.NewPath("spec").Child("p2pPort"), n.Spec.P2PPort, "must be between 30000 and 32767 if p2p.serviceType is NodePort")
+		p2pErrors = append(p2pErrors, err)
+	}
+
+	return p2pErrors
+}
+
+// validatePerformance validates node database and state storage tuning
+func (n *Node) validatePerformance() field.ErrorList {
+	var performanceErrors field.ErrorList
+
+	path := field.NewPath("spec").Child("performance")
+	performance := n.Spec.Performance
+
+	// validate database cache size is supported by client
+	if performance.Cache != 0 && !n.Spec.Client.SupportsDatabaseCache() {
+		err := field.Invalid(path.Child("cache"), performance.Cache, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		performanceErrors = append(performanceErrors, err)
+	}
+
+	// validate light nodes don't store state
+	if performance.Pruning != "" && n.Spec.SyncMode == LightSynchronization {
+		err := field.Invalid(path.Child("pruning"), performance.Pruning, "not supported with light sync mode")
+		performanceErrors = append(performanceErrors, err)
+	}
+
+	// validate pruning mode is supported by client
+	if performance.Pruning != "" && !n.Spec.Client.SupportsPruningMode(performance.Pruning) {
+		err := field.Invalid(path.Child("pruning"), performance.Pruning, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		performanceErrors = append(performanceErrors, err)
+	}
+
+	// validate storage format is supported by client
+	if performance.StorageFormat != "" && !n.Spec.Client.SupportsStorageFormat(performance.StorageFormat) {
+		err := field.Invalid(path.Child("storageFormat"), performance.StorageFormat, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		performanceErrors = append(performanceErrors, err)
+	} else if version := n.Spec.Client.StorageFormatMinimumVersion(); performance.StorageFormat != "" && !shared.ImageVersionAtLeast(n.Spec.Image, version) {
+		err := field.Invalid(path.Child("storageFormat"), performance.StorageFormat, fmt.Sprintf("requires client %s version %s or later", n.Spec.Client, version))
+		performanceErrors = append(performanceErrors, err)
+	}
+
+	// validate besu bonsai storage prunes state itself
+	if performance.StorageFormat == BonsaiStorageFormat && performance.Pruning == PrunedPruning {
+		err := field.Invalid(path.Child("pruning"), performance.Pruning, "not supported with bonsai storage format")
+		performanceErrors = append(performanceErrors, err)
+	}
+
+	// validate besu bonsai storage and geth path scheme keep recent state only
+	// geth full sync nodes are archive nodes unless pruning mode is set explicitly, other clients full sync nodes aren't
+	archive := performance.Pruning == ArchivePruning || (performance.Pruning == "" && n.Spec.SyncMode == FullSynchronization && n.Spec.Client == GethClient)
+	if archive && (performance.StorageFormat == BonsaiStorageFormat || performance.StorageFormat == PathStorageFormat) {
+		msg := fmt.Sprintf("archive nodes are not supported with %s storage format", performance.StorageFormat)
+		err := field.Invalid(path.Child("storageFormat"), performance.StorageFormat, msg)
+		performanceErrors = append(performanceErrors, err)
+	}
+
+	return performanceErrors
+}
+
+// validatePerformanceUpdate validates state storage settings that clients can't migrate
+func (n *Node) validatePerformanceUpdate(oldNode *Node) field.ErrorList {
+	var performanceErrors field.ErrorList
+
+	path := field.NewPath("spec").Child("performance")
+
+	var oldPerformance, newPerformance Performance
+	if oldNode.Spec.Performance != nil {
+		oldPerformance = *oldNode.Spec.Performance
+	}
+	if n.Spec.Performance != nil {
+		newPerformance = *n.Spec.Performance
+	}
+
+	// storage format can't be converted, it requires resync from scratch
+	// no storage format is the default storage format of client image the node is running
+	defaultFormat := oldNode.Spec.Client.DefaultStorageFormat(oldNode.Spec.Image)
+	oldFormat, newFormat := oldPerformance.StorageFormat, newPerformance.StorageFormat
+	if oldFormat == "" {
+		oldFormat = defaultFormat
+	}
+	if newFormat == "" {
+		newFormat = defaultFormat
+	}
+	if oldFormat != newFormat {
+		err := field.Invalid(path.Child("storageFormat"), newPerformance.StorageFormat, "field is immutable")
+		performanceErrors = append(performanceErrors, err)
+	}
+
+	// nethermind archive state database can't be pruned, and pruned state database can't be archive
+	// no pruning mode is archive if sync mode is full
+	wasArchive := oldPerformance.Pruning == ArchivePruning || (oldPerformance.Pruning == "" && oldNode.Spec.SyncMode == FullSynchronization)
+	isArchive := newPerformance.Pruning == ArchivePruning || (newPerformance.Pruning == "" && n.Spec.SyncMode == FullSynchronization)
+	if n.Spec.Client == NethermindClient && wasArchive != isArchive {
+		err := field.Invalid(path.Child("pruning"), newPerformance.Pruning, "field is immutable")
+		performanceErrors = append(performanceErrors, err)
+	}
+
+	return performanceErrors
+}
+
+// validateServe validates node chain data serving
+func (n *Node) validateServe() field.ErrorList {
+	var serveErrors field.ErrorList
+
+	path := field.NewPath("spec").Child("serve")
+	serve := n.Spec.Serve
+
+	// validate light nodes don't have chain data to serve
+	if n.Spec.SyncMode == LightSynchronization {
+		err := field.Invalid(path, "", "not supported with light sync mode")
+		serveErrors = append(serveErrors, err)
+	}
+
+	// validate light server is supported by client
+	if serve.LightServer != 0 && !n.Spec.Client.SupportsLightServer() {
+		err := field.Invalid(path.Child("lightServer"), serve.LightServer, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		serveErrors = append(serveErrors, err)
+	} else if version := n.Spec.Client.LightServerMaximumVersion(); serve.LightServer != 0 && version != "" && shared.ImageVersionAtLeast(n.Spec.Image, version) {
+		// light server has been removed from newer client images
+		err := field.Invalid(path.Child("lightServer"), serve.LightServer, fmt.Sprintf("not supported by client %s version %s or later", n.Spec.Client, version))
+		serveErrors = append(serveErrors, err)
+	}
+
+	// validate light peers limit is provided for light server only
+	if serve.LightPeers != 0 && serve.LightServer == 0 {
+		err := field.Invalid(path.Child("lightServer"), 0, "must provide lightServer if lightPeers is provided")
+		serveErrors = append(serveErrors, err)
+	}
+
+	// validate geth keeps state snapshot required by snap sync
+	if serve.Snap != nil && !*serve.Snap && n.Spec.Client == GethClient && n.Spec.SyncMode == SnapSynchronization {
+		err := field.Invalid(path.Child("snap"), false, "must be true if client is geth and syncMode is snap")
+		serveErrors = append(serveErrors, err)
+	}
+
+	// validate client image supports serving snap sync requests option
+	// besu snap sync requests serving is disabled by default, no option is used to disable it
+	snapOption := serve.Snap != nil && (*serve.Snap || n.Spec.Client != BesuClient)
+	if version := n.Spec.Client.SnapServingMinimumVersion(); snapOption && !shared.ImageVersionAtLeast(n.Spec.Image, version) {
+		err := field.Invalid(path.Child("snap"), *serve.Snap, fmt.Sprintf("requires client %s version %s or later", n.Spec.Client, version))
+		serveErrors = append(serveErrors, err)
+	}
+
+	if serve.HistoryExpiry {
+		// validate client image supports history expiry
+		if version := n.Spec.Client.HistoryExpiryMinimumVersion(); !shared.ImageVersionAtLeast(n.Spec.Image, version) {
+			err := field.Invalid(path.Child("historyExpiry"), true, fmt.Sprintf("requires client %s version %s or later", n.Spec.Client, version))
+			serveErrors = append(serveErrors, err)
+		}
+		// validate network has pre-merge history to expire
+		if n.Spec.Network != MainNetwork && n.Spec.Network != SepoliaNetwork {
+			err := field.Invalid(path.Child("historyExpiry"), true, "supported by mainnet and sepolia networks only")
+			serveErrors = append(serveErrors, err)
+		}
+		// validate full sync doesn't skip block bodies
+		if n.Spec.SyncMode == FullSynchronization {
+			err := field.Invalid(path.Child("historyExpiry"), true, "not supported with full sync mode")
+			serveErrors = append(serveErrors, err)
+		}
+	}
+
+	return serveErrors
+}
+
+// validateDev validates single node development chain
+func (n *Node) validateDev() field.ErrorList {
+	var devErrors field.ErrorList
+
+	path := field.NewPath("spec")
+
+	// validate development chain configuration is provided for dev network only
+	if n.Spec.Network != DevNetwork {
+		err := field.Invalid(path.Child("dev"), "", "must be none if network is not dev")
+		devErrors = append(devErrors, err)
+		return devErrors
+	}
+
+	// development chain first pre-funded account is the coinbase
+	if n.Spec.Coinbase != "" {
+		err := field.Invalid(path.Child("coinbase"), n.Spec.Coinbase, "must be none if network is dev")
+		devErrors = append(devErrors, err)
+	}
+
+	if n.Spec.Import != nil {
+		err := field.Invalid(path.Child("import"), "", "must be none if network is dev")
+		devErrors = append(devErrors, err)
+	}
+
+	// development chain genesis is generated from pre-funded accounts
+	if n.Spec.Genesis != nil {
+		err := field.Invalid(path.Child("genesis"), "", "must be none if network is dev")
+		devErrors = append(devErrors, err)
+	}
+
+	dev := n.Spec.Dev
+	if dev == nil {
+		return devErrors
+	}
+
+	// validate block period is supported by client
+	if dev.BlockPeriod != 0 && !n.Spec.Client.SupportsDevBlockPeriod() {
+		err := field.Invalid(path.Child("dev").Child("blockPeriod"), dev.BlockPeriod, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		devErrors = append(devErrors, err)
+	}
+
+	return devErrors
+}
+
+// validateDevUpdate validates development chain genesis accounts can't be changed
+func (n *Node) validateDevUpdate(oldNode *Node) field.ErrorList {
+	var devErrors field.ErrorList
+
+	path := field.NewPath("spec").Child("dev")
+	oldDev, dev := oldNode.Spec.Dev, n.Spec.Dev
+
+	if oldDev == nil || dev == nil {
+		return devErrors
+	}
+
+	if oldDev.MnemonicSecretName != dev.MnemonicSecretName {
+		err := field.Invalid(path.Child("mnemonicSecretName"), dev.MnemonicSecretName, "field is immutable")
+		devErrors = append(devErrors, err)
+	}
+
+	if oldDev.Accounts != dev.Accounts {
+		err := field.Invalid(path.Child("accounts"), dev.Accounts, "field is immutable")
+		devErrors = append(devErrors, err)
+	}
+
+	if oldDev.Balance != dev.Balance {
+		err := field.Invalid(path.Child("balance"), dev.Balance, "field is immutable")
+		devErrors = append(devErrors, err)
+	}
+
+	return devErrors
+}
+
+// validateTxPool validates node transaction pool limits
+func (n *Node) validateTxPool() field.ErrorList {
+	var txPoolErrors field.ErrorList
+
+	path := field.NewPath("spec").Child("txpool")
+	txPool := n.Spec.TxPool
+
+	// validate pending transactions per sender limit is supported by client
+	if txPool.SenderLimit != 0 && !n.Spec.Client.SupportsTxPoolSenderLimit() {
+		err := field.Invalid(path.Child("senderLimit"), txPool.SenderLimit, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		txPoolErrors = append(txPoolErrors, err)
+	}
+
+	// validate pending transactions lifetime is supported by client
+	if txPool.Lifetime != 0 && !n.Spec.Client.SupportsTxPoolLifetime() {
+		err := field.Invalid(path.Child("lifetime"), txPool.Lifetime, fmt.Sprintf("not supported by client %s", n.Spec.Client))
+		txPoolErrors = append(txPoolErrors, err)
+	}
+
+	return txPoolErrors
+}
+
+// validatePrivacy validates node private transactions configuration
+func (n *Node) validatePrivacy() field.ErrorList {
+	var privacyErrors field.ErrorList
+
+	path := field.NewPath("spec").Child("privacy")
+	privacy := n.Spec.Privacy
+
+	// validate only besu supports private transactions
+	if n.Spec.Client != BesuClient {
+		err := field.Invalid(field.NewPath("spec").Child("client"), n.Spec.Client, "client doesn't support private transactions")
+		privacyErrors = append(privacyErrors, err)
+	}
+
+	// validate transaction manager is provided
+	if privacy.TransactionManager == "" {
+		err := field.Invalid(path.Child("transactionManager"), "", "must provide transactionManager")
+		privacyErrors = append(privacyErrors, err)
+	}
+
+	// validate private transactions require full sync mode
+	if n.Spec.SyncMode != FullSynchronization {
+		err := field.Invalid(field.NewPath("spec").Child("syncMode"), n.Spec.SyncMode, "must be full if privacy is enabled")
+		privacyErrors = append(privacyErrors, err)
+	}
+
+	// validate jwtPublicKeySecretName is provided if multi-tenancy is enabled
+	if privacy.MultiTenancy && privacy.JWTPublicKeySecretName == "" {
+		err := field.Invalid(path.Child("jwtPublicKeySecretName"), "", "must provide jwtPublicKeySecretName if multiTenancy is true")
+		privacyErrors = append(privacyErrors, err)
+	}
+
+	// validate jwtPublicKeySecretName can't be set if multi-tenancy is disabled
+	if !privacy.MultiTenancy && privacy.JWTPublicKeySecretName != "" {
+		err := field.Invalid(path.Child("multiTenancy"), false, "must set multiTenancy to true if jwtPublicKeySecretName is provided")
+		privacyErrors = append(privacyErrors, err)
+	}
+
+	// validate rpc or ws is enabled if multi-tenancy is enabled
+	if privacy.MultiTenancy && !n.Spec.RPC && !n.Spec.WS {
+		err := field.Invalid(field.NewPath("spec").Child("rpc"), n.Spec.RPC, "must enable rpc or ws if multiTenancy is true")
+		privacyErrors = append(privacyErrors, err)
+	}
+
+	return privacyErrors
+}
+
+// Warnings returns node admission warnings
+func (n *Node) Warnings() (warnings []string) {
+	if DeprecatedNetworks[n.Spec.Network] {
+		warnings = append(warnings, DeprecatedNetworkWarning(n.Spec.Network))
+	}
+	return
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (n *Node) ValidateCreate() error {
 	var allErrors field.ErrorList
@@ -171,6 +653,9 @@ func (n *Node) ValidateUpdate(old runtime.Object) error {
 		allErrors = append(allErrors, err)
 	}
 
+	allErrors = append(allErrors, n.validatePerformanceUpdate(oldNode)...)
+	allErrors = append(allErrors, n.validateDevUpdate(oldNode)...)
+
 	// validate genesis block
 	if oldNode.Spec.Genesis != nil {
 		allErrors = append(allErrors, n.Spec.Genesis.ValidateUpdate(oldNode.Spec.Genesis)...)
@@ -6,6 +6,7 @@ import (
 	"github.com/kotalco/kotal/apis/shared"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -17,6 +18,8 @@ var _ = Describe("Ethereum node validation", func() {
 		networkID       uint = 77777
 		fixedDifficulty uint = 1500
 		coinbase             = shared.EthereumAddress("0xd2c21213027cbf4d46c16b55fa98e5252b048706")
+		snapDisabled         = false
+		snapEnabled          = true
 	)
 
 	createCases := []struct {
@@ -89,27 +92,6 @@ var _ = Describe("Ethereum node validation", func() {
 				},
 			},
 		},
-		{
-			Title: "node #10",
-			Node: &Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-1",
-				},
-				Spec: NodeSpec{
-					Client:  BesuClient,
-					Network: GoerliNetwork,
-					Engine:  true,
-				},
-			},
-			Errors: field.ErrorList{
-				{
-					Type:     field.ErrorTypeInvalid,
-					Field:    "spec.jwtSecretName",
-					BadValue: "",
-					Detail:   "must provide jwtSecretName if engine is true",
-				},
-			},
-		},
 		{
 			Title: "node #11",
 			Node: &Node{
@@ -530,184 +512,1397 @@ var _ = Describe("Ethereum node validation", func() {
 				},
 			},
 		},
-	}
-
-	// TODO: move .resources validation to shared resources package
-	updateCases := []struct {
-		Title   string
-		OldNode *Node
-		NewNode *Node
-		Errors  field.ErrorList
-	}{
 		{
-			Title: "node #1",
-			OldNode: &Node{
+			Title: "node #40",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "node-1",
 				},
 				Spec: NodeSpec{
-					Client:  BesuClient,
-					Network: GoerliNetwork,
+					Client:   GethClient,
+					Network:  GoerliNetwork,
+					SyncMode: FullSynchronization,
+					Privacy: &Privacy{
+						TransactionManager: "tessera",
+					},
 				},
 			},
-			NewNode: &Node{
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.client",
+					BadValue: GethClient,
+					Detail:   "client doesn't support private transactions",
+				},
+			},
+		},
+		{
+			Title: "node #41",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "node-1",
 				},
 				Spec: NodeSpec{
-					Client:  BesuClient,
-					Network: MainNetwork,
+					Client:   BesuClient,
+					Network:  GoerliNetwork,
+					SyncMode: FastSynchronization,
+					Privacy: &Privacy{
+						TransactionManager: "tessera",
+					},
 				},
 			},
 			Errors: field.ErrorList{
 				{
 					Type:     field.ErrorTypeInvalid,
-					Field:    "spec.network",
-					BadValue: MainNetwork,
-					Detail:   "field is immutable",
+					Field:    "spec.syncMode",
+					BadValue: FastSynchronization,
+					Detail:   "must be full if privacy is enabled",
 				},
 			},
 		},
 		{
-			Title: "node #2",
-			OldNode: &Node{
+			Title: "node #42",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-2",
+					Name: "node-1",
 				},
 				Spec: NodeSpec{
-					Client:  BesuClient,
-					Network: GoerliNetwork,
-					Resources: shared.Resources{
-						Storage: "20Gi",
+					Client: BesuClient,
+					Genesis: &Genesis{
+						ChainID:   55555,
+						NetworkID: networkID,
+						Clique: &Clique{
+							Signers: []shared.EthereumAddress{coinbase},
+						},
+					},
+					RPC: true,
+					Privacy: &Privacy{
+						TransactionManager: "tessera",
+						MultiTenancy:       true,
 					},
 				},
 			},
-			NewNode: &Node{
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.privacy.jwtPublicKeySecretName",
+					BadValue: "",
+					Detail:   "must provide jwtPublicKeySecretName if multiTenancy is true",
+				},
+			},
+		},
+		{
+			Title: "node #43",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-2",
+					Name: "node-1",
 				},
 				Spec: NodeSpec{
-					Client:  BesuClient,
-					Network: GoerliNetwork,
-					Resources: shared.Resources{
-						Storage: "10Gi",
+					Client: BesuClient,
+					Genesis: &Genesis{
+						ChainID:   55555,
+						NetworkID: networkID,
+						Clique: &Clique{
+							Signers: []shared.EthereumAddress{coinbase},
+						},
+					},
+					Privacy: &Privacy{
+						TransactionManager:     "tessera",
+						MultiTenancy:           true,
+						JWTPublicKeySecretName: "jwt-public-key",
 					},
 				},
 			},
 			Errors: field.ErrorList{
 				{
 					Type:     field.ErrorTypeInvalid,
-					Field:    "spec.resources.storage",
-					BadValue: "10Gi",
-					Detail:   "must be greater than or equal to old storage 20Gi",
+					Field:    "spec.rpc",
+					BadValue: false,
+					Detail:   "must enable rpc or ws if multiTenancy is true",
 				},
 			},
 		},
 		{
-			Title: "node #3",
-			OldNode: &Node{
+			Title: "node #44",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-3",
+					Name: "node-1",
 				},
 				Spec: NodeSpec{
 					Client:  BesuClient,
 					Network: GoerliNetwork,
-					Resources: shared.Resources{
-						CPU:      "1",
-						CPULimit: "2",
+					Performance: &Performance{
+						Cache: 2048,
 					},
 				},
 			},
-			NewNode: &Node{
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.cache",
+					BadValue: uint(2048),
+					Detail:   "not supported by client besu",
+				},
+			},
+		},
+		{
+			Title: "node #45",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-3",
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   GethClient,
+					Network:  GoerliNetwork,
+					SyncMode: LightSynchronization,
+					Performance: &Performance{
+						Pruning: ArchivePruning,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.pruning",
+					BadValue: ArchivePruning,
+					Detail:   "not supported with light sync mode",
+				},
+			},
+		},
+		{
+			Title: "node #46",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
 				},
 				Spec: NodeSpec{
 					Client:  BesuClient,
 					Network: GoerliNetwork,
-					Resources: shared.Resources{
-						CPU:      "2",
-						CPULimit: "1",
+					TxPool: &TxPool{
+						SenderLimit: 64,
 					},
 				},
 			},
 			Errors: field.ErrorList{
 				{
 					Type:     field.ErrorTypeInvalid,
-					Field:    "spec.resources.cpuLimit",
-					BadValue: "1",
-					Detail:   "must be greater than or equal to cpu 2",
+					Field:    "spec.txpool.senderLimit",
+					BadValue: uint(64),
+					Detail:   "not supported by client besu",
 				},
 			},
 		},
 		{
-			Title: "node #4",
-			OldNode: &Node{
+			Title: "node #47",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-4",
+					Name: "node-1",
 				},
 				Spec: NodeSpec{
-					Client:  BesuClient,
+					Client:  NethermindClient,
 					Network: GoerliNetwork,
-					Resources: shared.Resources{
-						Memory:      "1Gi",
-						MemoryLimit: "2Gi",
+					TxPool: &TxPool{
+						Lifetime: 6,
 					},
 				},
 			},
-			NewNode: &Node{
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.txpool.lifetime",
+					BadValue: uint(6),
+					Detail:   "not supported by client nethermind",
+				},
+			},
+		},
+		{
+			Title: "node #48",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-4",
+					Name: "node-1",
 				},
 				Spec: NodeSpec{
-					Client:  BesuClient,
+					Client:  GethClient,
 					Network: GoerliNetwork,
-					Resources: shared.Resources{
-						Memory:      "1Gi",
-						MemoryLimit: "1Gi",
+					Mining: &Mining{
+						GasTarget: 30000000,
 					},
 				},
 			},
 			Errors: field.ErrorList{
 				{
 					Type:     field.ErrorTypeInvalid,
-					Field:    "spec.resources.memoryLimit",
-					BadValue: "1Gi",
-					Detail:   "must be greater than memory 1Gi",
+					Field:    "spec.miner",
+					BadValue: false,
+					Detail:   "must set miner to true if mining.gasTarget is provided",
 				},
 			},
 		},
 		{
-			Title: "node #5",
-			OldNode: &Node{
+			Title: "node #49",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-5",
+					Name: "node-1",
 				},
 				Spec: NodeSpec{
-					Client:  BesuClient,
+					Client:  GethClient,
 					Network: GoerliNetwork,
+					Import: &ImportedAccount{
+						PrivateKeySecretName: "my-account-privatekey",
+						PasswordSecretName:   "my-account-password",
+					},
 				},
 			},
-			NewNode: &Node{
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.coinbase",
+					BadValue: "",
+					Detail:   "must provide coinbase if import is provided",
+				},
+			},
+		},
+		{
+			Title: "node #50",
+			Node: &Node{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "node-5",
+					Name: "node-1",
 				},
 				Spec: NodeSpec{
 					Client:  GethClient,
 					Network: GoerliNetwork,
+					Imports: []ImportedAccount{
+						{
+							PasswordSecretName: "my-account-password",
+						},
+						{
+							Address:              coinbase,
+							PrivateKeySecretName: "my-account-privatekey",
+							KeystoreSecretName:   "my-account-keystore",
+							PasswordSecretName:   "my-account-password",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.imports[0].privateKeySecretName",
+					BadValue: "",
+					Detail:   "must provide privateKeySecretName or keystoreSecretName",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.imports[0].address",
+					BadValue: "",
+					Detail:   "must provide address",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.imports[1].keystoreSecretName",
+					BadValue: "my-account-keystore",
+					Detail:   "must be none if privateKeySecretName is provided",
+				},
+			},
+		},
+		{
+			Title: "node #51",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   NethermindClient,
+					Network:  GoerliNetwork,
+					Miner:    true,
+					Coinbase: coinbase,
+					Import: &ImportedAccount{
+						PrivateKeySecretName: "my-account-privatekey",
+						PasswordSecretName:   "my-account-password",
+					},
+					Imports: []ImportedAccount{
+						{
+							Address:            coinbase,
+							KeystoreSecretName: "my-account-keystore",
+							PasswordSecretName: "my-account-password",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeDuplicate,
+					Field:    "spec.imports[0].address",
+					BadValue: coinbase,
+				},
+			},
+		},
+		{
+			Title: "node #52",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+					RPC:     true,
+					Imports: []ImportedAccount{
+						{
+							Address:            coinbase,
+							KeystoreSecretName: "my-account-keystore",
+							PasswordSecretName: "my-account-password",
+						},
+					},
 				},
 			},
 			Errors: field.ErrorList{
 				{
 					Type:     field.ErrorTypeInvalid,
 					Field:    "spec.client",
-					BadValue: GethClient,
-					Detail:   "field is immutable",
+					BadValue: "besu",
+					Detail:   "client doesn't support importing accounts",
 				},
 			},
 		},
-	}
-
+		{
+			Title: "node #53",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: ChiadoNetwork,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.network",
+					BadValue: ChiadoNetwork,
+					Detail:   "not supported by client geth",
+				},
+			},
+		},
+		{
+			Title: "node #54",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: MainNetwork,
+					Gateway: &shared.RPCGateway{
+						APIKeysSecretName: "api-keys",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.gateway",
+					BadValue: "",
+					Detail:   "must enable rpc or ws if gateway is provided",
+				},
+			},
+		},
+		{
+			Title: "node #55",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: MainNetwork,
+					RPC:     true,
+					Gateway: &shared.RPCGateway{
+						AllowedMethods: []string{"eth_*"},
+						DeniedMethods:  []string{"eth_*"},
+						RateLimit:      10,
+						RateLimitBurst: 5,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.gateway.apiKeysSecretName",
+					BadValue: "",
+					Detail:   "must provide apiKeysSecretName or jwtSecretName",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.gateway.deniedMethods[0]",
+					BadValue: "eth_*",
+					Detail:   "can't be allowed and denied",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.gateway.rateLimitBurst",
+					BadValue: uint(5),
+					Detail:   "must be greater than or equal to rateLimit",
+				},
+			},
+		},
+		{
+			Title: "node #56",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  NethermindClient,
+					Network: MainNetwork,
+					Performance: &Performance{
+						StorageFormat: BonsaiStorageFormat,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.storageFormat",
+					BadValue: BonsaiStorageFormat,
+					Detail:   "not supported by client nethermind",
+				},
+			},
+		},
+		{
+			Title: "node #57",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: MainNetwork,
+					Performance: &Performance{
+						Pruning: HybridPruning,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.pruning",
+					BadValue: HybridPruning,
+					Detail:   "not supported by client geth",
+				},
+			},
+		},
+		{
+			Title: "node #58",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: MainNetwork,
+					Performance: &Performance{
+						Pruning:       PrunedPruning,
+						StorageFormat: BonsaiStorageFormat,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.pruning",
+					BadValue: PrunedPruning,
+					Detail:   "not supported with bonsai storage format",
+				},
+			},
+		},
+		{
+			Title: "node #59",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: MainNetwork,
+					Performance: &Performance{
+						Pruning:       ArchivePruning,
+						StorageFormat: PathStorageFormat,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.storageFormat",
+					BadValue: PathStorageFormat,
+					Detail:   "archive nodes are not supported with path storage format",
+				},
+			},
+		},
+		{
+			Title: "node #60",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: MainNetwork,
+					P2P: &P2P{
+						ExternalIP: "not-an-ip",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.p2p.externalIP",
+					BadValue: "not-an-ip",
+					Detail:   "must be a valid IP address",
+				},
+			},
+		},
+		{
+			Title: "node #61",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: MainNetwork,
+					P2P: &P2P{
+						ExternalHostname: "geth.example.com",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.p2p.externalHostname",
+					BadValue: "geth.example.com",
+					Detail:   "not supported by client geth",
+				},
+			},
+		},
+		{
+			Title: "node #62",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  NethermindClient,
+					Network: MainNetwork,
+					P2P: &P2P{
+						NAT: PMPNATMethod,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.p2p.nat",
+					BadValue: PMPNATMethod,
+					Detail:   "not supported by client nethermind",
+				},
+			},
+		},
+		{
+			Title: "node #63",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: MainNetwork,
+					P2P: &P2P{
+						ExternalIP: "203.0.113.10",
+						NAT:        UPnPNATMethod,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.p2p.nat",
+					BadValue: UPnPNATMethod,
+					Detail:   "must be none if externalIP or externalHostname is provided",
+				},
+			},
+		},
+		{
+			Title: "node #64",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: MainNetwork,
+					P2P: &P2P{
+						DiscoveryV5: true,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.p2p.discoveryV5",
+					BadValue: true,
+					Detail:   "not supported by client besu",
+				},
+			},
+		},
+		{
+			Title: "node #65",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: MainNetwork,
+					P2PPort: 3000,
+					P2P: &P2P{
+						ServiceType: corev1.ServiceTypeNodePort,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.p2pPort",
+					BadValue: uint(3000),
+					Detail:   "must be between 30000 and 32767 if p2p.serviceType is NodePort",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.p2p.serviceType",
+					BadValue: corev1.ServiceTypeNodePort,
+					Detail:   "must provide externalIP or externalHostname if serviceType is NodePort",
+				},
+			},
+		},
+		{
+			Title: "node #66",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   BesuClient,
+					Network:  MainNetwork,
+					SyncMode: FastSynchronization,
+					Serve: &Serve{
+						LightServer: 50,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.lightServer",
+					BadValue: uint(50),
+					Detail:   "not supported by client besu",
+				},
+			},
+		},
+		{
+			Title: "node #67",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   GethClient,
+					Network:  MainNetwork,
+					SyncMode: FastSynchronization,
+					Serve: &Serve{
+						LightPeers: 10,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.lightServer",
+					BadValue: 0,
+					Detail:   "must provide lightServer if lightPeers is provided",
+				},
+			},
+		},
+		{
+			Title: "node #68",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   GethClient,
+					Network:  MainNetwork,
+					SyncMode: LightSynchronization,
+					Serve: &Serve{
+						LightServer: 50,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve",
+					BadValue: "",
+					Detail:   "not supported with light sync mode",
+				},
+			},
+		},
+		{
+			Title: "node #69",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   GethClient,
+					Network:  MainNetwork,
+					SyncMode: SnapSynchronization,
+					Serve: &Serve{
+						Snap: &snapDisabled,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.snap",
+					BadValue: false,
+					Detail:   "must be true if client is geth and syncMode is snap",
+				},
+			},
+		},
+		{
+			Title: "node #70",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   NethermindClient,
+					Network:  HoleskyNetwork,
+					SyncMode: FullSynchronization,
+					Serve: &Serve{
+						HistoryExpiry: true,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.historyExpiry",
+					BadValue: true,
+					Detail:   "supported by mainnet and sepolia networks only",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.historyExpiry",
+					BadValue: true,
+					Detail:   "not supported with full sync mode",
+				},
+			},
+		},
+		{
+			Title: "node #71",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: GoerliNetwork,
+					Dev:     &Dev{},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.dev",
+					BadValue: "",
+					Detail:   "must be none if network is not dev",
+				},
+			},
+		},
+		{
+			Title: "node #72",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: DevNetwork,
+					Dev: &Dev{
+						BlockPeriod: 5,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.dev.blockPeriod",
+					BadValue: uint(5),
+					Detail:   "not supported by client besu",
+				},
+			},
+		},
+		{
+			Title: "node #73",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   GethClient,
+					Network:  DevNetwork,
+					Miner:    true,
+					Coinbase: "0x2b3430337f12Ce89EaBC7b0d865F4253c7744c0d",
+					Import: &ImportedAccount{
+						PrivateKeySecretName: "my-account-privatekey",
+						PasswordSecretName:   "my-account-password",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.coinbase",
+					BadValue: shared.EthereumAddress("0x2b3430337f12Ce89EaBC7b0d865F4253c7744c0d"),
+					Detail:   "must be none if network is dev",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.import",
+					BadValue: "",
+					Detail:   "must be none if network is dev",
+				},
+			},
+		},
+		{
+			Title: "node #74",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: HoleskyNetwork,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.image",
+					BadValue: DefaultGethImage,
+					Detail:   "must be client geth version 1.13.0 or later to join network holesky",
+				},
+			},
+		},
+		{
+			Title: "node #75",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  NethermindClient,
+					Network: HoodiNetwork,
+					Image:   "nethermind/nethermind:1.30.3",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.image",
+					BadValue: "nethermind/nethermind:1.30.3",
+					Detail:   "must be client nethermind version 1.31.0 or later to join network hoodi",
+				},
+			},
+		},
+		{
+			Title: "node #76",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   GethClient,
+					Network:  MainNetwork,
+					SyncMode: SnapSynchronization,
+					Performance: &Performance{
+						StorageFormat: PathStorageFormat,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.storageFormat",
+					BadValue: PathStorageFormat,
+					Detail:   "requires client geth version 1.13.0 or later",
+				},
+			},
+		},
+		{
+			Title: "node #77",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   BesuClient,
+					Network:  MainNetwork,
+					SyncMode: SnapSynchronization,
+					Serve: &Serve{
+						Snap:          &snapEnabled,
+						HistoryExpiry: true,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.snap",
+					BadValue: true,
+					Detail:   "requires client besu version 25.4.0 or later",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.historyExpiry",
+					BadValue: true,
+					Detail:   "requires client besu version 25.7.0 or later",
+				},
+			},
+		},
+		{
+			Title: "node #78",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   NethermindClient,
+					Network:  MainNetwork,
+					SyncMode: SnapSynchronization,
+					Image:    "nethermind/nethermind:1.25.4",
+					Serve: &Serve{
+						Snap: &snapEnabled,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.snap",
+					BadValue: true,
+					Detail:   "requires client nethermind version 1.26.0 or later",
+				},
+			},
+		},
+		{
+			Title: "node #79",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   GethClient,
+					Network:  SepoliaNetwork,
+					SyncMode: SnapSynchronization,
+					Serve: &Serve{
+						HistoryExpiry: true,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.historyExpiry",
+					BadValue: true,
+					Detail:   "requires client geth version 1.16.0 or later",
+				},
+			},
+		},
+		{
+			Title: "node #80",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: DevNetwork,
+					Genesis: &Genesis{
+						ChainID:   55555,
+						NetworkID: 55555,
+						Ethash:    &Ethash{},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis",
+					BadValue: "",
+					Detail:   "must be none if network is dev",
+				},
+			},
+		},
+		{
+			Title: "node #81",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   BesuClient,
+					Network:  MainNetwork,
+					SyncMode: FullSynchronization,
+					Performance: &Performance{
+						Pruning:       ArchivePruning,
+						StorageFormat: BonsaiStorageFormat,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.storageFormat",
+					BadValue: BonsaiStorageFormat,
+					Detail:   "archive nodes are not supported with bonsai storage format",
+				},
+			},
+		},
+		{
+			Title: "node #82",
+			Node: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   GethClient,
+					Network:  MainNetwork,
+					SyncMode: SnapSynchronization,
+					Image:    "ethereum/client-go:v1.16.1",
+					Serve: &Serve{
+						LightServer: 50,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.serve.lightServer",
+					BadValue: uint(50),
+					Detail:   "not supported by client geth version 1.14.0 or later",
+				},
+			},
+		},
+	}
+
+	// TODO: move .resources validation to shared resources package
+	updateCases := []struct {
+		Title   string
+		OldNode *Node
+		NewNode *Node
+		Errors  field.ErrorList
+	}{
+		{
+			Title: "node #1",
+			OldNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+				},
+			},
+			NewNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: MainNetwork,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.network",
+					BadValue: MainNetwork,
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "node #2",
+			OldNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-2",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+					Resources: shared.Resources{
+						Storage: "20Gi",
+					},
+				},
+			},
+			NewNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-2",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+					Resources: shared.Resources{
+						Storage: "10Gi",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.resources.storage",
+					BadValue: "10Gi",
+					Detail:   "must be greater than or equal to old storage 20Gi",
+				},
+			},
+		},
+		{
+			Title: "node #3",
+			OldNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-3",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+					Resources: shared.Resources{
+						CPU:      "1",
+						CPULimit: "2",
+					},
+				},
+			},
+			NewNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-3",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+					Resources: shared.Resources{
+						CPU:      "2",
+						CPULimit: "1",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.resources.cpuLimit",
+					BadValue: "1",
+					Detail:   "must be greater than or equal to cpu 2",
+				},
+			},
+		},
+		{
+			Title: "node #4",
+			OldNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-4",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+					Resources: shared.Resources{
+						Memory:      "1Gi",
+						MemoryLimit: "2Gi",
+					},
+				},
+			},
+			NewNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-4",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+					Resources: shared.Resources{
+						Memory:      "1Gi",
+						MemoryLimit: "1Gi",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.resources.memoryLimit",
+					BadValue: "1Gi",
+					Detail:   "must be greater than memory 1Gi",
+				},
+			},
+		},
+		{
+			Title: "node #5",
+			OldNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-5",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: GoerliNetwork,
+				},
+			},
+			NewNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-5",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: GoerliNetwork,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.client",
+					BadValue: GethClient,
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "node #6",
+			OldNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-6",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: MainNetwork,
+					Performance: &Performance{
+						StorageFormat: ForestStorageFormat,
+					},
+				},
+			},
+			NewNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-6",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: MainNetwork,
+					Performance: &Performance{
+						StorageFormat: BonsaiStorageFormat,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.storageFormat",
+					BadValue: BonsaiStorageFormat,
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "node #7",
+			OldNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-7",
+				},
+				Spec: NodeSpec{
+					Client:  NethermindClient,
+					Network: MainNetwork,
+					Performance: &Performance{
+						Pruning: ArchivePruning,
+					},
+				},
+			},
+			NewNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-7",
+				},
+				Spec: NodeSpec{
+					Client:  NethermindClient,
+					Network: MainNetwork,
+					Performance: &Performance{
+						Pruning: FullPruning,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.performance.pruning",
+					BadValue: FullPruning,
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "node #8",
+			OldNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-8",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: DevNetwork,
+					Dev: &Dev{
+						MnemonicSecretName: "my-mnemonic",
+						Accounts:           10,
+						Balance:            DefaultDevBalance,
+					},
+				},
+			},
+			NewNode: &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-8",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: DevNetwork,
+					Dev: &Dev{
+						BlockPeriod:        5,
+						MnemonicSecretName: "my-other-mnemonic",
+						Accounts:           20,
+						Balance:            DefaultDevBalance,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.dev.mnemonicSecretName",
+					BadValue: "my-other-mnemonic",
+					Detail:   "field is immutable",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.dev.accounts",
+					BadValue: uint(20),
+					Detail:   "field is immutable",
+				},
+			},
+		},
+	}
+
 	Context("While creating node", func() {
 		for _, c := range createCases {
 			func() {
@@ -726,6 +1921,95 @@ var _ = Describe("Ethereum node validation", func() {
 		}
 	})
 
+	Context("While joining deprecated network", func() {
+		It("Should warn about deprecated network", func() {
+			node := &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: RinkebyNetwork,
+				},
+			}
+			Expect(node.Warnings()).To(ConsistOf(DeprecatedNetworkWarning(RinkebyNetwork)))
+		})
+
+		It("Should not warn about live network", func() {
+			node := &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  GethClient,
+					Network: HoodiNetwork,
+				},
+			}
+			Expect(node.Warnings()).To(BeEmpty())
+		})
+	})
+
+	Context("While creating full sync node", func() {
+		It("Should accept besu node with bonsai storage format", func() {
+			node := &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   BesuClient,
+					Network:  MainNetwork,
+					SyncMode: FullSynchronization,
+					Performance: &Performance{
+						StorageFormat: BonsaiStorageFormat,
+					},
+				},
+			}
+			node.Default()
+			Expect(node.ValidateCreate()).To(Succeed())
+		})
+	})
+
+	Context("While updating node storage settings to client defaults", func() {
+		It("Should accept storage format of client image", func() {
+			oldNode := &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:  BesuClient,
+					Network: MainNetwork,
+				},
+			}
+			newNode := oldNode.DeepCopy()
+			newNode.Spec.Performance = &Performance{
+				StorageFormat: ForestStorageFormat,
+			}
+			oldNode.Default()
+			newNode.Default()
+			Expect(newNode.ValidateUpdate(oldNode)).To(Succeed())
+		})
+
+		It("Should accept archive pruning of full sync nodes", func() {
+			oldNode := &Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Spec: NodeSpec{
+					Client:   NethermindClient,
+					Network:  MainNetwork,
+					SyncMode: FullSynchronization,
+				},
+			}
+			newNode := oldNode.DeepCopy()
+			newNode.Spec.Performance = &Performance{
+				Pruning: ArchivePruning,
+			}
+			oldNode.Default()
+			newNode.Default()
+			Expect(newNode.ValidateUpdate(oldNode)).To(Succeed())
+		})
+	})
+
 	Context("While updating node", func() {
 		for _, c := range updateCases {
 			func() {
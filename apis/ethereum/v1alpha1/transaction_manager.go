@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	"github.com/kotalco/kotal/apis/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransactionManagerSpec defines the desired state of TransactionManager
+type TransactionManagerSpec struct {
+	// Image is transaction manager client image
+	Image string `json:"image,omitempty"`
+
+	// Client is transaction manager client
+	Client TransactionManagerClient `json:"client,omitempty"`
+
+	// PrivateKeySecretName is the secret name holding transaction manager private key
+	PrivateKeySecretName string `json:"privateKeySecretName"`
+
+	// Peers is transaction manager peer urls or names of kotal transaction managers
+	// name (same namespace) or name.namespace can be used to refer to kotal transaction managers
+	// +listType=set
+	Peers []string `json:"peers,omitempty"`
+
+	// Resources is transaction manager compute and storage resources
+	shared.Resources `json:"resources,omitempty"`
+}
+
+// TransactionManagerClient is the client running the transaction manager
+// +kubebuilder:validation:Enum=tessera
+type TransactionManagerClient string
+
+const (
+	// TesseraTransactionManager is ConsenSys Tessera private transaction manager
+	TesseraTransactionManager TransactionManagerClient = "tessera"
+)
+
+// TransactionManagerStatus defines the observed state of TransactionManager
+type TransactionManagerStatus struct {
+	// Client is transaction manager client
+	Client string `json:"client,omitempty"`
+	// PublicKey is transaction manager public key in base64 format
+	PublicKey string `json:"publicKey,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// TransactionManager is the Schema for the transactionmanagers API
+// +kubebuilder:printcolumn:name="Client",type=string,JSONPath=".spec.client"
+// +kubebuilder:printcolumn:name="PublicKey",type=string,JSONPath=".status.publicKey",priority=10
+type TransactionManager struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransactionManagerSpec   `json:"spec,omitempty"`
+	Status TransactionManagerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransactionManagerList contains a list of TransactionManager
+type TransactionManagerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransactionManager `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TransactionManager{}, &TransactionManagerList{})
+}
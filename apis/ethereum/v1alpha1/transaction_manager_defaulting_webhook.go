@@ -0,0 +1,52 @@
+package v1alpha1
+
+import "sigs.k8s.io/controller-runtime/pkg/webhook"
+
+// +kubebuilder:webhook:path=/mutate-ethereum-kotal-io-v1alpha1-transactionmanager,mutating=true,failurePolicy=fail,groups=ethereum.kotal.io,resources=transactionmanagers,verbs=create;update,versions=v1alpha1,name=mutate-ethereum-v1alpha1-transactionmanager.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &TransactionManager{}
+
+// DefaultResources defaults transaction manager resources
+func (r *TransactionManager) DefaultResources() {
+	if r.Spec.Resources.CPU == "" {
+		r.Spec.Resources.CPU = DefaultTransactionManagerCPURequest
+	}
+
+	if r.Spec.Resources.CPULimit == "" {
+		r.Spec.Resources.CPULimit = DefaultTransactionManagerCPULimit
+	}
+
+	if r.Spec.Resources.Memory == "" {
+		r.Spec.Resources.Memory = DefaultTransactionManagerMemoryRequest
+	}
+
+	if r.Spec.Resources.MemoryLimit == "" {
+		r.Spec.Resources.MemoryLimit = DefaultTransactionManagerMemoryLimit
+	}
+
+	if r.Spec.Resources.Storage == "" {
+		r.Spec.Resources.Storage = DefaultTransactionManagerStorageRequest
+	}
+}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *TransactionManager) Default() {
+	transactionmanagerlog.Info("default", "name", r.Name)
+
+	if r.Spec.Client == "" {
+		r.Spec.Client = DefaultTransactionManagerClient
+	}
+
+	if r.Spec.Image == "" {
+		var image string
+
+		switch r.Spec.Client {
+		case TesseraTransactionManager:
+			image = DefaultTesseraImage
+		}
+
+		r.Spec.Image = image
+	}
+
+	r.DefaultResources()
+}
@@ -0,0 +1,28 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Ethereum transaction manager defaulting", func() {
+	It("Should default transaction manager", func() {
+		tm := TransactionManager{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: TransactionManagerSpec{
+				PrivateKeySecretName: "tessera-key",
+			},
+		}
+
+		tm.Default()
+
+		Expect(tm.Spec.Client).To(Equal(DefaultTransactionManagerClient))
+		Expect(tm.Spec.Image).To(Equal(DefaultTesseraImage))
+		Expect(tm.Spec.Resources.CPU).To(Equal(DefaultTransactionManagerCPURequest))
+		Expect(tm.Spec.Resources.CPULimit).To(Equal(DefaultTransactionManagerCPULimit))
+		Expect(tm.Spec.Resources.Memory).To(Equal(DefaultTransactionManagerMemoryRequest))
+		Expect(tm.Spec.Resources.MemoryLimit).To(Equal(DefaultTransactionManagerMemoryLimit))
+		Expect(tm.Spec.Resources.Storage).To(Equal(DefaultTransactionManagerStorageRequest))
+	})
+})
@@ -0,0 +1,77 @@
+package v1alpha1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ethereum-kotal-io-v1alpha1-transactionmanager,mutating=false,failurePolicy=fail,groups=ethereum.kotal.io,resources=transactionmanagers,versions=v1alpha1,name=validate-ethereum-v1alpha1-transactionmanager.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &TransactionManager{}
+
+// validate validates transaction manager
+func (r *TransactionManager) validate() field.ErrorList {
+	var tmErrors field.ErrorList
+
+	// validate private key secret name is provided
+	if r.Spec.PrivateKeySecretName == "" {
+		err := field.Invalid(field.NewPath("spec").Child("privateKeySecretName"), "", "must provide privateKeySecretName")
+		tmErrors = append(tmErrors, err)
+	}
+
+	return tmErrors
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *TransactionManager) ValidateCreate() error {
+	var allErrors field.ErrorList
+
+	transactionmanagerlog.Info("validate create", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.Spec.Resources.ValidateCreate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *TransactionManager) ValidateUpdate(old runtime.Object) error {
+	var allErrors field.ErrorList
+	oldTM := old.(*TransactionManager)
+
+	transactionmanagerlog.Info("validate update", "name", r.Name)
+
+	if oldTM.Spec.Client != r.Spec.Client {
+		err := field.Invalid(field.NewPath("spec").Child("client"), r.Spec.Client, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	// private key identifies the transaction manager to its peers and to the nodes
+	if oldTM.Spec.PrivateKeySecretName != r.Spec.PrivateKeySecretName {
+		err := field.Invalid(field.NewPath("spec").Child("privateKeySecretName"), r.Spec.PrivateKeySecretName, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.Spec.Resources.ValidateUpdate(&oldTM.Spec.Resources)...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *TransactionManager) ValidateDelete() error {
+	transactionmanagerlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Ethereum transaction manager validation", func() {
+	createCases := []struct {
+		Title  string
+		TM     *TransactionManager
+		Errors field.ErrorList
+	}{
+		{
+			Title: "transaction manager #1",
+			TM: &TransactionManager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tm-1",
+				},
+				Spec: TransactionManagerSpec{},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.privateKeySecretName",
+					BadValue: "",
+					Detail:   "must provide privateKeySecretName",
+				},
+			},
+		},
+	}
+
+	updateCases := []struct {
+		Title  string
+		OldTM  *TransactionManager
+		NewTM  *TransactionManager
+		Errors field.ErrorList
+	}{
+		{
+			Title: "transaction manager #1",
+			OldTM: &TransactionManager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tm-1",
+				},
+				Spec: TransactionManagerSpec{
+					PrivateKeySecretName: "tessera-key",
+				},
+			},
+			NewTM: &TransactionManager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tm-1",
+				},
+				Spec: TransactionManagerSpec{
+					PrivateKeySecretName: "tessera-key-2",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.privateKeySecretName",
+					BadValue: "tessera-key-2",
+					Detail:   "field is immutable",
+				},
+			},
+		},
+	}
+
+	Context("While creating transaction manager", func() {
+		for _, c := range createCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.TM.Default()
+					err := cc.TM.ValidateCreate()
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+
+	Context("While updating transaction manager", func() {
+		for _, c := range updateCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.OldTM.Default()
+					cc.NewTM.Default()
+					err := cc.NewTM.ValidateUpdate(cc.OldTM)
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+})
@@ -0,0 +1,90 @@
+package v1alpha1
+
+import (
+	"github.com/kotalco/kotal/apis/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidatorProposalSpec defines the desired state of ValidatorProposal
+type ValidatorProposalSpec struct {
+	// Nodes is kotal ethereum nodes to vote on the proposal
+	// nodes are referred to by name, and must be in the proposal namespace
+	// only nodes that are current signers or validators will vote
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Nodes []string `json:"nodes"`
+
+	// Address is the signer or validator address to vote on
+	Address shared.EthereumAddress `json:"address"`
+
+	// Action is whether to add or remove the signer or validator
+	Action ProposalAction `json:"action"`
+}
+
+// ProposalAction is the action to vote on
+// +kubebuilder:validation:Enum=add;remove
+type ProposalAction string
+
+const (
+	// AddValidatorAction votes to add signer or validator
+	AddValidatorAction ProposalAction = "add"
+	// RemoveValidatorAction votes to remove signer or validator
+	RemoveValidatorAction ProposalAction = "remove"
+)
+
+// ProposalPhase is validator proposal phase
+type ProposalPhase string
+
+const (
+	// ProposalPending is proposal waiting for nodes to be available
+	ProposalPending ProposalPhase = "Pending"
+	// ProposalVoting is proposal being voted on by current signers or validators
+	ProposalVoting ProposalPhase = "Voting"
+	// ProposalApplied is proposal applied on chain
+	ProposalApplied ProposalPhase = "Applied"
+)
+
+// ValidatorProposalStatus defines the observed state of ValidatorProposal
+type ValidatorProposalStatus struct {
+	// Consensus is network consensus algorithm
+	Consensus string `json:"consensus,omitempty"`
+	// Phase is proposal phase
+	Phase ProposalPhase `json:"phase,omitempty"`
+	// Voters is nodes that voted on the proposal
+	Voters []string `json:"voters,omitempty"`
+	// Validators is current number of signers or validators
+	Validators int `json:"validators,omitempty"`
+	// UnresolvedReferences is nodes references that couldn't be resolved
+	UnresolvedReferences []string `json:"unresolvedReferences,omitempty"`
+	// Message is the reason the proposal couldn't be voted on
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ValidatorProposal is the Schema for the validatorproposals API
+// +kubebuilder:printcolumn:name="Address",type=string,JSONPath=".spec.address"
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=".spec.action"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",priority=10
+type ValidatorProposal struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ValidatorProposalSpec   `json:"spec,omitempty"`
+	Status ValidatorProposalStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ValidatorProposalList contains a list of ValidatorProposal
+type ValidatorProposalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ValidatorProposal `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ValidatorProposal{}, &ValidatorProposalList{})
+}
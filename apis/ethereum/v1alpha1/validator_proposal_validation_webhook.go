@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ethereum-kotal-io-v1alpha1-validatorproposal,mutating=false,failurePolicy=fail,groups=ethereum.kotal.io,resources=validatorproposals,versions=v1alpha1,name=validate-ethereum-v1alpha1-validatorproposal.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ValidatorProposal{}
+
+// validate validates validator proposal
+func (r *ValidatorProposal) validate() field.ErrorList {
+	var proposalErrors field.ErrorList
+
+	// validate at least one node is voting on the proposal
+	if len(r.Spec.Nodes) == 0 {
+		err := field.Invalid(field.NewPath("spec").Child("nodes"), "", "must provide at least one node")
+		proposalErrors = append(proposalErrors, err)
+	}
+
+	// votes are cast using nodes gateway credentials, which are read in proposal namespace only
+	for i, node := range r.Spec.Nodes {
+		if strings.Contains(node, ".") {
+			err := field.Invalid(field.NewPath("spec").Child("nodes").Index(i), node, "must be a node in the same namespace")
+			proposalErrors = append(proposalErrors, err)
+		}
+	}
+
+	return proposalErrors
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ValidatorProposal) ValidateCreate() error {
+	var allErrors field.ErrorList
+
+	validatorproposallog.Info("validate create", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ValidatorProposal) ValidateUpdate(old runtime.Object) error {
+	var allErrors field.ErrorList
+	oldProposal := old.(*ValidatorProposal)
+
+	validatorproposallog.Info("validate update", "name", r.Name)
+
+	// votes already cast by the nodes are for the original address and action
+	if oldProposal.Spec.Address != r.Spec.Address {
+		err := field.Invalid(field.NewPath("spec").Child("address"), r.Spec.Address, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if oldProposal.Spec.Action != r.Spec.Action {
+		err := field.Invalid(field.NewPath("spec").Child("action"), r.Spec.Action, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	allErrors = append(allErrors, r.validate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ValidatorProposal) ValidateDelete() error {
+	validatorproposallog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
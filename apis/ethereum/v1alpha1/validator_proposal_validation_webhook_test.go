@@ -0,0 +1,166 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Ethereum validator proposal validation", func() {
+	createCases := []struct {
+		Title    string
+		Proposal *ValidatorProposal
+		Errors   field.ErrorList
+	}{
+		{
+			Title: "validator proposal #1",
+			Proposal: &ValidatorProposal{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "proposal-1",
+				},
+				Spec: ValidatorProposalSpec{
+					Address: "0x2b3430337f12Ce89EaBC7b0d865F4253c7744c0d",
+					Action:  AddValidatorAction,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.nodes",
+					BadValue: "",
+					Detail:   "must provide at least one node",
+				},
+			},
+		},
+		{
+			Title: "validator proposal #2",
+			Proposal: &ValidatorProposal{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "proposal-2",
+				},
+				Spec: ValidatorProposalSpec{
+					Nodes:   []string{"node-1", "node-2.other-namespace"},
+					Address: "0x2b3430337f12Ce89EaBC7b0d865F4253c7744c0d",
+					Action:  AddValidatorAction,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.nodes[1]",
+					BadValue: "node-2.other-namespace",
+					Detail:   "must be a node in the same namespace",
+				},
+			},
+		},
+	}
+
+	updateCases := []struct {
+		Title       string
+		OldProposal *ValidatorProposal
+		NewProposal *ValidatorProposal
+		Errors      field.ErrorList
+	}{
+		{
+			Title: "validator proposal #1",
+			OldProposal: &ValidatorProposal{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "proposal-1",
+				},
+				Spec: ValidatorProposalSpec{
+					Nodes:   []string{"node-1"},
+					Address: "0x2b3430337f12Ce89EaBC7b0d865F4253c7744c0d",
+					Action:  AddValidatorAction,
+				},
+			},
+			NewProposal: &ValidatorProposal{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "proposal-1",
+				},
+				Spec: ValidatorProposalSpec{
+					Nodes:   []string{"node-1"},
+					Address: "0xd2c21213027cbf4d46c16b55fa98e5252b048706",
+					Action:  AddValidatorAction,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.address",
+					BadValue: "0xd2c21213027cbf4d46c16b55fa98e5252b048706",
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "validator proposal #2",
+			OldProposal: &ValidatorProposal{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "proposal-2",
+				},
+				Spec: ValidatorProposalSpec{
+					Nodes:   []string{"node-1"},
+					Address: "0x2b3430337f12Ce89EaBC7b0d865F4253c7744c0d",
+					Action:  AddValidatorAction,
+				},
+			},
+			NewProposal: &ValidatorProposal{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "proposal-2",
+				},
+				Spec: ValidatorProposalSpec{
+					Nodes:   []string{"node-1"},
+					Address: "0x2b3430337f12Ce89EaBC7b0d865F4253c7744c0d",
+					Action:  RemoveValidatorAction,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.action",
+					BadValue: RemoveValidatorAction,
+					Detail:   "field is immutable",
+				},
+			},
+		},
+	}
+
+	Context("While creating validator proposal", func() {
+		for _, c := range createCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					err := cc.Proposal.ValidateCreate()
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+
+	Context("While updating validator proposal", func() {
+		for _, c := range updateCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					err := cc.NewProposal.ValidateUpdate(cc.OldProposal)
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+})
@@ -53,6 +53,21 @@ func (in *Clique) DeepCopy() *Clique {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dev) DeepCopyInto(out *Dev) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dev.
+func (in *Dev) DeepCopy() *Dev {
+	if in == nil {
+		return nil
+	}
+	out := new(Dev)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ethash) DeepCopyInto(out *Ethash) {
 	*out = *in
@@ -171,13 +186,129 @@ func (in *ImportedAccount) DeepCopy() *ImportedAccount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mining) DeepCopyInto(out *Mining) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mining.
+func (in *Mining) DeepCopy() *Mining {
+	if in == nil {
+		return nil
+	}
+	out := new(Mining)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
+func (in *Network) DeepCopy() *Network {
+	if in == nil {
+		return nil
+	}
+	out := new(Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Network) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkList) DeepCopyInto(out *NetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Network, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkList.
+func (in *NetworkList) DeepCopy() *NetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	if in.Clients != nil {
+		in, out := &in.Clients, &out.Clients
+		*out = make([]EthereumClient, len(*in))
+		copy(*out, *in)
+	}
+	in.Genesis.DeepCopyInto(&out.Genesis)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	if in.Validators != nil {
+		in, out := &in.Validators, &out.Validators
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Node) DeepCopyInto(out *Node) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Node.
@@ -238,11 +369,21 @@ func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 		*out = new(Genesis)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Dev != nil {
+		in, out := &in.Dev, &out.Dev
+		*out = new(Dev)
+		**out = **in
+	}
 	if in.Import != nil {
 		in, out := &in.Import, &out.Import
 		*out = new(ImportedAccount)
 		**out = **in
 	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]ImportedAccount, len(*in))
+		copy(*out, *in)
+	}
 	if in.Bootnodes != nil {
 		in, out := &in.Bootnodes, &out.Bootnodes
 		*out = make([]Enode, len(*in))
@@ -253,6 +394,11 @@ func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 		*out = make([]Enode, len(*in))
 		copy(*out, *in)
 	}
+	if in.P2P != nil {
+		in, out := &in.P2P, &out.P2P
+		*out = new(P2P)
+		**out = **in
+	}
 	if in.Hosts != nil {
 		in, out := &in.Hosts, &out.Hosts
 		*out = make([]string, len(*in))
@@ -273,6 +419,36 @@ func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 		*out = make([]API, len(*in))
 		copy(*out, *in)
 	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(shared.RPCGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Privacy != nil {
+		in, out := &in.Privacy, &out.Privacy
+		*out = new(Privacy)
+		**out = **in
+	}
+	if in.Performance != nil {
+		in, out := &in.Performance, &out.Performance
+		*out = new(Performance)
+		**out = **in
+	}
+	if in.Serve != nil {
+		in, out := &in.Serve, &out.Serve
+		*out = new(Serve)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TxPool != nil {
+		in, out := &in.TxPool, &out.TxPool
+		*out = new(TxPool)
+		**out = **in
+	}
+	if in.Mining != nil {
+		in, out := &in.Mining, &out.Mining
+		*out = new(Mining)
+		**out = **in
+	}
 	in.Resources.DeepCopyInto(&out.Resources)
 }
 
@@ -289,6 +465,26 @@ func (in *NodeSpec) DeepCopy() *NodeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	*out = *in
+	if in.UnresolvedReferences != nil {
+		in, out := &in.UnresolvedReferences, &out.UnresolvedReferences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BeaconNodes != nil {
+		in, out := &in.BeaconNodes, &out.BeaconNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GenesisMismatch != nil {
+		in, out := &in.GenesisMismatch, &out.GenesisMismatch
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DevAccounts != nil {
+		in, out := &in.DevAccounts, &out.DevAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatus.
@@ -301,6 +497,36 @@ func (in *NodeStatus) DeepCopy() *NodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *P2P) DeepCopyInto(out *P2P) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new P2P.
+func (in *P2P) DeepCopy() *P2P {
+	if in == nil {
+		return nil
+	}
+	out := new(P2P)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Performance) DeepCopyInto(out *Performance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Performance.
+func (in *Performance) DeepCopy() *Performance {
+	if in == nil {
+		return nil
+	}
+	out := new(Performance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PoA) DeepCopyInto(out *PoA) {
 	*out = *in
@@ -315,3 +541,252 @@ func (in *PoA) DeepCopy() *PoA {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Privacy) DeepCopyInto(out *Privacy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Privacy.
+func (in *Privacy) DeepCopy() *Privacy {
+	if in == nil {
+		return nil
+	}
+	out := new(Privacy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Serve) DeepCopyInto(out *Serve) {
+	*out = *in
+	if in.Snap != nil {
+		in, out := &in.Snap, &out.Snap
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Serve.
+func (in *Serve) DeepCopy() *Serve {
+	if in == nil {
+		return nil
+	}
+	out := new(Serve)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionManager) DeepCopyInto(out *TransactionManager) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionManager.
+func (in *TransactionManager) DeepCopy() *TransactionManager {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransactionManager) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionManagerList) DeepCopyInto(out *TransactionManagerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransactionManager, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionManagerList.
+func (in *TransactionManagerList) DeepCopy() *TransactionManagerList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionManagerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransactionManagerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionManagerSpec) DeepCopyInto(out *TransactionManagerSpec) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionManagerSpec.
+func (in *TransactionManagerSpec) DeepCopy() *TransactionManagerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionManagerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionManagerStatus) DeepCopyInto(out *TransactionManagerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionManagerStatus.
+func (in *TransactionManagerStatus) DeepCopy() *TransactionManagerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionManagerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TxPool) DeepCopyInto(out *TxPool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TxPool.
+func (in *TxPool) DeepCopy() *TxPool {
+	if in == nil {
+		return nil
+	}
+	out := new(TxPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorProposal) DeepCopyInto(out *ValidatorProposal) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorProposal.
+func (in *ValidatorProposal) DeepCopy() *ValidatorProposal {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorProposal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ValidatorProposal) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorProposalList) DeepCopyInto(out *ValidatorProposalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ValidatorProposal, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorProposalList.
+func (in *ValidatorProposalList) DeepCopy() *ValidatorProposalList {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorProposalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ValidatorProposalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorProposalSpec) DeepCopyInto(out *ValidatorProposalSpec) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorProposalSpec.
+func (in *ValidatorProposalSpec) DeepCopy() *ValidatorProposalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorProposalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorProposalStatus) DeepCopyInto(out *ValidatorProposalStatus) {
+	*out = *in
+	if in.Voters != nil {
+		in, out := &in.Voters, &out.Voters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnresolvedReferences != nil {
+		in, out := &in.UnresolvedReferences, &out.UnresolvedReferences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorProposalStatus.
+func (in *ValidatorProposalStatus) DeepCopy() *ValidatorProposalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorProposalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
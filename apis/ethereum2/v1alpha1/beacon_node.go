@@ -12,15 +12,24 @@ type BeaconNodeSpec struct {
 
 	// Network is the network to join
 	Network string `json:"network"`
+	// Genesis is custom network genesis, required for joining private networks
+	Genesis *BeaconGenesis `json:"genesis,omitempty"`
 	// Client is the Ethereum 2.0 client to use
 	Client Ethereum2Client `json:"client"`
 	// ExecutionEngineEndpoint is Ethereum Execution engine node endpoint
-	ExecutionEngineEndpoint string `json:"executionEngineEndpoint"`
+	ExecutionEngineEndpoint string `json:"executionEngineEndpoint,omitempty"`
+	// ExecutionEngineRef is kotal Ethereum node name in the beacon node namespace
+	// execution engine endpoint and JWT secret are resolved from referenced node service, engine port and JWT secret
+	ExecutionEngineRef string `json:"executionEngineRef,omitempty"`
 	// JWTSecretName is kubernetes secret name holding JWT secret
-	JWTSecretName string `json:"jwtSecretName"`
+	// JWT secret is shared from referenced execution engine if not provided
+	JWTSecretName string `json:"jwtSecretName,omitempty"`
 	// FeeRecipient is ethereum address collecting transaction fees
 	FeeRecipient shared.EthereumAddress `json:"feeRecipient,omitempty"`
 
+	// Builder is external block builder the beacon node gets execution payloads from
+	Builder *BeaconNodeBuilder `json:"builder,omitempty"`
+
 	// CheckpointSyncURL is trusted beacon node rest api endpoint
 	CheckpointSyncURL string `json:"checkpointSyncUrl,omitempty"`
 
@@ -28,6 +37,9 @@ type BeaconNodeSpec struct {
 	REST bool `json:"rest,omitempty"`
 	// RESTPort is Beacon REST API server port
 	RESTPort uint `json:"restPort,omitempty"`
+	// Gateway is authenticated gateway in front of Beacon REST API
+	// REST API server is bound to localhost, and is reachable only through the gateway
+	Gateway *shared.RPCGateway `json:"gateway,omitempty"`
 
 	// RPC enables RPC server
 	RPC bool `json:"rpc,omitempty"`
@@ -60,11 +72,39 @@ type BeaconNodeSpec struct {
 	shared.Resources `json:"resources,omitempty"`
 }
 
+// BeaconNodeBuilder is external block builder like mev-boost
+type BeaconNodeBuilder struct {
+	// Endpoint is block builder API endpoint
+	Endpoint string `json:"endpoint,omitempty"`
+	// MEVBoostRef is kotal MEVBoost name in the same namespace
+	// builder endpoint is resolved from referenced mev-boost service and port
+	MEVBoostRef string `json:"mevBoostRef,omitempty"`
+}
+
+// BeaconGenesis is custom beacon chain network genesis
+type BeaconGenesis struct {
+	// ConfigMapName is kubernetes config map name holding [config.yaml], [genesis.ssz] and [deploy_block.txt] keys
+	// genesis.ssz is binary, and must be provided in config map binaryData
+	ConfigMapName string `json:"configMapName"`
+	// Bootnodes is network bootnodes ENR records
+	// +listType=set
+	Bootnodes []string `json:"bootnodes,omitempty"`
+}
+
 // BeaconNodeStatus defines the observed state of BeaconNode
 type BeaconNodeStatus struct {
+	// ExecutionEngine is referenced execution engine node in the format of namespace/name
+	ExecutionEngine string `json:"executionEngine,omitempty"`
+	// ExecutionEngineEndpoint is resolved execution engine endpoint
+	ExecutionEngineEndpoint string `json:"executionEngineEndpoint,omitempty"`
+	// MEVBoost is referenced mev-boost in the format of namespace/name
+	MEVBoost string `json:"mevBoost,omitempty"`
+	// BuilderEndpoint is resolved block builder endpoint
+	BuilderEndpoint string `json:"builderEndpoint,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // BeaconNode is the Schema for the beaconnodes API
 // +kubebuilder:printcolumn:name="Client",type=string,JSONPath=".spec.client"
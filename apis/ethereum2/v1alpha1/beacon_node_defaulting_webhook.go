@@ -22,6 +22,8 @@ func (r *BeaconNode) Default() {
 			image = DefaultNimbusBeaconNodeImage
 		case LighthouseClient:
 			image = DefaultLighthouseBeaconNodeImage
+		case LodestarClient:
+			image = DefaultLodestarBeaconNodeImage
 		}
 
 		r.Spec.Image = image
@@ -39,6 +41,10 @@ func (r *BeaconNode) Default() {
 		r.Spec.RESTPort = DefaultRestPort
 	}
 
+	if r.Spec.Gateway != nil {
+		r.Spec.Gateway.Default()
+	}
+
 	if r.Spec.RPCPort == 0 {
 		r.Spec.RPCPort = DefaultRPCPort
 	}
@@ -2,7 +2,9 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/kotalco/kotal/apis/shared"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -20,6 +22,28 @@ func (r *BeaconNode) validate() field.ErrorList {
 
 	path := field.NewPath("spec")
 
+	// validate network is supported by client
+	if !r.Spec.Client.SupportsNetwork(r.Spec.Network) {
+		err := field.Invalid(path.Child("network"), r.Spec.Network, fmt.Sprintf("not supported by client %s", r.Spec.Client))
+		nodeErrors = append(nodeErrors, err)
+	} else if version := r.Spec.Client.NetworkMinimumVersion(r.Spec.Network); !shared.ImageVersionAtLeast(r.Spec.Image, version) {
+		// networks launched after client image can't be joined
+		err := field.Invalid(path.Child("image"), r.Spec.Image, fmt.Sprintf("must be client %s version %s or later to join network %s", r.Spec.Client, version, r.Spec.Network))
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	// public networks genesis is built into clients
+	if r.Spec.Genesis != nil && PublicNetworks[r.Spec.Network] {
+		err := field.Invalid(path.Child("genesis"), r.Spec.Genesis.ConfigMapName, fmt.Sprintf("can't be used with public network %s", r.Spec.Network))
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	// custom networks genesis isn't known to clients
+	if r.Spec.Genesis == nil && !PublicNetworks[r.Spec.Network] {
+		err := field.Invalid(path.Child("genesis"), "", fmt.Sprintf("must provide genesis for custom network %s", r.Spec.Network))
+		nodeErrors = append(nodeErrors, err)
+	}
+
 	// rest is supported by all clients except prysm
 	if r.Spec.REST && r.Spec.Client == PrysmClient {
 		err := field.Invalid(path.Child("rest"), r.Spec.REST, fmt.Sprintf("not supported by %s client", r.Spec.Client))
@@ -50,15 +74,82 @@ func (r *BeaconNode) validate() field.ErrorList {
 		nodeErrors = append(nodeErrors, err)
 	}
 
+	// validate execution engine is provided either by endpoint or reference
+	if r.Spec.ExecutionEngineEndpoint == "" && r.Spec.ExecutionEngineRef == "" {
+		err := field.Invalid(path.Child("executionEngineEndpoint"), "", "must provide executionEngineEndpoint or executionEngineRef")
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	// validate execution engine endpoint and reference can't be used together
+	if r.Spec.ExecutionEngineEndpoint != "" && r.Spec.ExecutionEngineRef != "" {
+		err := field.Invalid(path.Child("executionEngineRef"), r.Spec.ExecutionEngineRef, "can't be provided with executionEngineEndpoint")
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	// execution engine JWT secret is shared with beacon nodes in the same namespace only
+	if strings.Contains(r.Spec.ExecutionEngineRef, ".") {
+		err := field.Invalid(path.Child("executionEngineRef"), r.Spec.ExecutionEngineRef, "must be a node in the same namespace")
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	// validate jwt secret is provided if execution engine endpoint is used
+	// jwt secret is shared from execution engine if reference is used
+	if r.Spec.ExecutionEngineEndpoint != "" && r.Spec.JWTSecretName == "" {
+		err := field.Invalid(path.Child("jwtSecretName"), "", "must provide jwtSecretName if executionEngineEndpoint is provided")
+		nodeErrors = append(nodeErrors, err)
+	}
+
+	// validate builder is provided either by endpoint or mev-boost reference
+	if r.Spec.Builder != nil {
+		if r.Spec.Builder.Endpoint == "" && r.Spec.Builder.MEVBoostRef == "" {
+			err := field.Invalid(path.Child("builder").Child("endpoint"), "", "must provide endpoint or mevBoostRef")
+			nodeErrors = append(nodeErrors, err)
+		}
+
+		if r.Spec.Builder.Endpoint != "" && r.Spec.Builder.MEVBoostRef != "" {
+			err := field.Invalid(path.Child("builder").Child("mevBoostRef"), r.Spec.Builder.MEVBoostRef, "can't be provided with endpoint")
+			nodeErrors = append(nodeErrors, err)
+		}
+
+		if strings.Contains(r.Spec.Builder.MEVBoostRef, ".") {
+			err := field.Invalid(path.Child("builder").Child("mevBoostRef"), r.Spec.Builder.MEVBoostRef, "must be a mev-boost in the same namespace")
+			nodeErrors = append(nodeErrors, err)
+		}
+	}
+
+	if r.Spec.Gateway != nil {
+		nodeErrors = append(nodeErrors, r.Spec.Gateway.Validate()...)
+	}
+
+	// validate gateway has rest api server to proxy
+	if r.Spec.Gateway != nil && !r.Spec.REST {
+		err := field.Invalid(path.Child("gateway"), "", "must enable rest if gateway is provided")
+		nodeErrors = append(nodeErrors, err)
+	}
+
 	// rpc is always on in prysm
 	if r.Spec.Client == PrysmClient && !r.Spec.RPC {
 		err := field.Invalid(path.Child("rpc"), r.Spec.RPC, "can't be disabled in prysm client")
 		nodeErrors = append(nodeErrors, err)
 	}
 
+	// rest is always on in lodestar, validator clients connect to lodestar beacon node through rest only
+	if r.Spec.Client == LodestarClient && !r.Spec.REST {
+		err := field.Invalid(path.Child("rest"), r.Spec.REST, "can't be disabled in lodestar client")
+		nodeErrors = append(nodeErrors, err)
+	}
+
 	return nodeErrors
 }
 
+// Warnings returns node admission warnings
+func (r *BeaconNode) Warnings() (warnings []string) {
+	if DeprecatedNetworks[r.Spec.Network] {
+		warnings = append(warnings, DeprecatedNetworkWarning(r.Spec.Network))
+	}
+	return
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *BeaconNode) ValidateCreate() error {
 	var allErrors field.ErrorList
@@ -97,6 +188,11 @@ func (r *BeaconNode) ValidateUpdate(old runtime.Object) error {
 		allErrors = append(allErrors, err)
 	}
 
+	if genesisConfigMapName(oldNode) != genesisConfigMapName(r) {
+		err := field.Invalid(path.Child("genesis").Child("configMapName"), genesisConfigMapName(r), "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
 	if len(allErrors) == 0 {
 		return nil
 	}
@@ -104,6 +200,14 @@ func (r *BeaconNode) ValidateUpdate(old runtime.Object) error {
 	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
 }
 
+// genesisConfigMapName returns beacon node custom genesis config map name, or empty string if genesis isn't provided
+func genesisConfigMapName(node *BeaconNode) string {
+	if node.Spec.Genesis == nil {
+		return ""
+	}
+	return node.Spec.Genesis.ConfigMapName
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *BeaconNode) ValidateDelete() error {
 	nodelog.Info("validate delete", "name", r.Name)
@@ -142,6 +142,290 @@ var _ = Describe("Ethereum 2.0 beacon node validation", func() {
 				},
 			},
 		},
+		{
+			Title: "Node #10",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network: "mainnet",
+					Client:  TekuClient,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.executionEngineEndpoint",
+					BadValue: "",
+					Detail:   "must provide executionEngineEndpoint or executionEngineRef",
+				},
+			},
+		},
+		{
+			Title: "Node #11",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:                 "mainnet",
+					Client:                  TekuClient,
+					ExecutionEngineEndpoint: "http://geth-node:8551",
+					ExecutionEngineRef:      "geth-node",
+					JWTSecretName:           "jwt-secret",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.executionEngineRef",
+					BadValue: "geth-node",
+					Detail:   "can't be provided with executionEngineEndpoint",
+				},
+			},
+		},
+		{
+			Title: "Node #12",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:                 "mainnet",
+					Client:                  TekuClient,
+					ExecutionEngineEndpoint: "http://geth-node:8551",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.jwtSecretName",
+					BadValue: "",
+					Detail:   "must provide jwtSecretName if executionEngineEndpoint is provided",
+				},
+			},
+		},
+		{
+			Title: "Node #13",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:                 "chiado",
+					Client:                  NimbusClient,
+					ExecutionEngineEndpoint: "http://nethermind-node:8551",
+					JWTSecretName:           "jwt-secret",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.network",
+					BadValue: "chiado",
+					Detail:   "not supported by client nimbus",
+				},
+			},
+		},
+		{
+			Title: "Node #14",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "mainnet",
+					Client:             TekuClient,
+					ExecutionEngineRef: "geth-node",
+					Gateway: &shared.RPCGateway{
+						JWTSecretName: "gateway-jwt",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.gateway",
+					BadValue: "",
+					Detail:   "must enable rest if gateway is provided",
+				},
+			},
+		},
+		{
+			Title: "Node #15",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "mainnet",
+					Client:             LodestarClient,
+					ExecutionEngineRef: "geth-node",
+					RPC:                true,
+					Logging:            shared.CriticalLogs,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.rpc",
+					BadValue: true,
+					Detail:   "not supported by lodestar client",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.logging",
+					BadValue: shared.CriticalLogs,
+					Detail:   "not supported by lodestar client",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.rest",
+					BadValue: false,
+					Detail:   "can't be disabled in lodestar client",
+				},
+			},
+		},
+		{
+			Title: "Node #16",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "mainnet",
+					Client:             TekuClient,
+					ExecutionEngineRef: "geth-node",
+					Builder:            &BeaconNodeBuilder{},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.builder.endpoint",
+					BadValue: "",
+					Detail:   "must provide endpoint or mevBoostRef",
+				},
+			},
+		},
+		{
+			Title: "Node #17",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "mainnet",
+					Client:             LighthouseClient,
+					ExecutionEngineRef: "geth-node",
+					Builder: &BeaconNodeBuilder{
+						Endpoint:    "http://mev-boost:18550",
+						MEVBoostRef: "mev-boost",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.builder.mevBoostRef",
+					BadValue: "mev-boost",
+					Detail:   "can't be provided with endpoint",
+				},
+			},
+		},
+		{
+			Title: "Node #18",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "mainnet",
+					Client:             LighthouseClient,
+					ExecutionEngineRef: "geth-node",
+					Genesis: &BeaconGenesis{
+						ConfigMapName: "devnet-genesis",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis",
+					BadValue: "devnet-genesis",
+					Detail:   "can't be used with public network mainnet",
+				},
+			},
+		},
+		{
+			Title: "Node #19",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "mainnet",
+					Client:             TekuClient,
+					ExecutionEngineRef: "geth-node.other-namespace",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.executionEngineRef",
+					BadValue: "geth-node.other-namespace",
+					Detail:   "must be a node in the same namespace",
+				},
+			},
+		},
+		{
+			Title: "Node #20",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "hoodi",
+					Client:             LighthouseClient,
+					ExecutionEngineRef: "geth-node",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.image",
+					BadValue: DefaultLighthouseBeaconNodeImage,
+					Detail:   "must be client lighthouse version 7.0.0 or later to join network hoodi",
+				},
+			},
+		},
+		{
+			Title: "Node #21",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "mainnet",
+					Client:             TekuClient,
+					ExecutionEngineRef: "geth-node",
+					Builder: &BeaconNodeBuilder{
+						MEVBoostRef: "my-boost.default",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.builder.mevBoostRef",
+					BadValue: "my-boost.default",
+					Detail:   "must be a mev-boost in the same namespace",
+				},
+			},
+		},
+		{
+			Title: "Node #22",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:            "devnet",
+					Client:             TekuClient,
+					ExecutionEngineRef: "geth-node",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis",
+					BadValue: "",
+					Detail:   "must provide genesis for custom network devnet",
+				},
+			},
+		},
+		{
+			Title: "Node #23",
+			Node: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network:                 "chiado",
+					Client:                  LodestarClient,
+					REST:                    true,
+					ExecutionEngineEndpoint: "http://nethermind-node:8551",
+					JWTSecretName:           "jwt-secret",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.image",
+					BadValue: DefaultLodestarBeaconNodeImage,
+					Detail:   "must be client lodestar version 1.4.0 or later to join network chiado",
+				},
+			},
+		},
 	}
 
 	updateCases := []struct {
@@ -196,6 +480,35 @@ var _ = Describe("Ethereum 2.0 beacon node validation", func() {
 				},
 			},
 		},
+		{
+			Title: "Node #3",
+			OldNode: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network: "devnet",
+					Client:  TekuClient,
+					Genesis: &BeaconGenesis{
+						ConfigMapName: "devnet-genesis",
+					},
+				},
+			},
+			NewNode: &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network: "devnet",
+					Client:  TekuClient,
+					Genesis: &BeaconGenesis{
+						ConfigMapName: "devnet-2-genesis",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis.configMapName",
+					BadValue: "devnet-2-genesis",
+					Detail:   "field is immutable",
+				},
+			},
+		},
 	}
 
 	Context("While creating beacon node", func() {
@@ -237,4 +550,26 @@ var _ = Describe("Ethereum 2.0 beacon node validation", func() {
 		}
 	})
 
+	Context("While joining deprecated network", func() {
+		It("Should warn about deprecated network", func() {
+			node := &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network: PraterNetwork,
+					Client:  TekuClient,
+				},
+			}
+			Expect(node.Warnings()).To(ConsistOf(DeprecatedNetworkWarning(PraterNetwork)))
+		})
+
+		It("Should not warn about live network", func() {
+			node := &BeaconNode{
+				Spec: BeaconNodeSpec{
+					Network: HoodiNetwork,
+					Client:  LighthouseClient,
+				},
+			}
+			Expect(node.Warnings()).To(BeEmpty())
+		})
+	})
+
 })
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"github.com/kotalco/kotal/apis/shared"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -10,6 +11,7 @@ var nodelog = logf.Log.WithName("node-resource")
 
 // SetupWebhookWithManager sets up the webook with a given controller manager
 func (r *BeaconNode) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	shared.RegisterValidatingWebhookWithWarnings(mgr, "/validate-ethereum2-kotal-io-v1alpha1-beaconnode", r)
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
@@ -3,7 +3,7 @@ package v1alpha1
 import "github.com/kotalco/kotal/apis/shared"
 
 // Ethereum2Client is Ethereum 2.0 client
-// +kubebuilder:validation:Enum=teku;prysm;lighthouse;nimbus
+// +kubebuilder:validation:Enum=teku;prysm;lighthouse;nimbus;lodestar
 type Ethereum2Client string
 
 const (
@@ -15,6 +15,8 @@ const (
 	LighthouseClient Ethereum2Client = "lighthouse"
 	// NimbusClient is Status Ethereum 2.0 client
 	NimbusClient Ethereum2Client = "nimbus"
+	// LodestarClient is ChainSafe Ethereum 2.0 client
+	LodestarClient Ethereum2Client = "lodestar"
 )
 
 func (client Ethereum2Client) SupportsVerbosityLevel(level shared.VerbosityLevel, validator bool) bool {
@@ -68,7 +70,75 @@ func (client Ethereum2Client) SupportsVerbosityLevel(level shared.VerbosityLevel
 			shared.NoneLogs:
 			return true
 		}
+
+	case LodestarClient:
+		switch level {
+		case shared.ErrorLogs,
+			shared.WarnLogs,
+			shared.InfoLogs,
+			shared.DebugLogs,
+			shared.TraceLogs:
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsMultipleBeaconEndpoints returns true if validator client can use multiple beacon nodes
+func (client Ethereum2Client) SupportsMultipleBeaconEndpoints() bool {
+	switch client {
+	case LighthouseClient, LodestarClient:
+		return true
 	}
+	return false
+}
 
+// SupportsPerKeyGraffiti returns true if validator client can include different graffiti per validator key
+func (client Ethereum2Client) SupportsPerKeyGraffiti() bool {
+	switch client {
+	case LighthouseClient, LodestarClient:
+		return true
+	}
 	return false
 }
+
+// networkClientVersions is the minimum client versions joining networks launched after client default images
+// networks not listed here aren't validated, they're public networks joined by all clients as before, or custom networks
+var networkClientVersions = map[string]map[Ethereum2Client]string{
+	HoleskyNetwork: {
+		TekuClient:       "23.9.1",
+		PrysmClient:      "4.1.0",
+		LighthouseClient: "4.5.0",
+		NimbusClient:     "23.9.1",
+		LodestarClient:   "1.11.1",
+	},
+	HoodiNetwork: {
+		TekuClient:       "25.3.0",
+		PrysmClient:      "5.3.1",
+		LighthouseClient: "7.0.0",
+		NimbusClient:     "25.3.0",
+		LodestarClient:   "1.28.0",
+	},
+	ChiadoNetwork: {
+		TekuClient:       "23.1.0",
+		LighthouseClient: "3.5.0",
+		LodestarClient:   "1.4.0",
+	},
+}
+
+// SupportsNetwork returns true if client can join network
+func (client Ethereum2Client) SupportsNetwork(network string) bool {
+	versions, ok := networkClientVersions[network]
+	if !ok {
+		return true
+	}
+	_, ok = versions[client]
+	return ok
+}
+
+// NetworkMinimumVersion returns minimum client version joining network
+// empty version is returned if network can be joined by any client version
+func (client Ethereum2Client) NetworkMinimumVersion(network string) string {
+	return networkClientVersions[network][client]
+}
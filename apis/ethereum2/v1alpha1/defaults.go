@@ -33,6 +33,8 @@ const (
 	DefaultPrysmBeaconNodeImage = "kotalco/prysm:v3.1.2"
 	// DefaultNimbusBeaconNodeImage is the default Status Ethereum 2.0 beacon node image
 	DefaultNimbusBeaconNodeImage = "kotalco/nimbus:v22.10.1"
+	// DefaultLodestarBeaconNodeImage is the default ChainSafe Ethereum 2.0 beacon node image
+	DefaultLodestarBeaconNodeImage = "chainsafe/lodestar:v1.2.1"
 )
 
 const (
@@ -44,6 +46,50 @@ const (
 	DefaultNimbusValidatorImage = "kotalco/nimbus:v22.10.1"
 	// DefaultLighthouseValidatorImage is the default SigmaPrime Ethereum 2.0 validator client image
 	DefaultLighthouseValidatorImage = "kotalco/lighthouse:v3.3.0"
+	// DefaultLodestarValidatorImage is the default ChainSafe Ethereum 2.0 validator client image
+	DefaultLodestarValidatorImage = "chainsafe/lodestar:v1.2.1"
+)
+
+const (
+	// DefaultWeb3SignerImage is ConsenSys Web3Signer remote signer image
+	DefaultWeb3SignerImage = "consensys/web3signer:22.11.0"
+	// DefaultRemoteSignerPort is the default remote signer HTTP server port
+	DefaultRemoteSignerPort uint = 9000
+	// DefaultRemoteSignerCPURequest is the default CPU cores required by remote signer
+	DefaultRemoteSignerCPURequest = "1"
+	// DefaultRemoteSignerCPULimit is the default CPU cores limit by remote signer
+	DefaultRemoteSignerCPULimit = "2"
+	// DefaultRemoteSignerMemoryRequest is the default memory required by remote signer
+	DefaultRemoteSignerMemoryRequest = "1Gi"
+	// DefaultRemoteSignerMemoryLimit is the default memory limit by remote signer
+	DefaultRemoteSignerMemoryLimit = "2Gi"
+)
+
+const (
+	// DefaultMEVBoostImage is Flashbots mev-boost image
+	DefaultMEVBoostImage = "flashbots/mev-boost:1.9"
+	// DefaultMEVBoostPort is the default mev-boost builder API server port
+	DefaultMEVBoostPort uint = 18550
+	// DefaultMEVBoostCPURequest is the default CPU cores required by mev-boost
+	DefaultMEVBoostCPURequest = "500m"
+	// DefaultMEVBoostCPULimit is the default CPU cores limit by mev-boost
+	DefaultMEVBoostCPULimit = "1"
+	// DefaultMEVBoostMemoryRequest is the default memory required by mev-boost
+	DefaultMEVBoostMemoryRequest = "256Mi"
+	// DefaultMEVBoostMemoryLimit is the default memory limit by mev-boost
+	DefaultMEVBoostMemoryLimit = "512Mi"
+	// DefaultBuilderGasLimit is the default gas limit validators register with block builders
+	DefaultBuilderGasLimit uint64 = 30000000
+)
+
+const (
+	// DefaultMigrationEpochs is the default number of epochs migrated validator waits before starting
+	DefaultMigrationEpochs uint64 = 2
+)
+
+const (
+	// DefaultDepositAmount is the default validator deposit amount in gwei
+	DefaultDepositAmount uint64 = 32000000000
 )
 
 const (
@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	"github.com/kotalco/kotal/apis/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MEVBoostSpec defines the desired state of MEVBoost
+type MEVBoostSpec struct {
+	// Image is Flashbots mev-boost image
+	Image string `json:"image,omitempty"`
+	// Network is the network block builders are building blocks for
+	// +kubebuilder:validation:Enum=mainnet;sepolia;holesky;hoodi
+	Network string `json:"network"`
+	// Port is mev-boost builder API server port
+	Port uint `json:"port,omitempty"`
+	// Relays is a list of relay urls in the format of https://pubkey@host
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Relays []string `json:"relays"`
+	// MinBid is the minimum bid in ether accepted from relays
+	// beacon nodes build blocks locally if relays bids are lower than min bid
+	// +kubebuilder:validation:Pattern="^[0-9]+(\\.[0-9]+)?$"
+	MinBid string `json:"minBid,omitempty"`
+	// RelayCheck checks relays are reachable on startup and on beacon nodes status requests
+	RelayCheck bool `json:"relayCheck,omitempty"`
+	// Logging is logging verboisty level
+	// +kubebuilder:validation:Enum=trace;debug;info;warn;error;fatal;panic
+	Logging shared.VerbosityLevel `json:"logging,omitempty"`
+	// Resources is mev-boost compute resources
+	shared.Resources `json:"resources,omitempty"`
+}
+
+// MEVBoostStatus defines the observed state of MEVBoost
+type MEVBoostStatus struct {
+	// URL is mev-boost in-cluster url
+	URL string `json:"url,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// MEVBoost is the Schema for the mevboosts API
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.network"
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=".status.url",priority=10
+type MEVBoost struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MEVBoostSpec   `json:"spec,omitempty"`
+	Status MEVBoostStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MEVBoostList contains a list of MEVBoost
+type MEVBoostList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MEVBoost `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MEVBoost{}, &MEVBoostList{})
+}
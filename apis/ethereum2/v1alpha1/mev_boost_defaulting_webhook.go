@@ -0,0 +1,40 @@
+package v1alpha1
+
+import "sigs.k8s.io/controller-runtime/pkg/webhook"
+
+// +kubebuilder:webhook:path=/mutate-ethereum2-kotal-io-v1alpha1-mevboost,mutating=true,failurePolicy=fail,groups=ethereum2.kotal.io,resources=mevboosts,verbs=create;update,versions=v1alpha1,name=mutate-ethereum2-v1alpha1-mevboost.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &MEVBoost{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *MEVBoost) Default() {
+	mevboostlog.Info("default", "name", r.Name)
+
+	if r.Spec.Image == "" {
+		r.Spec.Image = DefaultMEVBoostImage
+	}
+
+	if r.Spec.Port == 0 {
+		r.Spec.Port = DefaultMEVBoostPort
+	}
+
+	if r.Spec.Logging == "" {
+		r.Spec.Logging = DefaultLogging
+	}
+
+	if r.Spec.Resources.CPU == "" {
+		r.Spec.Resources.CPU = DefaultMEVBoostCPURequest
+	}
+
+	if r.Spec.Resources.CPULimit == "" {
+		r.Spec.Resources.CPULimit = DefaultMEVBoostCPULimit
+	}
+
+	if r.Spec.Resources.Memory == "" {
+		r.Spec.Resources.Memory = DefaultMEVBoostMemoryRequest
+	}
+
+	if r.Spec.Resources.MemoryLimit == "" {
+		r.Spec.Resources.MemoryLimit = DefaultMEVBoostMemoryLimit
+	}
+}
@@ -0,0 +1,26 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ethereum 2.0 mev-boost defaulting", func() {
+
+	It("Should default mev-boost with missing image, port, logging and resources", func() {
+		boost := MEVBoost{
+			Spec: MEVBoostSpec{
+				Network: "mainnet",
+			},
+		}
+		boost.Default()
+		Expect(boost.Spec.Image).To(Equal(DefaultMEVBoostImage))
+		Expect(boost.Spec.Port).To(Equal(DefaultMEVBoostPort))
+		Expect(boost.Spec.Logging).To(Equal(DefaultLogging))
+		Expect(boost.Spec.Resources.CPU).To(Equal(DefaultMEVBoostCPURequest))
+		Expect(boost.Spec.Resources.CPULimit).To(Equal(DefaultMEVBoostCPULimit))
+		Expect(boost.Spec.Resources.Memory).To(Equal(DefaultMEVBoostMemoryRequest))
+		Expect(boost.Spec.Resources.MemoryLimit).To(Equal(DefaultMEVBoostMemoryLimit))
+	})
+
+})
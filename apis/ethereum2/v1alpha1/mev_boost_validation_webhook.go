@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	"net/url"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ethereum2-kotal-io-v1alpha1-mevboost,mutating=false,failurePolicy=fail,groups=ethereum2.kotal.io,resources=mevboosts,versions=v1alpha1,name=validate-ethereum2-v1alpha1-mevboost.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &MEVBoost{}
+
+// validate validates mev-boost
+func (r *MEVBoost) validate() field.ErrorList {
+	var boostErrors field.ErrorList
+
+	// mev-boost has no custom genesis support, network must be known to mev-boost
+	if !MEVBoostNetworks[r.Spec.Network] {
+		err := field.Invalid(field.NewPath("spec").Child("network"), r.Spec.Network, "network is not supported by mev-boost")
+		boostErrors = append(boostErrors, err)
+	}
+
+	// mev-boost verifies relays bids using relay public key in relay url
+	for i, relay := range r.Spec.Relays {
+		u, err := url.Parse(relay)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.User.Username() == "" || u.Host == "" {
+			err := field.Invalid(field.NewPath("spec").Child("relays").Index(i), relay, "must be relay url in the format of https://pubkey@host")
+			boostErrors = append(boostErrors, err)
+		}
+	}
+
+	return boostErrors
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *MEVBoost) ValidateCreate() error {
+	var allErrors field.ErrorList
+
+	mevboostlog.Info("validate create", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.Spec.Resources.ValidateCreate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *MEVBoost) ValidateUpdate(old runtime.Object) error {
+	var allErrors field.ErrorList
+	oldBoost := old.(*MEVBoost)
+
+	mevboostlog.Info("validate update", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.Spec.Resources.ValidateUpdate(&oldBoost.Spec.Resources)...)
+
+	if oldBoost.Spec.Network != r.Spec.Network {
+		err := field.Invalid(field.NewPath("spec").Child("network"), r.Spec.Network, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *MEVBoost) ValidateDelete() error {
+	mevboostlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
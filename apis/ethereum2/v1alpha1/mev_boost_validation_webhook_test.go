@@ -0,0 +1,158 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Ethereum 2.0 mev-boost validation", func() {
+
+	relay := "https://0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae@boost-relay.flashbots.net"
+
+	createCases := []struct {
+		Title  string
+		Boost  *MEVBoost
+		Errors field.ErrorList
+	}{
+		{
+			Title: "MEV boost #1",
+			Boost: &MEVBoost{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "boost-1",
+				},
+				Spec: MEVBoostSpec{
+					Network: "mainnet",
+					Relays:  []string{relay, "https://boost-relay.flashbots.net"},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.relays[1]",
+					BadValue: "https://boost-relay.flashbots.net",
+					Detail:   "must be relay url in the format of https://pubkey@host",
+				},
+			},
+		},
+		{
+			Title: "MEV boost #2",
+			Boost: &MEVBoost{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "boost-2",
+				},
+				Spec: MEVBoostSpec{
+					Network: "mainnet",
+					Relays:  []string{"0xac6e77df@boost-relay.flashbots.net"},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.relays[0]",
+					BadValue: "0xac6e77df@boost-relay.flashbots.net",
+					Detail:   "must be relay url in the format of https://pubkey@host",
+				},
+			},
+		},
+		{
+			Title: "MEV boost #3",
+			Boost: &MEVBoost{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "boost-3",
+				},
+				Spec: MEVBoostSpec{
+					Network: "my-network",
+					Relays:  []string{relay},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.network",
+					BadValue: "my-network",
+					Detail:   "network is not supported by mev-boost",
+				},
+			},
+		},
+	}
+
+	updateCases := []struct {
+		Title    string
+		OldBoost *MEVBoost
+		NewBoost *MEVBoost
+		Errors   field.ErrorList
+	}{
+		{
+			Title: "MEV boost #1",
+			OldBoost: &MEVBoost{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "boost-1",
+				},
+				Spec: MEVBoostSpec{
+					Network: "mainnet",
+					Relays:  []string{relay},
+				},
+			},
+			NewBoost: &MEVBoost{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "boost-1",
+				},
+				Spec: MEVBoostSpec{
+					Network: "sepolia",
+					Relays:  []string{relay},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.network",
+					BadValue: "sepolia",
+					Detail:   "field is immutable",
+				},
+			},
+		},
+	}
+
+	Context("While creating mev-boost", func() {
+		for _, c := range createCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.Boost.Default()
+					err := cc.Boost.ValidateCreate()
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+
+	Context("While updating mev-boost", func() {
+		for _, c := range updateCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.OldBoost.Default()
+					cc.NewBoost.Default()
+					err := cc.NewBoost.ValidateUpdate(cc.OldBoost)
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+})
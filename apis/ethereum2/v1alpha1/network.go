@@ -0,0 +1,65 @@
+package v1alpha1
+
+import "fmt"
+
+const (
+	// MainNetwork is ethereum beacon chain main network
+	MainNetwork = "mainnet"
+	// SepoliaNetwork is sepolia test network
+	SepoliaNetwork = "sepolia"
+	// HoleskyNetwork is holesky staking and infrastructure test network
+	HoleskyNetwork = "holesky"
+	// HoodiNetwork is hoodi validators test network
+	HoodiNetwork = "hoodi"
+	// GnosisNetwork is gnosis chain beacon chain network
+	GnosisNetwork = "gnosis"
+	// ChiadoNetwork is gnosis chain chiado test network
+	ChiadoNetwork = "chiado"
+	// GoerliNetwork is goerli test network
+	GoerliNetwork = "goerli"
+	// PraterNetwork is goerli test network beacon chain
+	PraterNetwork = "prater"
+	// RopstenNetwork is ropsten test network
+	RopstenNetwork = "ropsten"
+)
+
+// GenesisForkVersions is networks genesis fork version used to sign validator deposits
+var GenesisForkVersions = map[string]string{
+	MainNetwork:    "0x00000000",
+	SepoliaNetwork: "0x90000069",
+	HoleskyNetwork: "0x01017000",
+	HoodiNetwork:   "0x10000910",
+}
+
+// PublicNetworks is networks known to clients, that don't require custom genesis
+var PublicNetworks = map[string]bool{
+	MainNetwork:    true,
+	SepoliaNetwork: true,
+	HoleskyNetwork: true,
+	HoodiNetwork:   true,
+	GnosisNetwork:  true,
+	ChiadoNetwork:  true,
+	GoerliNetwork:  true,
+	PraterNetwork:  true,
+	RopstenNetwork: true,
+}
+
+// MEVBoostNetworks is networks known to mev-boost, selected by -<network> flag
+var MEVBoostNetworks = map[string]bool{
+	MainNetwork:    true,
+	SepoliaNetwork: true,
+	HoleskyNetwork: true,
+	HoodiNetwork:   true,
+}
+
+// DeprecatedNetworks is retired networks that are no longer running
+var DeprecatedNetworks = map[string]bool{
+	GoerliNetwork:  true,
+	PraterNetwork:  true,
+	RopstenNetwork: true,
+}
+
+// DeprecatedNetworkWarning returns warning of joining retired network
+func DeprecatedNetworkWarning(network string) string {
+	return fmt.Sprintf("network %s is deprecated and no longer running, use %s or %s test networks", network, SepoliaNetwork, HoodiNetwork)
+}
@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	"github.com/kotalco/kotal/apis/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RemoteSignerSpec defines the desired state of RemoteSigner
+type RemoteSignerSpec struct {
+	// Image is Web3Signer remote signer image
+	Image string `json:"image,omitempty"`
+	// Network is the network validator keys are signing for
+	Network string `json:"network"`
+	// Port is remote signer HTTP server port
+	Port uint `json:"port,omitempty"`
+	// Hosts is a list of hostnames to to whitelist for API access
+	// +listType=set
+	Hosts []string `json:"hosts,omitempty"`
+	// CORSDomains is the domains from which to accept cross origin requests
+	// +listType=set
+	CORSDomains []string `json:"corsDomains,omitempty"`
+	// Keystores is a list of Validator keystores loaded by the remote signer
+	// +kubebuilder:validation:MinItems=1
+	Keystores []Keystore `json:"keystores"`
+	// SlashingProtectionDatabaseURL is slashing protection Postgres database JDBC url
+	// like jdbc:postgresql://postgres:5432/web3signer
+	// database schema must be migrated using Web3Signer migrations before deploying the remote signer
+	SlashingProtectionDatabaseURL string `json:"slashingProtectionDatabaseUrl"`
+	// SlashingProtectionDatabaseSecretName is kubernetes secret name holding database [username] and [password]
+	SlashingProtectionDatabaseSecretName string `json:"slashingProtectionDatabaseSecretName,omitempty"`
+	// Logging is logging verboisty level
+	// +kubebuilder:validation:Enum=off;fatal;error;warn;info;debug;trace;all
+	Logging shared.VerbosityLevel `json:"logging,omitempty"`
+	// Resources is remote signer compute resources
+	shared.Resources `json:"resources,omitempty"`
+}
+
+// RemoteSignerStatus defines the observed state of RemoteSigner
+type RemoteSignerStatus struct {
+	// URL is remote signer in-cluster url
+	URL string `json:"url,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// RemoteSigner is the Schema for the remotesigners API
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.network"
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=".status.url",priority=10
+type RemoteSigner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RemoteSignerSpec   `json:"spec,omitempty"`
+	Status RemoteSignerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RemoteSignerList contains a list of RemoteSigner
+type RemoteSignerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RemoteSigner `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RemoteSigner{}, &RemoteSignerList{})
+}
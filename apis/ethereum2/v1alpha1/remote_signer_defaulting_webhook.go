@@ -0,0 +1,48 @@
+package v1alpha1
+
+import "sigs.k8s.io/controller-runtime/pkg/webhook"
+
+// +kubebuilder:webhook:path=/mutate-ethereum2-kotal-io-v1alpha1-remotesigner,mutating=true,failurePolicy=fail,groups=ethereum2.kotal.io,resources=remotesigners,verbs=create;update,versions=v1alpha1,name=mutate-ethereum2-v1alpha1-remotesigner.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &RemoteSigner{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *RemoteSigner) Default() {
+	remotesignerlog.Info("default", "name", r.Name)
+
+	if r.Spec.Image == "" {
+		r.Spec.Image = DefaultWeb3SignerImage
+	}
+
+	if r.Spec.Port == 0 {
+		r.Spec.Port = DefaultRemoteSignerPort
+	}
+
+	if len(r.Spec.Hosts) == 0 {
+		r.Spec.Hosts = DefaultOrigins
+	}
+
+	if len(r.Spec.CORSDomains) == 0 {
+		r.Spec.CORSDomains = DefaultOrigins
+	}
+
+	if r.Spec.Logging == "" {
+		r.Spec.Logging = DefaultLogging
+	}
+
+	if r.Spec.Resources.CPU == "" {
+		r.Spec.Resources.CPU = DefaultRemoteSignerCPURequest
+	}
+
+	if r.Spec.Resources.CPULimit == "" {
+		r.Spec.Resources.CPULimit = DefaultRemoteSignerCPULimit
+	}
+
+	if r.Spec.Resources.Memory == "" {
+		r.Spec.Resources.Memory = DefaultRemoteSignerMemoryRequest
+	}
+
+	if r.Spec.Resources.MemoryLimit == "" {
+		r.Spec.Resources.MemoryLimit = DefaultRemoteSignerMemoryLimit
+	}
+}
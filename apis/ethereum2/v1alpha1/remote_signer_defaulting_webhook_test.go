@@ -0,0 +1,28 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ethereum 2.0 remote signer defaulting", func() {
+
+	It("Should default remote signer with missing image, port, hosts, cors domains, logging and resources", func() {
+		signer := RemoteSigner{
+			Spec: RemoteSignerSpec{
+				Network: "mainnet",
+			},
+		}
+		signer.Default()
+		Expect(signer.Spec.Image).To(Equal(DefaultWeb3SignerImage))
+		Expect(signer.Spec.Port).To(Equal(DefaultRemoteSignerPort))
+		Expect(signer.Spec.Hosts).To(Equal(DefaultOrigins))
+		Expect(signer.Spec.CORSDomains).To(Equal(DefaultOrigins))
+		Expect(signer.Spec.Logging).To(Equal(DefaultLogging))
+		Expect(signer.Spec.Resources.CPU).To(Equal(DefaultRemoteSignerCPURequest))
+		Expect(signer.Spec.Resources.CPULimit).To(Equal(DefaultRemoteSignerCPULimit))
+		Expect(signer.Spec.Resources.Memory).To(Equal(DefaultRemoteSignerMemoryRequest))
+		Expect(signer.Spec.Resources.MemoryLimit).To(Equal(DefaultRemoteSignerMemoryLimit))
+	})
+
+})
@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ethereum2-kotal-io-v1alpha1-remotesigner,mutating=false,failurePolicy=fail,groups=ethereum2.kotal.io,resources=remotesigners,versions=v1alpha1,name=validate-ethereum2-v1alpha1-remotesigner.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RemoteSigner{}
+
+// validate validates remote signer
+func (r *RemoteSigner) validate() field.ErrorList {
+	var signerErrors field.ErrorList
+
+	// web3signer loads keys from keystores, and validator clients request signing by public key
+	for i, keystore := range r.Spec.Keystores {
+		if keystore.PublicKey == "" {
+			err := field.Invalid(field.NewPath("spec").Child("keystores").Index(i).Child("publicKey"), "", "keystore public key is required")
+			signerErrors = append(signerErrors, err)
+		}
+	}
+
+	if !strings.HasPrefix(r.Spec.SlashingProtectionDatabaseURL, "jdbc:postgresql://") {
+		err := field.Invalid(field.NewPath("spec").Child("slashingProtectionDatabaseUrl"), r.Spec.SlashingProtectionDatabaseURL, "must be postgres jdbc url")
+		signerErrors = append(signerErrors, err)
+	}
+
+	return signerErrors
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *RemoteSigner) ValidateCreate() error {
+	var allErrors field.ErrorList
+
+	remotesignerlog.Info("validate create", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.Spec.Resources.ValidateCreate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *RemoteSigner) ValidateUpdate(old runtime.Object) error {
+	var allErrors field.ErrorList
+	oldSigner := old.(*RemoteSigner)
+
+	remotesignerlog.Info("validate update", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.Spec.Resources.ValidateUpdate(&oldSigner.Spec.Resources)...)
+
+	if oldSigner.Spec.Network != r.Spec.Network {
+		err := field.Invalid(field.NewPath("spec").Child("network"), r.Spec.Network, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *RemoteSigner) ValidateDelete() error {
+	remotesignerlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
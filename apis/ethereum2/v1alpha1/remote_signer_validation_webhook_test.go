@@ -0,0 +1,147 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Ethereum 2.0 remote signer validation", func() {
+
+	createCases := []struct {
+		Title  string
+		Signer *RemoteSigner
+		Errors field.ErrorList
+	}{
+		{
+			Title: "Remote signer #1",
+			Signer: &RemoteSigner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "signer-1",
+				},
+				Spec: RemoteSignerSpec{
+					Network: "mainnet",
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+					SlashingProtectionDatabaseURL: "jdbc:postgresql://postgres:5432/web3signer",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.keystores[0].publicKey",
+					BadValue: "",
+					Detail:   "keystore public key is required",
+				},
+			},
+		},
+		{
+			Title: "Remote signer #2",
+			Signer: &RemoteSigner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "signer-2",
+				},
+				Spec: RemoteSignerSpec{
+					Network: "mainnet",
+					Keystores: []Keystore{
+						{
+							PublicKey:  "0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+							SecretName: "my-validator",
+						},
+					},
+					SlashingProtectionDatabaseURL: "postgres:5432/web3signer",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.slashingProtectionDatabaseUrl",
+					BadValue: "postgres:5432/web3signer",
+					Detail:   "must be postgres jdbc url",
+				},
+			},
+		},
+	}
+
+	updateCases := []struct {
+		Title     string
+		OldSigner *RemoteSigner
+		NewSigner *RemoteSigner
+		Errors    field.ErrorList
+	}{
+		{
+			Title: "Remote signer #1",
+			OldSigner: &RemoteSigner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "signer-1",
+				},
+				Spec: RemoteSignerSpec{
+					Network:                       "mainnet",
+					SlashingProtectionDatabaseURL: "jdbc:postgresql://postgres:5432/web3signer",
+				},
+			},
+			NewSigner: &RemoteSigner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "signer-1",
+				},
+				Spec: RemoteSignerSpec{
+					Network:                       "sepolia",
+					SlashingProtectionDatabaseURL: "jdbc:postgresql://postgres:5432/web3signer",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.network",
+					BadValue: "sepolia",
+					Detail:   "field is immutable",
+				},
+			},
+		},
+	}
+
+	Context("While creating remote signer", func() {
+		for _, c := range createCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.Signer.Default()
+					err := cc.Signer.ValidateCreate()
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+
+	Context("While updating remote signer", func() {
+		for _, c := range updateCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.OldSigner.Default()
+					cc.NewSigner.Default()
+					err := cc.NewSigner.ValidateUpdate(cc.OldSigner)
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+})
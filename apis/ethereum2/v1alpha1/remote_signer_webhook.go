@@ -0,0 +1,16 @@
+package v1alpha1
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// log is for logging in this package.
+var remotesignerlog = logf.Log.WithName("remotesigner-resource")
+
+// SetupWebhookWithManager sets up the webook with a given controller manager
+func (r *RemoteSigner) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
@@ -12,6 +12,8 @@ type ValidatorSpec struct {
 
 	// Network is the network this validator is validating blocks for
 	Network string `json:"network"`
+	// Genesis is custom network genesis, required for validating on private networks
+	Genesis *ValidatorGenesis `json:"genesis,omitempty"`
 	// Client is the Ethereum 2.0 client to use
 	Client Ethereum2Client `json:"client"`
 	// FeeRecipient is ethereum address collecting transaction fees
@@ -28,10 +30,20 @@ type ValidatorSpec struct {
 	// CertSecretName is k8s secret name that holds tls.crt
 	CertSecretName string `json:"certSecretName,omitempty"`
 	// Keystores is a list of Validator keystores
-	// +kubebuilder:validation:MinItems=1
-	Keystores []Keystore `json:"keystores"`
+	Keystores []Keystore `json:"keystores,omitempty"`
+	// RemoteSigner is remote signer name in the same namespace signing for the validator instead of keystores
+	RemoteSigner string `json:"remoteSigner,omitempty"`
+	// Builder enables proposing blocks built by external block builders
+	// validators are registered with block builders through beacon nodes, which must have builder configured
+	Builder *ValidatorBuilder `json:"builder,omitempty"`
+	// DoppelgangerProtection delays signing until validator keys are not seen live on the network for few epochs
+	DoppelgangerProtection bool `json:"doppelgangerProtection,omitempty"`
+	// Migration starts the validator after the source validator has stopped signing
+	Migration *ValidatorMigration `json:"migration,omitempty"`
 	// WalletPasswordSecret is wallet password secret
 	WalletPasswordSecret string `json:"walletPasswordSecret,omitempty"`
+	// SlashingProtection is EIP-3076 slashing protection interchange import and export
+	SlashingProtection *SlashingProtection `json:"slashingProtection,omitempty"`
 	// Resources is node compute and storage resources
 	shared.Resources `json:"resources,omitempty"`
 }
@@ -39,20 +51,168 @@ type ValidatorSpec struct {
 // Keystore is Ethereum 2.0 validator EIP-2335 BLS12-381 keystore https://eips.ethereum.org/EIPS/eip-2335
 type Keystore struct {
 	// PublicKey is the validator public key in hexadecimal
+	// it's derived from the keystore if not provided, except for lighthouse client which requires it
 	// +kubebuilder:validation:Pattern="^0[xX][0-9a-fA-F]{96}$"
 	PublicKey string `json:"publicKey,omitempty"`
 	// SecretName is the kubernetes secret holding [keystore] and [password]
 	SecretName string `json:"secretName"`
+	// FeeRecipient is ethereum address collecting transaction fees of blocks proposed by this key
+	// it overrides validator fee recipient, and it's ignored by remote signers
+	FeeRecipient shared.EthereumAddress `json:"feeRecipient,omitempty"`
+	// Graffiti is the text to include in blocks proposed by this key
+	// it overrides validator graffiti, and it's ignored by remote signers
+	Graffiti string `json:"graffiti,omitempty"`
+	// GasLimit is the gas limit registered with block builders for blocks proposed by this key
+	// it overrides validator builder gas limit, and it's ignored by remote signers
+	GasLimit uint64 `json:"gasLimit,omitempty"`
+}
+
+// ValidatorGenesis is custom beacon chain network genesis
+type ValidatorGenesis struct {
+	// ConfigMapName is kubernetes config map name holding [config.yaml], [genesis.ssz] and [deploy_block.txt] keys
+	// it's the same genesis config map used by the network beacon nodes
+	ConfigMapName string `json:"configMapName"`
+}
+
+// ValidatorBuilder is external block builders proposals
+type ValidatorBuilder struct {
+	// GasLimit is the gas limit registered with block builders for proposed blocks
+	GasLimit uint64 `json:"gasLimit,omitempty"`
+}
+
+// ValidatorMigration is safe validator migration from another validator signing with the same keys
+type ValidatorMigration struct {
+	// Source is the validator name in the same namespace migrated from
+	// source validator must exist when migration starts, it's deleted or stopped by exporting its slashing protection
+	// validator is stopped again if source validator is started while it exists
+	// if source is in another cluster, keep it empty and stop the source validator before creating this one
+	Source string `json:"source,omitempty"`
+	// Epochs is the number of epochs to wait after the source validator is deleted or stopped before starting the validator
+	// +kubebuilder:validation:Minimum=1
+	Epochs uint64 `json:"epochs,omitempty"`
+}
+
+// SlashingProtection is EIP-3076 slashing protection interchange https://eips.ethereum.org/EIPS/eip-3076
+type SlashingProtection struct {
+	// Import is slashing protection interchange imported before starting the validator
+	Import *SlashingProtectionSource `json:"import,omitempty"`
+	// Export stops the validator and exports slashing protection interchange into export secret
+	// export must be completed before switching validator client
+	Export bool `json:"export,omitempty"`
+	// ExportSecretName is kubernetes secret name to export slashing protection interchange into [interchange.json] key
+	// slashing protection interchange is exported on validator deletion as well
+	ExportSecretName string `json:"exportSecretName,omitempty"`
+	// SkipExportOnDeletion deletes the validator without exporting slashing protection interchange
+	// it's used if export can't complete, like when validator data is lost
+	SkipExportOnDeletion bool `json:"skipExportOnDeletion,omitempty"`
+}
+
+// SlashingProtectionSource is slashing protection interchange source
+type SlashingProtectionSource struct {
+	// SecretName is kubernetes secret name holding slashing protection interchange in [interchange.json] key
+	SecretName string `json:"secretName,omitempty"`
+	// ConfigMapName is kubernetes config map name holding slashing protection interchange in [interchange.json] key
+	ConfigMapName string `json:"configMapName,omitempty"`
+}
+
+// SlashingProtectionStatus is slashing protection interchange export status
+type SlashingProtectionStatus struct {
+	// Exported is true if validator is stopped and slashing protection export has completed
+	Exported bool `json:"exported,omitempty"`
+	// ExportedClient is the client whose slashing protection interchange was exported last
+	ExportedClient Ethereum2Client `json:"exportedClient,omitempty"`
+	// ExportSecretName is kubernetes secret name holding exported slashing protection interchange
+	ExportSecretName string `json:"exportSecretName,omitempty"`
+}
+
+const (
+	// MigratedCondition is validator condition type reporting migration state
+	MigratedCondition = "Migrated"
+	// SourceNotFoundReason is migration condition reason if source validator has never been found
+	SourceNotFoundReason = "SourceNotFound"
+	// SourceRunningReason is migration condition reason while source validator is running
+	SourceRunningReason = "SourceRunning"
+	// WaitingEpochsReason is migration condition reason while waiting epochs after source validator is deleted or stopped
+	WaitingEpochsReason = "WaitingEpochs"
+	// MigratedReason is migration condition reason after validator has been started
+	MigratedReason = "Migrated"
+)
+
+const (
+	// SlashingProtectionExportedCondition is validator condition type reporting slashing protection interchange export state
+	SlashingProtectionExportedCondition = "SlashingProtectionExported"
+	// ExportFailedReason is slashing protection export condition reason if export job has failed
+	ExportFailedReason = "ExportFailed"
+	// InvalidInterchangeReason is slashing protection export condition reason if exported interchange is invalid
+	InvalidInterchangeReason = "InvalidInterchange"
+	// ExportedReason is slashing protection export condition reason after interchange has been exported
+	ExportedReason = "Exported"
+)
+
+// ValidatorKeyState is validator key state on the beacon chain
+type ValidatorKeyState string
+
+const (
+	// PendingValidatorKey is validator key waiting for deposit processing or activation
+	PendingValidatorKey ValidatorKeyState = "pending"
+	// ActiveValidatorKey is active validator key
+	ActiveValidatorKey ValidatorKeyState = "active"
+	// ExitingValidatorKey is active validator key that has initiated exit
+	ExitingValidatorKey ValidatorKeyState = "exiting"
+	// ExitedValidatorKey is exited validator key
+	ExitedValidatorKey ValidatorKeyState = "exited"
+	// SlashedValidatorKey is slashed validator key
+	SlashedValidatorKey ValidatorKeyState = "slashed"
+	// UnknownValidatorKey is validator key that isn't known to the beacon chain yet
+	UnknownValidatorKey ValidatorKeyState = "unknown"
+)
+
+// ValidatorKeyStatus is validator key status on the beacon chain
+type ValidatorKeyStatus struct {
+	// PublicKey is the validator public key in hexadecimal
+	PublicKey string `json:"publicKey"`
+	// Index is the validator index on the beacon chain
+	Index string `json:"index,omitempty"`
+	// State is the validator key state
+	State ValidatorKeyState `json:"state"`
+	// Balance is the validator balance in gwei
+	Balance string `json:"balance,omitempty"`
+	// EffectiveBalance is the validator effective balance in gwei
+	EffectiveBalance string `json:"effectiveBalance,omitempty"`
+	// ExitEpoch is the epoch validator exits at, if exit has been initiated
+	ExitEpoch string `json:"exitEpoch,omitempty"`
 }
 
 // ValidatorStatus defines the observed state of Validator
-type ValidatorStatus struct{}
+type ValidatorStatus struct {
+	// RemoteSigner is remote signer namespace/name
+	RemoteSigner string `json:"remoteSigner,omitempty"`
+	// RemoteSignerURL is remote signer url
+	RemoteSignerURL string `json:"remoteSignerUrl,omitempty"`
+	// PublicKeys is public keys of validators signed by remote signer
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// SlashingProtection is slashing protection interchange export status
+	SlashingProtection SlashingProtectionStatus `json:"slashingProtection,omitempty"`
+	// Keys is validator keys status on the beacon chain
+	Keys []ValidatorKeyStatus `json:"keys,omitempty"`
+	// ActiveKeys is the number of active validator keys
+	ActiveKeys int `json:"activeKeys,omitempty"`
+	// TotalKeys is the number of validator keys
+	TotalKeys int `json:"totalKeys,omitempty"`
+	// Conditions is validator conditions like migration state
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // Validator is the Schema for the validators API
 // +kubebuilder:printcolumn:name="Client",type=string,JSONPath=".spec.client"
 // +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.network"
+// +kubebuilder:printcolumn:name="Active",type=integer,JSONPath=".status.activeKeys"
+// +kubebuilder:printcolumn:name="Keys",type=integer,JSONPath=".status.totalKeys"
 type Validator struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"fmt"
+
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
@@ -32,11 +34,25 @@ func (r *Validator) Default() {
 			image = DefaultNimbusValidatorImage
 		case PrysmClient:
 			image = DefaultPrysmValidatorImage
+		case LodestarClient:
+			image = DefaultLodestarValidatorImage
 		}
 
 		r.Spec.Image = image
 	}
 
+	if r.Spec.SlashingProtection != nil && r.Spec.SlashingProtection.ExportSecretName == "" {
+		r.Spec.SlashingProtection.ExportSecretName = fmt.Sprintf("%s-slashing-protection", r.Name)
+	}
+
+	if r.Spec.Builder != nil && r.Spec.Builder.GasLimit == 0 {
+		r.Spec.Builder.GasLimit = DefaultBuilderGasLimit
+	}
+
+	if r.Spec.Migration != nil && r.Spec.Migration.Epochs == 0 {
+		r.Spec.Migration.Epochs = DefaultMigrationEpochs
+	}
+
 	r.DefaultNodeResources()
 
 }
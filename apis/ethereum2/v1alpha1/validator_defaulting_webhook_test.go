@@ -4,6 +4,7 @@ import (
 	"github.com/kotalco/kotal/apis/shared"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Ethereum 2.0 validator client defaulting", func() {
@@ -27,4 +28,45 @@ var _ = Describe("Ethereum 2.0 validator client defaulting", func() {
 		Expect(node.Spec.Resources.Storage).To(Equal(DefaultStorage))
 	})
 
+	It("Should default validator client slashing protection export secret name", func() {
+		node := Validator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-validator",
+			},
+			Spec: ValidatorSpec{
+				Network:            "mainnet",
+				Client:             LighthouseClient,
+				SlashingProtection: &SlashingProtection{},
+			},
+		}
+		node.Default()
+		Expect(node.Spec.SlashingProtection.ExportSecretName).To(Equal("my-validator-slashing-protection"))
+	})
+
+	It("Should default validator client builder gas limit", func() {
+		node := Validator{
+			Spec: ValidatorSpec{
+				Network: "mainnet",
+				Client:  PrysmClient,
+				Builder: &ValidatorBuilder{},
+			},
+		}
+		node.Default()
+		Expect(node.Spec.Builder.GasLimit).To(Equal(DefaultBuilderGasLimit))
+	})
+
+	It("Should default validator client migration epochs", func() {
+		node := Validator{
+			Spec: ValidatorSpec{
+				Network: "mainnet",
+				Client:  LighthouseClient,
+				Migration: &ValidatorMigration{
+					Source: "old-validator",
+				},
+			},
+		}
+		node.Default()
+		Expect(node.Spec.Migration.Epochs).To(Equal(DefaultMigrationEpochs))
+	})
+
 })
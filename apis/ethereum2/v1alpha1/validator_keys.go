@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	"github.com/kotalco/kotal/apis/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidatorKeysSpec defines the desired state of ValidatorKeys
+type ValidatorKeysSpec struct {
+	// Network is the network deposit data is signed for
+	// +kubebuilder:validation:Enum=mainnet;sepolia;holesky;hoodi
+	Network string `json:"network"`
+	// MnemonicSecretName is kubernetes secret holding BIP-39 [mnemonic]
+	MnemonicSecretName string `json:"mnemonicSecretName"`
+	// StartIndex is the first validator key index in EIP-2334 path m/12381/3600/index/0/0
+	StartIndex uint32 `json:"startIndex,omitempty"`
+	// Count is the number of generated validator keys
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	Count uint32 `json:"count"`
+	// WithdrawalAddress is execution layer address receiving validator withdrawals
+	// BLS withdrawal credentials derived from the mnemonic are used if not provided
+	WithdrawalAddress shared.EthereumAddress `json:"withdrawalAddress,omitempty"`
+	// Amount is deposit amount in gwei
+	Amount uint64 `json:"amount,omitempty"`
+}
+
+// ValidatorKeysStatus defines the observed state of ValidatorKeys
+type ValidatorKeysStatus struct {
+	// Keystores is generated validator keystores, to be used in validator or remote signer keystores
+	Keystores []Keystore `json:"keystores,omitempty"`
+	// DepositDataConfigMapName is config map holding [deposit_data.json]
+	DepositDataConfigMapName string `json:"depositDataConfigMapName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ValidatorKeys is the Schema for the validatorkeys API
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.network"
+// +kubebuilder:printcolumn:name="Start",type=integer,JSONPath=".spec.startIndex"
+// +kubebuilder:printcolumn:name="Count",type=integer,JSONPath=".spec.count"
+type ValidatorKeys struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ValidatorKeysSpec   `json:"spec,omitempty"`
+	Status ValidatorKeysStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ValidatorKeysList contains a list of ValidatorKeys
+type ValidatorKeysList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ValidatorKeys `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ValidatorKeys{}, &ValidatorKeysList{})
+}
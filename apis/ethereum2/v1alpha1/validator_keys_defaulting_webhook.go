@@ -0,0 +1,16 @@
+package v1alpha1
+
+import "sigs.k8s.io/controller-runtime/pkg/webhook"
+
+// +kubebuilder:webhook:path=/mutate-ethereum2-kotal-io-v1alpha1-validatorkeys,mutating=true,failurePolicy=fail,groups=ethereum2.kotal.io,resources=validatorkeys,verbs=create;update,versions=v1alpha1,name=mutate-ethereum2-v1alpha1-validatorkeys.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &ValidatorKeys{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ValidatorKeys) Default() {
+	validatorkeyslog.Info("default", "name", r.Name)
+
+	if r.Spec.Amount == 0 {
+		r.Spec.Amount = DefaultDepositAmount
+	}
+}
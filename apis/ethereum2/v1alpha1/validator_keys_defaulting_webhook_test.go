@@ -0,0 +1,22 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ethereum 2.0 validator keys defaulting", func() {
+
+	It("Should default validator keys with missing deposit amount", func() {
+		keys := ValidatorKeys{
+			Spec: ValidatorKeysSpec{
+				Network:            "mainnet",
+				MnemonicSecretName: "my-mnemonic",
+				Count:              2,
+			},
+		}
+		keys.Default()
+		Expect(keys.Spec.Amount).To(Equal(DefaultDepositAmount))
+	})
+
+})
@@ -0,0 +1,95 @@
+package v1alpha1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ethereum2-kotal-io-v1alpha1-validatorkeys,mutating=false,failurePolicy=fail,groups=ethereum2.kotal.io,resources=validatorkeys,versions=v1alpha1,name=validate-ethereum2-v1alpha1-validatorkeys.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ValidatorKeys{}
+
+// validate validates validator keys
+func (r *ValidatorKeys) validate() field.ErrorList {
+	var keysErrors field.ErrorList
+
+	// deposit contract rejects deposits less than 1 ether
+	if r.Spec.Amount < 1000000000 {
+		err := field.Invalid(field.NewPath("spec").Child("amount"), r.Spec.Amount, "must be at least 1000000000 gwei")
+		keysErrors = append(keysErrors, err)
+	}
+
+	return keysErrors
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ValidatorKeys) ValidateCreate() error {
+	var allErrors field.ErrorList
+
+	validatorkeyslog.Info("validate create", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+// generated keys can't be changed, but more keys can be generated by increasing count
+func (r *ValidatorKeys) ValidateUpdate(old runtime.Object) error {
+	var allErrors field.ErrorList
+	oldKeys := old.(*ValidatorKeys)
+
+	validatorkeyslog.Info("validate update", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+
+	if oldKeys.Spec.Network != r.Spec.Network {
+		err := field.Invalid(field.NewPath("spec").Child("network"), r.Spec.Network, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if oldKeys.Spec.MnemonicSecretName != r.Spec.MnemonicSecretName {
+		err := field.Invalid(field.NewPath("spec").Child("mnemonicSecretName"), r.Spec.MnemonicSecretName, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if oldKeys.Spec.StartIndex != r.Spec.StartIndex {
+		err := field.Invalid(field.NewPath("spec").Child("startIndex"), r.Spec.StartIndex, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if r.Spec.Count < oldKeys.Spec.Count {
+		err := field.Invalid(field.NewPath("spec").Child("count"), r.Spec.Count, "can't be decreased")
+		allErrors = append(allErrors, err)
+	}
+
+	if oldKeys.Spec.WithdrawalAddress != r.Spec.WithdrawalAddress {
+		err := field.Invalid(field.NewPath("spec").Child("withdrawalAddress"), r.Spec.WithdrawalAddress, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if oldKeys.Spec.Amount != r.Spec.Amount {
+		err := field.Invalid(field.NewPath("spec").Child("amount"), r.Spec.Amount, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ValidatorKeys) ValidateDelete() error {
+	validatorkeyslog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Ethereum 2.0 validator keys validation", func() {
+
+	createCases := []struct {
+		Title  string
+		Keys   *ValidatorKeys
+		Errors field.ErrorList
+	}{
+		{
+			Title: "Validator keys #1",
+			Keys: &ValidatorKeys{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "keys-1",
+				},
+				Spec: ValidatorKeysSpec{
+					Network:            "mainnet",
+					MnemonicSecretName: "my-mnemonic",
+					Count:              2,
+					Amount:             1000,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.amount",
+					BadValue: uint64(1000),
+					Detail:   "must be at least 1000000000 gwei",
+				},
+			},
+		},
+	}
+
+	updateCases := []struct {
+		Title   string
+		OldKeys *ValidatorKeys
+		NewKeys *ValidatorKeys
+		Errors  field.ErrorList
+	}{
+		{
+			Title: "Validator keys #1",
+			OldKeys: &ValidatorKeys{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "keys-1",
+				},
+				Spec: ValidatorKeysSpec{
+					Network:            "mainnet",
+					MnemonicSecretName: "my-mnemonic",
+					Count:              2,
+				},
+			},
+			NewKeys: &ValidatorKeys{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "keys-1",
+				},
+				Spec: ValidatorKeysSpec{
+					Network:            "sepolia",
+					MnemonicSecretName: "my-mnemonic",
+					Count:              2,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.network",
+					BadValue: "sepolia",
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "Validator keys #2",
+			OldKeys: &ValidatorKeys{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "keys-2",
+				},
+				Spec: ValidatorKeysSpec{
+					Network:            "mainnet",
+					MnemonicSecretName: "my-mnemonic",
+					Count:              2,
+				},
+			},
+			NewKeys: &ValidatorKeys{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "keys-2",
+				},
+				Spec: ValidatorKeysSpec{
+					Network:            "mainnet",
+					MnemonicSecretName: "my-mnemonic",
+					StartIndex:         1,
+					Count:              1,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.startIndex",
+					BadValue: uint32(1),
+					Detail:   "field is immutable",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.count",
+					BadValue: uint32(1),
+					Detail:   "can't be decreased",
+				},
+			},
+		},
+		{
+			Title: "Validator keys #3",
+			OldKeys: &ValidatorKeys{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "keys-3",
+				},
+				Spec: ValidatorKeysSpec{
+					Network:            "mainnet",
+					MnemonicSecretName: "my-mnemonic",
+					Count:              2,
+				},
+			},
+			NewKeys: &ValidatorKeys{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "keys-3",
+				},
+				Spec: ValidatorKeysSpec{
+					Network:            "mainnet",
+					MnemonicSecretName: "other-mnemonic",
+					Count:              2,
+					WithdrawalAddress:  "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.mnemonicSecretName",
+					BadValue: "other-mnemonic",
+					Detail:   "field is immutable",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.withdrawalAddress",
+					BadValue: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+					Detail:   "field is immutable",
+				},
+			},
+		},
+	}
+
+	Context("While creating validator keys", func() {
+		for _, c := range createCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.Keys.Default()
+					err := cc.Keys.ValidateCreate()
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+
+	Context("While updating validator keys", func() {
+		for _, c := range updateCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					cc.OldKeys.Default()
+					cc.NewKeys.Default()
+					err := cc.NewKeys.ValidateUpdate(cc.OldKeys)
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+})
@@ -1,12 +1,15 @@
 package v1alpha1
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/kotalco/kotal/apis/shared"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
@@ -19,8 +22,47 @@ var _ webhook.Validator = &Validator{}
 func (r *Validator) validate() field.ErrorList {
 	var validatorErrors field.ErrorList
 
-	// prysm requires wallet password
-	if r.Spec.Client == PrysmClient && r.Spec.WalletPasswordSecret == "" {
+	// validate network is supported by client
+	if !r.Spec.Client.SupportsNetwork(r.Spec.Network) {
+		err := field.Invalid(field.NewPath("spec").Child("network"), r.Spec.Network, fmt.Sprintf("not supported by client %s", r.Spec.Client))
+		validatorErrors = append(validatorErrors, err)
+	} else if version := r.Spec.Client.NetworkMinimumVersion(r.Spec.Network); !shared.ImageVersionAtLeast(r.Spec.Image, version) {
+		// networks launched after client image can't be joined
+		err := field.Invalid(field.NewPath("spec").Child("image"), r.Spec.Image, fmt.Sprintf("must be client %s version %s or later to join network %s", r.Spec.Client, version, r.Spec.Network))
+		validatorErrors = append(validatorErrors, err)
+	}
+
+	// public networks genesis is built into clients
+	if r.Spec.Genesis != nil && PublicNetworks[r.Spec.Network] {
+		err := field.Invalid(field.NewPath("spec").Child("genesis"), r.Spec.Genesis.ConfigMapName, fmt.Sprintf("can't be used with public network %s", r.Spec.Network))
+		validatorErrors = append(validatorErrors, err)
+	}
+
+	// custom networks genesis isn't known to clients
+	if r.Spec.Genesis == nil && !PublicNetworks[r.Spec.Network] {
+		err := field.Invalid(field.NewPath("spec").Child("genesis"), "", fmt.Sprintf("must provide genesis for custom network %s", r.Spec.Network))
+		validatorErrors = append(validatorErrors, err)
+	}
+
+	// validator keys are either loaded from keystores or signed for by remote signer
+	if r.Spec.RemoteSigner == "" && len(r.Spec.Keystores) == 0 {
+		err := field.Invalid(field.NewPath("spec").Child("keystores"), "", "must provide keystores or remoteSigner")
+		validatorErrors = append(validatorErrors, err)
+	}
+
+	if r.Spec.RemoteSigner != "" && len(r.Spec.Keystores) != 0 {
+		err := field.Invalid(field.NewPath("spec").Child("keystores"), "", "must be none if remoteSigner is provided")
+		validatorErrors = append(validatorErrors, err)
+	}
+
+	// remote signer url and signed for public keys are resolved from remote signers in the same namespace only
+	if strings.Contains(r.Spec.RemoteSigner, ".") {
+		err := field.Invalid(field.NewPath("spec").Child("remoteSigner"), r.Spec.RemoteSigner, "must be a remote signer in the same namespace")
+		validatorErrors = append(validatorErrors, err)
+	}
+
+	// prysm requires wallet password for keystores imported into wallet
+	if r.Spec.Client == PrysmClient && r.Spec.RemoteSigner == "" && r.Spec.WalletPasswordSecret == "" {
 		msg := "must provide walletPasswordSecret if client is prysm"
 		err := field.Invalid(field.NewPath("spec").Child("walletPasswordSecret"), r.Spec.WalletPasswordSecret, msg)
 		validatorErrors = append(validatorErrors, err)
@@ -36,13 +78,29 @@ func (r *Validator) validate() field.ErrorList {
 		validatorErrors = append(validatorErrors, err)
 	}
 
-	// lighthouse is the only client supporting multiple beacon endpoints
-	if r.Spec.Client != LighthouseClient && len(r.Spec.BeaconEndpoints) > 1 {
+	// lighthouse and lodestar are the only clients supporting multiple beacon endpoints
+	if !r.Spec.Client.SupportsMultipleBeaconEndpoints() && len(r.Spec.BeaconEndpoints) > 1 {
 		msg := fmt.Sprintf("multiple beacon node endpoints not supported by %s client", r.Spec.Client)
 		err := field.Invalid(field.NewPath("spec").Child("beaconEndpoints"), strings.Join(r.Spec.BeaconEndpoints, ","), msg)
 		validatorErrors = append(validatorErrors, err)
 	}
 
+	// validate per key proposer config overrides
+	for i, keystore := range r.Spec.Keystores {
+		path := field.NewPath("spec").Child("keystores").Index(i)
+
+		if keystore.Graffiti != "" && !r.Spec.Client.SupportsPerKeyGraffiti() {
+			err := field.Invalid(path.Child("graffiti"), keystore.Graffiti, fmt.Sprintf("not supported by %s client", r.Spec.Client))
+			validatorErrors = append(validatorErrors, err)
+		}
+
+		if keystore.GasLimit != 0 && r.Spec.Builder == nil {
+			err := field.Invalid(path.Child("gasLimit"), keystore.GasLimit, "must configure builder to register gas limit")
+			validatorErrors = append(validatorErrors, err)
+		}
+	}
+
+	// lighthouse validator definitions require voting public keys
 	if r.Spec.Client == LighthouseClient {
 		for i, keystore := range r.Spec.Keystores {
 			if keystore.PublicKey == "" {
@@ -53,9 +111,67 @@ func (r *Validator) validate() field.ErrorList {
 		}
 	}
 
+	if r.Spec.Migration != nil {
+		source := r.Spec.Migration.Source
+		sourcePath := field.NewPath("spec").Child("migration").Child("source")
+
+		if strings.Contains(source, ".") {
+			err := field.Invalid(sourcePath, source, "must be a validator in the same namespace")
+			validatorErrors = append(validatorErrors, err)
+		}
+
+		// validator can't wait for its own pod to stop
+		if source != "" && source == r.Name {
+			err := field.Invalid(sourcePath, source, "can't be the validator itself")
+			validatorErrors = append(validatorErrors, err)
+		}
+	}
+
+	if r.Spec.SlashingProtection != nil && r.Spec.SlashingProtection.Import != nil {
+		source := r.Spec.SlashingProtection.Import
+		if (source.SecretName == "") == (source.ConfigMapName == "") {
+			err := field.Invalid(field.NewPath("spec").Child("slashingProtection").Child("import"), "", "must provide either secretName or configMapName")
+			validatorErrors = append(validatorErrors, err)
+		}
+	}
+
 	return validatorErrors
 }
 
+// validateRemoteSigner validates referenced remote signer is signing for validator network
+// missing remote signers are reported by the controller, they can be created after the validator
+func (r *Validator) validateRemoteSigner() field.ErrorList {
+	var signerErrors field.ErrorList
+
+	if validatorReader == nil || r.Spec.RemoteSigner == "" || strings.Contains(r.Spec.RemoteSigner, ".") {
+		return nil
+	}
+
+	signer := &RemoteSigner{}
+	key := types.NamespacedName{Name: r.Spec.RemoteSigner, Namespace: r.Namespace}
+	if err := validatorReader.Get(context.Background(), key, signer); err != nil {
+		if !apierrors.IsNotFound(err) {
+			validatorlog.Error(err, "unable to get remote signer", "name", r.Spec.RemoteSigner)
+		}
+		return nil
+	}
+
+	if signer.Spec.Network != r.Spec.Network {
+		err := field.Invalid(field.NewPath("spec").Child("remoteSigner"), r.Spec.RemoteSigner, fmt.Sprintf("is signing for network %s", signer.Spec.Network))
+		signerErrors = append(signerErrors, err)
+	}
+
+	return signerErrors
+}
+
+// Warnings returns validator admission warnings
+func (r *Validator) Warnings() (warnings []string) {
+	if DeprecatedNetworks[r.Spec.Network] {
+		warnings = append(warnings, DeprecatedNetworkWarning(r.Spec.Network))
+	}
+	return
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *Validator) ValidateCreate() error {
 	var allErrors field.ErrorList
@@ -63,6 +179,7 @@ func (r *Validator) ValidateCreate() error {
 	validatorlog.Info("validate create", "name", r.Name)
 
 	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.validateRemoteSigner()...)
 	allErrors = append(allErrors, r.Spec.Resources.ValidateCreate()...)
 
 	if len(allErrors) == 0 {
@@ -80,18 +197,21 @@ func (r *Validator) ValidateUpdate(old runtime.Object) error {
 	validatorlog.Info("validate update", "name", r.Name)
 
 	allErrors = append(allErrors, r.validate()...)
+	allErrors = append(allErrors, r.validateRemoteSigner()...)
 	allErrors = append(allErrors, r.Spec.Resources.ValidateUpdate(&oldValidator.Spec.Resources)...)
 
-	if oldValidator.Spec.Client != r.Spec.Client {
-		err := field.Invalid(field.NewPath("spec").Child("client"), r.Spec.Client, "field is immutable")
-		allErrors = append(allErrors, err)
-	}
+	allErrors = append(allErrors, r.validateClientUpdate(oldValidator)...)
 
 	if oldValidator.Spec.Network != r.Spec.Network {
 		err := field.Invalid(field.NewPath("spec").Child("network"), r.Spec.Network, "field is immutable")
 		allErrors = append(allErrors, err)
 	}
 
+	if validatorGenesisConfigMapName(oldValidator) != validatorGenesisConfigMapName(r) {
+		err := field.Invalid(field.NewPath("spec").Child("genesis").Child("configMapName"), validatorGenesisConfigMapName(r), "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
 	allErrors = append(allErrors, r.Spec.Resources.ValidateCreate()...)
 
 	if len(allErrors) == 0 {
@@ -101,6 +221,41 @@ func (r *Validator) ValidateUpdate(old runtime.Object) error {
 	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
 }
 
+// validatorGenesisConfigMapName returns validator custom genesis config map name, or empty string if genesis isn't provided
+func validatorGenesisConfigMapName(validator *Validator) string {
+	if validator.Spec.Genesis == nil {
+		return ""
+	}
+	return validator.Spec.Genesis.ConfigMapName
+}
+
+// validateClientUpdate validates switching validator client
+// slashing protection interchange of the old client must be exported first, then imported by the new client
+func (r *Validator) validateClientUpdate(oldValidator *Validator) field.ErrorList {
+	var clientErrors field.ErrorList
+
+	if oldValidator.Spec.Client == r.Spec.Client {
+		return nil
+	}
+
+	if !oldValidator.Status.SlashingProtection.Exported || oldValidator.Status.SlashingProtection.ExportedClient != oldValidator.Spec.Client {
+		err := field.Invalid(field.NewPath("spec").Child("client"), r.Spec.Client, "can't be changed before slashing protection export has completed")
+		clientErrors = append(clientErrors, err)
+	}
+
+	if r.Spec.SlashingProtection != nil && r.Spec.SlashingProtection.Export {
+		err := field.Invalid(field.NewPath("spec").Child("slashingProtection").Child("export"), true, "must be disabled when switching client")
+		clientErrors = append(clientErrors, err)
+	}
+
+	if oldValidator.Spec.Image == r.Spec.Image {
+		err := field.Invalid(field.NewPath("spec").Child("image"), r.Spec.Image, "must be updated when switching client")
+		clientErrors = append(clientErrors, err)
+	}
+
+	return clientErrors
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *Validator) ValidateDelete() error {
 	validatorlog.Info("validate delete", "name", r.Name)
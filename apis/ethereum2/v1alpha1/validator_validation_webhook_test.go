@@ -7,7 +7,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("Ethereum 2.0 validator client validation", func() {
@@ -106,6 +109,343 @@ var _ = Describe("Ethereum 2.0 validator client validation", func() {
 				},
 			},
 		},
+		{
+			Title: "Validator #5",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "chiado",
+					Client:  NimbusClient,
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.network",
+					BadValue: "chiado",
+					Detail:   "not supported by client nimbus",
+				},
+			},
+		},
+		{
+			Title: "Validator #6",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  LodestarClient,
+					Logging: shared.FatalLogs,
+					BeaconEndpoints: []string{
+						"http://lodestar-beacon-node-1:5051",
+						"http://lodestar-beacon-node-2:5051",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.logging",
+					BadValue: shared.FatalLogs,
+					Detail:   "not supported by lodestar client",
+				},
+			},
+		},
+		{
+			Title: "Validator #7",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  TekuClient,
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.keystores",
+					BadValue: "",
+					Detail:   "must provide keystores or remoteSigner",
+				},
+			},
+		},
+		{
+			Title: "Validator #8",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network:      "mainnet",
+					Client:       TekuClient,
+					RemoteSigner: "my-signer",
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.keystores",
+					BadValue: "",
+					Detail:   "must be none if remoteSigner is provided",
+				},
+			},
+		},
+		{
+			Title: "Validator #9",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  TekuClient,
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+					SlashingProtection: &SlashingProtection{
+						Import: &SlashingProtectionSource{
+							SecretName:    "my-interchange",
+							ConfigMapName: "my-interchange",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.slashingProtection.import",
+					BadValue: "",
+					Detail:   "must provide either secretName or configMapName",
+				},
+			},
+		},
+		{
+			Title: "Validator #10",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  TekuClient,
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+							Graffiti:   "Validated by Kotal",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.keystores[0].graffiti",
+					BadValue: "Validated by Kotal",
+					Detail:   "not supported by teku client",
+				},
+			},
+		},
+		{
+			Title: "Validator #11",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  LighthouseClient,
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+							GasLimit:   36000000,
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.keystores[0].gasLimit",
+					BadValue: uint64(36000000),
+					Detail:   "must configure builder to register gas limit",
+				},
+			},
+		},
+		{
+			Title: "Validator #12",
+			Validator: &Validator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-validator",
+					Namespace: "default",
+				},
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  LighthouseClient,
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+					Migration: &ValidatorMigration{
+						Source: "my-validator",
+						Epochs: 2,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.migration.source",
+					BadValue: "my-validator",
+					Detail:   "can't be the validator itself",
+				},
+			},
+		},
+		{
+			Title: "Validator #13",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "holesky",
+					Client:  PrysmClient,
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+					WalletPasswordSecret: "wallet-password",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.image",
+					BadValue: DefaultPrysmValidatorImage,
+					Detail:   "must be client prysm version 4.1.0 or later to join network holesky",
+				},
+			},
+		},
+		{
+			Title: "Validator #14",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network:      "mainnet",
+					Client:       TekuClient,
+					RemoteSigner: "my-signer.other-namespace",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.remoteSigner",
+					BadValue: "my-signer.other-namespace",
+					Detail:   "must be a remote signer in the same namespace",
+				},
+			},
+		},
+		{
+			Title: "Validator #15",
+			Validator: &Validator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "new-validator",
+					Namespace: "default",
+				},
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  LighthouseClient,
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+							PublicKey:  "0x83dbb18e088cb16a07fca598db2ac24da3e8549601eedd75eb28d8a9d4be405f49f7dbdcad5c9d7df54a8a40a143e852",
+						},
+					},
+					Migration: &ValidatorMigration{
+						Source: "old-validator.staking",
+						Epochs: 2,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.migration.source",
+					BadValue: "old-validator.staking",
+					Detail:   "must be a validator in the same namespace",
+				},
+			},
+		},
+		{
+			Title: "Validator #16",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "devnet",
+					Client:  TekuClient,
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis",
+					BadValue: "",
+					Detail:   "must provide genesis for custom network devnet",
+				},
+			},
+		},
+		{
+			Title: "Validator #17",
+			Validator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  TekuClient,
+					Genesis: &ValidatorGenesis{
+						ConfigMapName: "devnet-genesis",
+					},
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis",
+					BadValue: "devnet-genesis",
+					Detail:   "can't be used with public network mainnet",
+				},
+			},
+		},
 	}
 
 	updateCases := []struct {
@@ -215,6 +555,99 @@ var _ = Describe("Ethereum 2.0 validator client validation", func() {
 					Type:     field.ErrorTypeInvalid,
 					Field:    "spec.client",
 					BadValue: "prysm",
+					Detail:   "can't be changed before slashing protection export has completed",
+				},
+			},
+		},
+		{
+			Title: "Validator #4",
+			OldValidator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  TekuClient,
+					Image:   "kotalco/validator:test",
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+					SlashingProtection: &SlashingProtection{
+						Export: true,
+					},
+				},
+				Status: ValidatorStatus{
+					SlashingProtection: SlashingProtectionStatus{
+						Exported:       true,
+						ExportedClient: TekuClient,
+					},
+				},
+			},
+			NewValidator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "mainnet",
+					Client:  LighthouseClient,
+					Image:   "kotalco/validator:test",
+					Keystores: []Keystore{
+						{
+							PublicKey:  "0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+							SecretName: "my-validator",
+						},
+					},
+					SlashingProtection: &SlashingProtection{
+						Export: true,
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.slashingProtection.export",
+					BadValue: true,
+					Detail:   "must be disabled when switching client",
+				},
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.image",
+					BadValue: "kotalco/validator:test",
+					Detail:   "must be updated when switching client",
+				},
+			},
+		},
+		{
+			Title: "Validator #5",
+			OldValidator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "devnet",
+					Client:  TekuClient,
+					Genesis: &ValidatorGenesis{
+						ConfigMapName: "devnet-genesis",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+				},
+			},
+			NewValidator: &Validator{
+				Spec: ValidatorSpec{
+					Network: "devnet",
+					Client:  TekuClient,
+					Genesis: &ValidatorGenesis{
+						ConfigMapName: "devnet-2-genesis",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.genesis.configMapName",
+					BadValue: "devnet-2-genesis",
 					Detail:   "field is immutable",
 				},
 			},
@@ -258,4 +691,117 @@ var _ = Describe("Ethereum 2.0 validator client validation", func() {
 		}
 	})
 
+	Context("While referencing remote signer", func() {
+		signer := &RemoteSigner{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-signer",
+				Namespace: "default",
+			},
+			Spec: RemoteSignerSpec{
+				Network: "mainnet",
+			},
+		}
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(AddToScheme(scheme)).To(Succeed())
+			validatorReader = fake.NewClientBuilder().WithScheme(scheme).WithObjects(signer).Build()
+		})
+
+		AfterEach(func() {
+			validatorReader = nil
+		})
+
+		It("Should reject remote signer signing for another network", func() {
+			validator := &Validator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-validator",
+					Namespace: "default",
+				},
+				Spec: ValidatorSpec{
+					Network:      HoleskyNetwork,
+					Client:       TekuClient,
+					RemoteSigner: "my-signer",
+				},
+			}
+			validator.Default()
+			err := validator.ValidateCreate()
+
+			errStatus := err.(*errors.StatusError)
+
+			causes := shared.ErrorsToCauses(field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.remoteSigner",
+					BadValue: "my-signer",
+					Detail:   "is signing for network mainnet",
+				},
+			})
+
+			Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+		})
+
+		It("Should accept remote signer signing for the same network", func() {
+			validator := &Validator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-validator",
+					Namespace: "default",
+				},
+				Spec: ValidatorSpec{
+					Network:      "mainnet",
+					Client:       TekuClient,
+					RemoteSigner: "my-signer",
+				},
+			}
+			validator.Default()
+			Expect(validator.ValidateCreate()).To(Succeed())
+		})
+	})
+
+	Context("While validating on custom network", func() {
+		It("Should accept custom network with genesis", func() {
+			validator := &Validator{
+				Spec: ValidatorSpec{
+					Network: "devnet",
+					Client:  TekuClient,
+					Genesis: &ValidatorGenesis{
+						ConfigMapName: "devnet-genesis",
+					},
+					BeaconEndpoints: []string{
+						"http://10.96.130.88:9999",
+					},
+					Keystores: []Keystore{
+						{
+							SecretName: "my-validator",
+						},
+					},
+				},
+			}
+			validator.Default()
+			Expect(validator.ValidateCreate()).To(Succeed())
+		})
+	})
+
+	Context("While joining deprecated network", func() {
+		It("Should warn about deprecated network", func() {
+			validator := &Validator{
+				Spec: ValidatorSpec{
+					Network: GoerliNetwork,
+					Client:  TekuClient,
+				},
+			}
+			Expect(validator.Warnings()).To(ConsistOf(DeprecatedNetworkWarning(GoerliNetwork)))
+		})
+
+		It("Should not warn about live network", func() {
+			validator := &Validator{
+				Spec: ValidatorSpec{
+					Network: HoleskyNetwork,
+					Client:  TekuClient,
+				},
+			}
+			Expect(validator.Warnings()).To(BeEmpty())
+		})
+	})
+
 })
@@ -1,15 +1,22 @@
 package v1alpha1
 
 import (
+	"github.com/kotalco/kotal/apis/shared"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 // log is for logging in this package.
 var validatorlog = logf.Log.WithName("validator-resource")
 
+// validatorReader reads resources referenced by validators like remote signers
+var validatorReader client.Reader
+
 // SetupWebhookWithManager sets up the webook with a given controller manager
 func (r *Validator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	validatorReader = mgr.GetAPIReader()
+	shared.RegisterValidatingWebhookWithWarnings(mgr, "/validate-ethereum2-kotal-io-v1alpha1-validator", r)
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
@@ -0,0 +1,84 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VoluntaryExitSpec defines the desired state of VoluntaryExit
+type VoluntaryExitSpec struct {
+	// Validator is validator name in the same namespace whose keys are exiting
+	// exits are signed using validator keystores or its remote signer
+	// public keys must be validator keystores or remote signer public keys
+	Validator string `json:"validator"`
+	// PublicKeys is public keys of exiting validator keys
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	PublicKeys []string `json:"publicKeys"`
+	// BeaconEndpoint is beacon node REST API url exits are submitted to
+	// first validator beacon endpoint that's REST API url is used if not provided
+	BeaconEndpoint string `json:"beaconEndpoint,omitempty"`
+}
+
+// VoluntaryExitPhase is voluntary exit phase
+type VoluntaryExitPhase string
+
+const (
+	// VoluntaryExitPending is voluntary exit waiting for validator keys to be signed and submitted
+	VoluntaryExitPending VoluntaryExitPhase = "Pending"
+	// VoluntaryExitSubmitted is voluntary exit submitted to the beacon node, waiting for validator keys to exit
+	VoluntaryExitSubmitted VoluntaryExitPhase = "Submitted"
+	// VoluntaryExitCompleted is voluntary exit of exited validator keys
+	VoluntaryExitCompleted VoluntaryExitPhase = "Exited"
+)
+
+// VoluntaryExitKeyStatus is exiting validator key status
+type VoluntaryExitKeyStatus struct {
+	// PublicKey is the validator public key in hexadecimal
+	PublicKey string `json:"publicKey"`
+	// Index is the validator index on the beacon chain
+	Index string `json:"index,omitempty"`
+	// State is the validator key state
+	State ValidatorKeyState `json:"state,omitempty"`
+	// Submitted is true if signed voluntary exit has been submitted to the beacon node
+	Submitted bool `json:"submitted,omitempty"`
+	// ExitEpoch is the epoch validator exits at, once the voluntary exit is included on chain
+	ExitEpoch string `json:"exitEpoch,omitempty"`
+}
+
+// VoluntaryExitStatus defines the observed state of VoluntaryExit
+type VoluntaryExitStatus struct {
+	// Phase is voluntary exit phase
+	Phase VoluntaryExitPhase `json:"phase,omitempty"`
+	// Keys is exiting validator keys status
+	Keys []VoluntaryExitKeyStatus `json:"keys,omitempty"`
+	// Message is the reason the voluntary exit couldn't be signed or submitted
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VoluntaryExit is the Schema for the voluntaryexits API
+// +kubebuilder:printcolumn:name="Validator",type=string,JSONPath=".spec.validator"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",priority=10
+type VoluntaryExit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VoluntaryExitSpec   `json:"spec,omitempty"`
+	Status VoluntaryExitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VoluntaryExitList contains a list of VoluntaryExit
+type VoluntaryExitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VoluntaryExit `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VoluntaryExit{}, &VoluntaryExitList{})
+}
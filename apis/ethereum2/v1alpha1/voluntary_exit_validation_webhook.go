@@ -0,0 +1,94 @@
+package v1alpha1
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ethereum2-kotal-io-v1alpha1-voluntaryexit,mutating=false,failurePolicy=fail,groups=ethereum2.kotal.io,resources=voluntaryexits,versions=v1alpha1,name=validate-ethereum2-v1alpha1-voluntaryexit.kb.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VoluntaryExit{}
+
+// validate validates voluntary exit
+func (r *VoluntaryExit) validate() field.ErrorList {
+	var exitErrors field.ErrorList
+
+	// exits are signed using keys of validators in the same namespace only
+	if strings.Contains(r.Spec.Validator, ".") {
+		err := field.Invalid(field.NewPath("spec").Child("validator"), r.Spec.Validator, "must be a validator in the same namespace")
+		exitErrors = append(exitErrors, err)
+	}
+
+	// validate at least one validator key is exiting
+	if len(r.Spec.PublicKeys) == 0 {
+		err := field.Invalid(field.NewPath("spec").Child("publicKeys"), "", "must provide at least one public key")
+		exitErrors = append(exitErrors, err)
+	}
+
+	// validate public keys are 48 bytes BLS12-381 public keys in hexadecimal
+	for i, publicKey := range r.Spec.PublicKeys {
+		decoded, err := hex.DecodeString(strings.TrimPrefix(publicKey, "0x"))
+		if err != nil || len(decoded) != 48 || !strings.HasPrefix(publicKey, "0x") {
+			err := field.Invalid(field.NewPath("spec").Child("publicKeys").Index(i), publicKey, "must be 0x prefixed 48 bytes public key in hexadecimal")
+			exitErrors = append(exitErrors, err)
+		}
+	}
+
+	return exitErrors
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VoluntaryExit) ValidateCreate() error {
+	var allErrors field.ErrorList
+
+	voluntaryexitlog.Info("validate create", "name", r.Name)
+
+	allErrors = append(allErrors, r.validate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VoluntaryExit) ValidateUpdate(old runtime.Object) error {
+	var allErrors field.ErrorList
+	oldExit := old.(*VoluntaryExit)
+
+	voluntaryexitlog.Info("validate update", "name", r.Name)
+
+	// voluntary exits are irreversible once submitted
+	if oldExit.Spec.Validator != r.Spec.Validator {
+		err := field.Invalid(field.NewPath("spec").Child("validator"), r.Spec.Validator, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	if !reflect.DeepEqual(oldExit.Spec.PublicKeys, r.Spec.PublicKeys) {
+		err := field.Invalid(field.NewPath("spec").Child("publicKeys"), r.Spec.PublicKeys, "field is immutable")
+		allErrors = append(allErrors, err)
+	}
+
+	allErrors = append(allErrors, r.validate()...)
+
+	if len(allErrors) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{}, r.Name, allErrors)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VoluntaryExit) ValidateDelete() error {
+	voluntaryexitlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Ethereum 2.0 voluntary exit validation", func() {
+
+	publicKey := "0x83dee0bd09cfb0d8e5c73b3bb66bc5a6d0d7cc69d5fbfbd0b1bc7ad5a3b3a5b1eb9c8f5f7b3d8b4bfc1b0f0bf3b2a1c0d"
+	otherPublicKey := "0xa4d5d1bf1a0e8f0e7f9c8d4c3d0a6a6a3c7e8b8f9f1e3b5d1c9a7b3c5d7e9f1a3b5c7d9e1f3a5b7c9d1e3f5a7b9c1d3e5"
+
+	createCases := []struct {
+		Title  string
+		Exit   *VoluntaryExit
+		Errors field.ErrorList
+	}{
+		{
+			Title: "Voluntary exit #1",
+			Exit: &VoluntaryExit{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "exit-1",
+				},
+				Spec: VoluntaryExitSpec{
+					Validator: "my-validator",
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.publicKeys",
+					BadValue: "",
+					Detail:   "must provide at least one public key",
+				},
+			},
+		},
+		{
+			Title: "Voluntary exit #2",
+			Exit: &VoluntaryExit{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "exit-2",
+				},
+				Spec: VoluntaryExitSpec{
+					Validator:  "my-validator",
+					PublicKeys: []string{publicKey, "0x83dee0bd"},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.publicKeys[1]",
+					BadValue: "0x83dee0bd",
+					Detail:   "must be 0x prefixed 48 bytes public key in hexadecimal",
+				},
+			},
+		},
+		{
+			Title: "Voluntary exit #3",
+			Exit: &VoluntaryExit{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "exit-3",
+				},
+				Spec: VoluntaryExitSpec{
+					Validator:  "my-validator.other-namespace",
+					PublicKeys: []string{publicKey},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.validator",
+					BadValue: "my-validator.other-namespace",
+					Detail:   "must be a validator in the same namespace",
+				},
+			},
+		},
+	}
+
+	updateCases := []struct {
+		Title   string
+		OldExit *VoluntaryExit
+		NewExit *VoluntaryExit
+		Errors  field.ErrorList
+	}{
+		{
+			Title: "Voluntary exit #1",
+			OldExit: &VoluntaryExit{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "exit-1",
+				},
+				Spec: VoluntaryExitSpec{
+					Validator:  "my-validator",
+					PublicKeys: []string{publicKey},
+				},
+			},
+			NewExit: &VoluntaryExit{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "exit-1",
+				},
+				Spec: VoluntaryExitSpec{
+					Validator:  "other-validator",
+					PublicKeys: []string{publicKey},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.validator",
+					BadValue: "other-validator",
+					Detail:   "field is immutable",
+				},
+			},
+		},
+		{
+			Title: "Voluntary exit #2",
+			OldExit: &VoluntaryExit{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "exit-2",
+				},
+				Spec: VoluntaryExitSpec{
+					Validator:  "my-validator",
+					PublicKeys: []string{publicKey},
+				},
+			},
+			NewExit: &VoluntaryExit{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "exit-2",
+				},
+				Spec: VoluntaryExitSpec{
+					Validator:  "my-validator",
+					PublicKeys: []string{publicKey, otherPublicKey},
+				},
+			},
+			Errors: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.publicKeys",
+					BadValue: []string{publicKey, otherPublicKey},
+					Detail:   "field is immutable",
+				},
+			},
+		},
+	}
+
+	Context("While creating voluntary exit", func() {
+		for _, c := range createCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					err := cc.Exit.ValidateCreate()
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+
+	Context("While updating voluntary exit", func() {
+		for _, c := range updateCases {
+			func() {
+				cc := c
+				It(fmt.Sprintf("Should validate %s", cc.Title), func() {
+					err := cc.NewExit.ValidateUpdate(cc.OldExit)
+
+					errStatus := err.(*errors.StatusError)
+
+					causes := shared.ErrorsToCauses(cc.Errors)
+
+					Expect(errStatus.ErrStatus.Details.Causes).To(ContainElements(causes))
+				})
+			}()
+		}
+	})
+})
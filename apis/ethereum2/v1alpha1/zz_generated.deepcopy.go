@@ -6,9 +6,31 @@
 package v1alpha1
 
 import (
+	"github.com/kotalco/kotal/apis/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeaconGenesis) DeepCopyInto(out *BeaconGenesis) {
+	*out = *in
+	if in.Bootnodes != nil {
+		in, out := &in.Bootnodes, &out.Bootnodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeaconGenesis.
+func (in *BeaconGenesis) DeepCopy() *BeaconGenesis {
+	if in == nil {
+		return nil
+	}
+	out := new(BeaconGenesis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BeaconNode) DeepCopyInto(out *BeaconNode) {
 	*out = *in
@@ -36,6 +58,21 @@ func (in *BeaconNode) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeaconNodeBuilder) DeepCopyInto(out *BeaconNodeBuilder) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeaconNodeBuilder.
+func (in *BeaconNodeBuilder) DeepCopy() *BeaconNodeBuilder {
+	if in == nil {
+		return nil
+	}
+	out := new(BeaconNodeBuilder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BeaconNodeList) DeepCopyInto(out *BeaconNodeList) {
 	*out = *in
@@ -71,6 +108,21 @@ func (in *BeaconNodeList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BeaconNodeSpec) DeepCopyInto(out *BeaconNodeSpec) {
 	*out = *in
+	if in.Genesis != nil {
+		in, out := &in.Genesis, &out.Genesis
+		*out = new(BeaconGenesis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Builder != nil {
+		in, out := &in.Builder, &out.Builder
+		*out = new(BeaconNodeBuilder)
+		**out = **in
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(shared.RPCGateway)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Hosts != nil {
 		in, out := &in.Hosts, &out.Hosts
 		*out = make([]string, len(*in))
@@ -125,7 +177,102 @@ func (in *Keystore) DeepCopy() *Keystore {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Validator) DeepCopyInto(out *Validator) {
+func (in *MEVBoost) DeepCopyInto(out *MEVBoost) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MEVBoost.
+func (in *MEVBoost) DeepCopy() *MEVBoost {
+	if in == nil {
+		return nil
+	}
+	out := new(MEVBoost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MEVBoost) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MEVBoostList) DeepCopyInto(out *MEVBoostList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MEVBoost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MEVBoostList.
+func (in *MEVBoostList) DeepCopy() *MEVBoostList {
+	if in == nil {
+		return nil
+	}
+	out := new(MEVBoostList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MEVBoostList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MEVBoostSpec) DeepCopyInto(out *MEVBoostSpec) {
+	*out = *in
+	if in.Relays != nil {
+		in, out := &in.Relays, &out.Relays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MEVBoostSpec.
+func (in *MEVBoostSpec) DeepCopy() *MEVBoostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MEVBoostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MEVBoostStatus) DeepCopyInto(out *MEVBoostStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MEVBoostStatus.
+func (in *MEVBoostStatus) DeepCopy() *MEVBoostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MEVBoostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteSigner) DeepCopyInto(out *RemoteSigner) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -133,6 +280,161 @@ func (in *Validator) DeepCopyInto(out *Validator) {
 	out.Status = in.Status
 }
 
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteSigner.
+func (in *RemoteSigner) DeepCopy() *RemoteSigner {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteSigner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteSigner) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteSignerList) DeepCopyInto(out *RemoteSignerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RemoteSigner, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteSignerList.
+func (in *RemoteSignerList) DeepCopy() *RemoteSignerList {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteSignerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteSignerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteSignerSpec) DeepCopyInto(out *RemoteSignerSpec) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CORSDomains != nil {
+		in, out := &in.CORSDomains, &out.CORSDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keystores != nil {
+		in, out := &in.Keystores, &out.Keystores
+		*out = make([]Keystore, len(*in))
+		copy(*out, *in)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteSignerSpec.
+func (in *RemoteSignerSpec) DeepCopy() *RemoteSignerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteSignerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteSignerStatus) DeepCopyInto(out *RemoteSignerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteSignerStatus.
+func (in *RemoteSignerStatus) DeepCopy() *RemoteSignerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteSignerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlashingProtection) DeepCopyInto(out *SlashingProtection) {
+	*out = *in
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(SlashingProtectionSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlashingProtection.
+func (in *SlashingProtection) DeepCopy() *SlashingProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(SlashingProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlashingProtectionSource) DeepCopyInto(out *SlashingProtectionSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlashingProtectionSource.
+func (in *SlashingProtectionSource) DeepCopy() *SlashingProtectionSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SlashingProtectionSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlashingProtectionStatus) DeepCopyInto(out *SlashingProtectionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlashingProtectionStatus.
+func (in *SlashingProtectionStatus) DeepCopy() *SlashingProtectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SlashingProtectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Validator) DeepCopyInto(out *Validator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Validator.
 func (in *Validator) DeepCopy() *Validator {
 	if in == nil {
@@ -151,6 +453,145 @@ func (in *Validator) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorBuilder) DeepCopyInto(out *ValidatorBuilder) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorBuilder.
+func (in *ValidatorBuilder) DeepCopy() *ValidatorBuilder {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorBuilder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorGenesis) DeepCopyInto(out *ValidatorGenesis) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorGenesis.
+func (in *ValidatorGenesis) DeepCopy() *ValidatorGenesis {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorGenesis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorKeyStatus) DeepCopyInto(out *ValidatorKeyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorKeyStatus.
+func (in *ValidatorKeyStatus) DeepCopy() *ValidatorKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorKeys) DeepCopyInto(out *ValidatorKeys) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorKeys.
+func (in *ValidatorKeys) DeepCopy() *ValidatorKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ValidatorKeys) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorKeysList) DeepCopyInto(out *ValidatorKeysList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ValidatorKeys, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorKeysList.
+func (in *ValidatorKeysList) DeepCopy() *ValidatorKeysList {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorKeysList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ValidatorKeysList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorKeysSpec) DeepCopyInto(out *ValidatorKeysSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorKeysSpec.
+func (in *ValidatorKeysSpec) DeepCopy() *ValidatorKeysSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorKeysSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorKeysStatus) DeepCopyInto(out *ValidatorKeysStatus) {
+	*out = *in
+	if in.Keystores != nil {
+		in, out := &in.Keystores, &out.Keystores
+		*out = make([]Keystore, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorKeysStatus.
+func (in *ValidatorKeysStatus) DeepCopy() *ValidatorKeysStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorKeysStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidatorList) DeepCopyInto(out *ValidatorList) {
 	*out = *in
@@ -183,9 +624,29 @@ func (in *ValidatorList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorMigration) DeepCopyInto(out *ValidatorMigration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorMigration.
+func (in *ValidatorMigration) DeepCopy() *ValidatorMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidatorSpec) DeepCopyInto(out *ValidatorSpec) {
 	*out = *in
+	if in.Genesis != nil {
+		in, out := &in.Genesis, &out.Genesis
+		*out = new(ValidatorGenesis)
+		**out = **in
+	}
 	if in.BeaconEndpoints != nil {
 		in, out := &in.BeaconEndpoints, &out.BeaconEndpoints
 		*out = make([]string, len(*in))
@@ -196,6 +657,21 @@ func (in *ValidatorSpec) DeepCopyInto(out *ValidatorSpec) {
 		*out = make([]Keystore, len(*in))
 		copy(*out, *in)
 	}
+	if in.Builder != nil {
+		in, out := &in.Builder, &out.Builder
+		*out = new(ValidatorBuilder)
+		**out = **in
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(ValidatorMigration)
+		**out = **in
+	}
+	if in.SlashingProtection != nil {
+		in, out := &in.SlashingProtection, &out.SlashingProtection
+		*out = new(SlashingProtection)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Resources.DeepCopyInto(&out.Resources)
 }
 
@@ -212,6 +688,24 @@ func (in *ValidatorSpec) DeepCopy() *ValidatorSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidatorStatus) DeepCopyInto(out *ValidatorStatus) {
 	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.SlashingProtection = in.SlashingProtection
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]ValidatorKeyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorStatus.
@@ -223,3 +717,117 @@ func (in *ValidatorStatus) DeepCopy() *ValidatorStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VoluntaryExit) DeepCopyInto(out *VoluntaryExit) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VoluntaryExit.
+func (in *VoluntaryExit) DeepCopy() *VoluntaryExit {
+	if in == nil {
+		return nil
+	}
+	out := new(VoluntaryExit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VoluntaryExit) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VoluntaryExitKeyStatus) DeepCopyInto(out *VoluntaryExitKeyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VoluntaryExitKeyStatus.
+func (in *VoluntaryExitKeyStatus) DeepCopy() *VoluntaryExitKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VoluntaryExitKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VoluntaryExitList) DeepCopyInto(out *VoluntaryExitList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VoluntaryExit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VoluntaryExitList.
+func (in *VoluntaryExitList) DeepCopy() *VoluntaryExitList {
+	if in == nil {
+		return nil
+	}
+	out := new(VoluntaryExitList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VoluntaryExitList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VoluntaryExitSpec) DeepCopyInto(out *VoluntaryExitSpec) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VoluntaryExitSpec.
+func (in *VoluntaryExitSpec) DeepCopy() *VoluntaryExitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VoluntaryExitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VoluntaryExitStatus) DeepCopyInto(out *VoluntaryExitStatus) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]VoluntaryExitKeyStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VoluntaryExitStatus.
+func (in *VoluntaryExitStatus) DeepCopy() *VoluntaryExitStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VoluntaryExitStatus)
+	in.DeepCopyInto(out)
+	return out
+}
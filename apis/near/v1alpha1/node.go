@@ -31,6 +31,7 @@ type NodeSpec struct {
 	// TelemetryURL is telemetry service URL
 	TelemetryURL string `json:"telemetryURL,omitempty"`
 	// Bootnodes is array of boot nodes to bootstrap network from
+	// boot node is either <public key>@<ip>:<port>, or kotal NEAR node reference in the format of name or name.namespace
 	// +listType=set
 	Bootnodes []string `json:"bootnodes,omitempty"`
 	// Resources is node compute and storage resources
@@ -40,6 +41,10 @@ type NodeSpec struct {
 // NodeStatus defines the observed state of Node
 type NodeStatus struct {
 	Client string `json:"client,omitempty"`
+	// BootnodeURL is the node boot node URL in the format of <public key>@<ip>:<port>
+	BootnodeURL string `json:"bootnodeURL,omitempty"`
+	// UnresolvedReferences is bootnodes references that couldn't be resolved
+	UnresolvedReferences []string `json:"unresolvedReferences,omitempty"`
 }
 
 // +kubebuilder:object:root=true
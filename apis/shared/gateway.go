@@ -0,0 +1,77 @@
+package shared
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// DefaultRPCGatewayImage is authenticated JSON-RPC and REST gateway image
+	DefaultRPCGatewayImage = "kotalco/rpc-gateway:v0.1.0"
+)
+
+// RPCGateway is authenticated gateway running in front of node APIs
+// node APIs are bound to localhost, and are reachable only through the gateway
+// +k8s:deepcopy-gen=true
+type RPCGateway struct {
+	// Image is RPC gateway image
+	Image string `json:"image,omitempty"`
+	// AllowedMethods is JSON-RPC methods, or REST paths, allowed through the gateway
+	// patterns ending with * match prefixes, like eth_* or /eth/v1/node/*
+	// all methods are allowed if no allowed methods are provided
+	// +listType=set
+	AllowedMethods []string `json:"allowedMethods,omitempty"`
+	// DeniedMethods is JSON-RPC methods, or REST paths, denied by the gateway
+	// denied methods take precedence over allowed methods
+	// +listType=set
+	DeniedMethods []string `json:"deniedMethods,omitempty"`
+	// APIKeysSecretName is kubernetes secret name holding API keys
+	// secret key is API key owner, and secret value is the API key
+	APIKeysSecretName string `json:"apiKeysSecretName,omitempty"`
+	// JWTSecretName is kubernetes secret name holding HS256 JWT tokens signing key in secret key
+	JWTSecretName string `json:"jwtSecretName,omitempty"`
+	// RateLimit is requests per second allowed for every API key owner or JWT token subject
+	// requests are not rate limited if rate limit is not provided
+	RateLimit uint `json:"rateLimit,omitempty"`
+	// RateLimitBurst is maximum requests burst for every API key owner or JWT token subject
+	RateLimitBurst uint `json:"rateLimitBurst,omitempty"`
+	// RequestLogging logs every request with its caller and methods
+	RequestLogging bool `json:"requestLogging,omitempty"`
+}
+
+// Default sets default values for the gateway
+func (g *RPCGateway) Default() {
+	if g.Image == "" {
+		g.Image = DefaultRPCGatewayImage
+	}
+
+	if g.RateLimitBurst == 0 {
+		g.RateLimitBurst = g.RateLimit
+	}
+}
+
+// Validate validates the gateway
+func (g *RPCGateway) Validate() (errors field.ErrorList) {
+	path := field.NewPath("spec").Child("gateway")
+
+	// gateway must authenticate requests
+	if g.APIKeysSecretName == "" && g.JWTSecretName == "" {
+		err := field.Invalid(path.Child("apiKeysSecretName"), "", "must provide apiKeysSecretName or jwtSecretName")
+		errors = append(errors, err)
+	}
+
+	for i, method := range g.DeniedMethods {
+		for _, allowed := range g.AllowedMethods {
+			if method == allowed {
+				err := field.Invalid(path.Child("deniedMethods").Index(i), method, "can't be allowed and denied")
+				errors = append(errors, err)
+			}
+		}
+	}
+
+	if g.RateLimitBurst != 0 && g.RateLimitBurst < g.RateLimit {
+		err := field.Invalid(path.Child("rateLimitBurst"), g.RateLimitBurst, "must be greater than or equal to rateLimit")
+		errors = append(errors, err)
+	}
+
+	return
+}
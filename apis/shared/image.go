@@ -0,0 +1,68 @@
+package shared
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ImageVersion returns major, minor and patch version parsed from image tag like v1.10.26 or 22.10.0
+// ok is false if image tag isn't a version, like latest, develop or digests
+func ImageVersion(image string) (version [3]int, ok bool) {
+	// digests identify image content, not version
+	if strings.Contains(image, "@") {
+		return
+	}
+
+	i := strings.LastIndex(image, ":")
+	// no tag, or colon is registry port
+	if i == -1 || strings.Contains(image[i+1:], "/") {
+		return
+	}
+
+	tag := strings.TrimPrefix(image[i+1:], "v")
+	// pre-release and build suffixes like -beta.0 or -alpine are ignored
+	if j := strings.IndexAny(tag, "-+_"); j != -1 {
+		tag = tag[:j]
+	}
+
+	parts := strings.Split(tag, ".")
+	if len(parts) > 3 {
+		return
+	}
+
+	for j, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return
+		}
+		version[j] = n
+	}
+
+	return version, true
+}
+
+// ImageVersionAtLeast returns true if image tag version is the given minimum version or later
+// images with tags that aren't versions are assumed to be recent enough
+func ImageVersionAtLeast(image, minimum string) bool {
+	if minimum == "" {
+		return true
+	}
+
+	version, ok := ImageVersion(image)
+	if !ok {
+		return true
+	}
+
+	required, ok := ImageVersion(":" + minimum)
+	if !ok {
+		return true
+	}
+
+	for i := range version {
+		if version[i] != required[i] {
+			return version[i] > required[i]
+		}
+	}
+
+	return true
+}
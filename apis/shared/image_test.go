@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Image version", func() {
+	cases := []struct {
+		image   string
+		minimum string
+		atLeast bool
+	}{
+		{"kotalco/geth:v1.10.26", "1.13.0", false},
+		{"kotalco/geth:v1.13.0", "1.13.0", true},
+		{"ethereum/client-go:v1.15.11", "1.13.0", true},
+		{"hyperledger/besu:22.10.0", "23.7.2", false},
+		{"hyperledger/besu:25.4.1", "23.7.2", true},
+		{"sigp/lighthouse:v7.0.0-beta.5", "7.0.0", true},
+		{"sigp/lighthouse:v6.0.1", "7.0.0", false},
+		{"flashbots/mev-boost:1.9", "1.9.1", false},
+		{"ethereum/client-go:latest", "1.13.0", true},
+		{"ethereum/client-go", "1.13.0", true},
+		{"localhost:5000/geth", "1.13.0", true},
+		{"ethereum/client-go@sha256:0d8f", "1.13.0", true},
+		{"kotalco/geth:v1.10.26", "", true},
+	}
+
+	for _, c := range cases {
+		func() {
+			cc := c
+			It(fmt.Sprintf("Should check %s is at least version %s", cc.image, cc.minimum), func() {
+				Expect(ImageVersionAtLeast(cc.image, cc.minimum)).To(Equal(cc.atLeast))
+			})
+		}()
+	}
+})
@@ -0,0 +1,58 @@
+package shared
+
+import (
+	"context"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Warner is validated resource that reports admission warnings, like joining deprecated networks
+type Warner interface {
+	admission.Validator
+	Warnings() []string
+}
+
+// warningHandler adds resource warnings to allowed admission responses
+type warningHandler struct {
+	admission.Handler
+	warner  Warner
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &warningHandler{}
+
+// InjectDecoder injects the decoder into the handler and the wrapped validating handler
+func (h *warningHandler) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	_, err := admission.InjectDecoderInto(d, h.Handler)
+	return err
+}
+
+// Handle validates the resource, then adds its warnings to the response
+func (h *warningHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	response := h.Handler.Handle(ctx, req)
+
+	if !response.Allowed || req.Operation == admissionv1.Delete {
+		return response
+	}
+
+	obj := h.warner.DeepCopyObject().(Warner)
+	if err := h.decoder.Decode(req, obj); err != nil {
+		return response
+	}
+
+	return response.WithWarnings(obj.Warnings()...)
+}
+
+// RegisterValidatingWebhookWithWarnings registers validating webhook that reports resource warnings
+// it must be registered before the webhook builder, which skips already registered paths
+func RegisterValidatingWebhookWithWarnings(mgr ctrl.Manager, path string, warner Warner) {
+	mgr.GetWebhookServer().Register(path, &admission.Webhook{
+		Handler: &warningHandler{
+			Handler: admission.ValidatingWebhookFor(warner).Handler,
+			warner:  warner,
+		},
+	})
+}
@@ -7,6 +7,31 @@ package shared
 
 import ()
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RPCGateway) DeepCopyInto(out *RPCGateway) {
+	*out = *in
+	if in.AllowedMethods != nil {
+		in, out := &in.AllowedMethods, &out.AllowedMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedMethods != nil {
+		in, out := &in.DeniedMethods, &out.DeniedMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RPCGateway.
+func (in *RPCGateway) DeepCopy() *RPCGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(RPCGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resources) DeepCopyInto(out *Resources) {
 	*out = *in
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
 	"github.com/kotalco/kotal/controllers/shared"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -39,12 +40,25 @@ func (b *BesuClient) Args() (args []string) {
 
 	node := b.node
 
-	args = append(args, BesuNatMethod, "KUBERNETES")
+	args = append(args, BesuNatMethod, besuNatMethod(node))
 	args = append(args, BesuDataPath, shared.PathData(b.HomeDir()))
 	args = append(args, BesuP2PPort, fmt.Sprintf("%d", node.Spec.P2PPort))
 	args = append(args, BesuSyncMode, string(node.Spec.SyncMode))
 	args = append(args, BesuLogging, strings.ToUpper(string(node.Spec.Logging)))
 
+	if p2p := node.Spec.P2P; p2p != nil {
+		if p2p.ExternalIP != "" {
+			args = append(args, BesuP2PHost, p2p.ExternalIP)
+		}
+		if p2p.ExternalHostname != "" {
+			args = append(args, BesuP2PHost, p2p.ExternalHostname)
+			args = append(args, BesuDNSEnabled, "true")
+		}
+		if p2p.MaxPeers != 0 {
+			args = append(args, BesuMaxPeers, fmt.Sprintf("%d", p2p.MaxPeers))
+		}
+	}
+
 	if node.Spec.NodePrivateKeySecretName != "" {
 		args = append(args, BesuNodePrivateKey, fmt.Sprintf("%s/nodekey", shared.PathSecrets(b.HomeDir())))
 	}
@@ -75,6 +89,42 @@ func (b *BesuClient) Args() (args []string) {
 		args = append(args, BesuMinerCoinbase, string(node.Spec.Coinbase))
 	}
 
+	if node.Spec.Performance != nil && node.Spec.Performance.Pruning == ethereumv1alpha1.PrunedPruning {
+		args = append(args, BesuPruningEnabled)
+	}
+
+	if node.Spec.Performance != nil && node.Spec.Performance.StorageFormat != "" {
+		args = append(args, BesuDataStorageFormat, strings.ToUpper(string(node.Spec.Performance.StorageFormat)))
+	}
+
+	// serving options aren't supported by older besu images
+	if serve := node.Spec.Serve; serve != nil {
+		if serve.Snap != nil && *serve.Snap && sharedAPI.ImageVersionAtLeast(node.Spec.Image, node.Spec.Client.SnapServingMinimumVersion()) {
+			args = append(args, BesuSnapSyncServerEnabled)
+		}
+		if serve.HistoryExpiry && sharedAPI.ImageVersionAtLeast(node.Spec.Image, node.Spec.Client.HistoryExpiryMinimumVersion()) {
+			args = append(args, BesuHistoryExpiryPrune)
+		}
+	}
+
+	if txPool := node.Spec.TxPool; txPool != nil {
+		if txPool.Size != 0 {
+			args = append(args, BesuTxPoolMaxSize, fmt.Sprintf("%d", txPool.Size))
+		}
+		if txPool.Lifetime != 0 {
+			args = append(args, BesuTxPoolRetentionHours, fmt.Sprintf("%d", txPool.Lifetime))
+		}
+	}
+
+	if mining := node.Spec.Mining; mining != nil {
+		if mining.MinGasPrice != 0 {
+			args = append(args, BesuMinGasPrice, fmt.Sprintf("%d", mining.MinGasPrice))
+		}
+		if mining.GasTarget != 0 {
+			args = append(args, BesuTargetGasLimit, fmt.Sprintf("%d", mining.GasTarget))
+		}
+	}
+
 	// convert spec rpc modules into format suitable for cli option
 	normalizedAPIs := func(modules []ethereumv1alpha1.API) string {
 		apis := []string{}
@@ -86,8 +136,9 @@ func (b *BesuClient) Args() (args []string) {
 
 	if node.Spec.RPC {
 		args = append(args, BesuRPCHTTPEnabled)
-		args = append(args, BesuRPCHTTPHost, shared.Host(node.Spec.RPC))
-		args = append(args, BesuRPCHTTPPort, fmt.Sprintf("%d", node.Spec.RPCPort))
+		rpcHost, rpcPort := rpcServer(node)
+		args = append(args, BesuRPCHTTPHost, rpcHost)
+		args = append(args, BesuRPCHTTPPort, fmt.Sprintf("%d", rpcPort))
 		args = append(args, BesuRPCHTTPAPI, normalizedAPIs(node.Spec.RPCAPI))
 	}
 
@@ -100,8 +151,9 @@ func (b *BesuClient) Args() (args []string) {
 
 	if node.Spec.WS {
 		args = append(args, BesuRPCWSEnabled)
-		args = append(args, BesuRPCWSHost, shared.Host(node.Spec.WS))
-		args = append(args, BesuRPCWSPort, fmt.Sprintf("%d", node.Spec.WSPort))
+		wsHost, wsPort := wsServer(node)
+		args = append(args, BesuRPCWSHost, wsHost)
+		args = append(args, BesuRPCWSPort, fmt.Sprintf("%d", wsPort))
 		args = append(args, BesuRPCWSAPI, normalizedAPIs(node.Spec.WSAPI))
 	}
 
@@ -130,6 +182,27 @@ func (b *BesuClient) Args() (args []string) {
 		// no ws cors setting
 	}
 
+	if node.Spec.Privacy != nil {
+		args = append(args, BesuPrivacyEnabled)
+		// transaction manager is in the same namespace as the node
+		args = append(args, BesuPrivacyURL, fmt.Sprintf("http://%s:%d", node.Spec.Privacy.TransactionManager, TesseraQ2TPort))
+		if node.Spec.Privacy.MultiTenancy {
+			// tenant privacy public key is read from the JWT token
+			jwtPublicKeyPath := fmt.Sprintf("%s/privacy-jwt.pub", shared.PathSecrets(b.HomeDir()))
+			args = append(args, BesuPrivacyMultiTenancyEnabled)
+			if node.Spec.RPC {
+				args = append(args, BesuRPCHTTPAuthenticationEnabled)
+				args = append(args, BesuRPCHTTPAuthenticationJWTPublicKeyFile, jwtPublicKeyPath)
+			}
+			if node.Spec.WS {
+				args = append(args, BesuRPCWSAuthenticationEnabled)
+				args = append(args, BesuRPCWSAuthenticationJWTPublicKeyFile, jwtPublicKeyPath)
+			}
+		} else {
+			args = append(args, BesuPrivacyPublicKeyFile, fmt.Sprintf("%s/privacy.pub", shared.PathSecrets(b.HomeDir())))
+		}
+	}
+
 	return args
 }
 
@@ -180,7 +253,7 @@ func (b *BesuClient) Genesis() (content string, err error) {
 			"futureMessagesMaxDistance": genesis.IBFT2.FutureMessagesMaxDistance,
 		}
 		engine = "ibft2"
-		mixHash = "0x63746963616c2062797a616e74696e65206661756c7420746f6c6572616e6365"
+		mixHash = IBFT2MixHash
 		nonce = "0x0"
 		difficulty = "0x1"
 		extraData, err = createExtraDataFromValidators(genesis.IBFT2.Validators)
@@ -265,3 +338,19 @@ func (b *BesuClient) EncodeStaticNodes() string {
 	encoded, _ := json.Marshal(b.node.Spec.StaticNodes)
 	return string(encoded)
 }
+
+// besuNatMethod returns besu nat method
+// kubernetes nat method is used unless nat method or external address is provided
+func besuNatMethod(node *ethereumv1alpha1.Node) string {
+	p2p := node.Spec.P2P
+	if p2p == nil {
+		return "KUBERNETES"
+	}
+	if p2p.ExternalIP != "" || p2p.ExternalHostname != "" {
+		return "NONE"
+	}
+	if p2p.NAT != "" {
+		return strings.ToUpper(string(p2p.NAT))
+	}
+	return "KUBERNETES"
+}
@@ -140,6 +140,66 @@ var _ = Describe("Besu Client", func() {
 
 	})
 
+	Context("Joining hoodi", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "besu-hoodi-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.BesuClient,
+				Network: ethereumv1alpha1.HoodiNetwork,
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				BesuNetwork,
+				ethereumv1alpha1.HoodiNetwork,
+			))
+		})
+
+	})
+
+	Context("rpc behind gateway", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "besu-gateway-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.BesuClient,
+				Network: ethereumv1alpha1.MainNetwork,
+				RPC:     true,
+				WS:      true,
+				Gateway: &sharedAPI.RPCGateway{
+					APIKeysSecretName: "besu-api-keys",
+				},
+			},
+		}
+		node.Default()
+
+		It("should bind rpc and ws servers to localhost", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			args := client.Args()
+			Expect(args).To(ContainElements(
+				BesuRPCHTTPHost,
+				"127.0.0.1",
+				BesuRPCHTTPPort,
+				"18545",
+				BesuRPCWSHost,
+				BesuRPCWSPort,
+				"18546",
+			))
+			Expect(args).NotTo(ContainElement("0.0.0.0"))
+		})
+
+	})
+
 	Context("miner in private PoW network", func() {
 		node := &ethereumv1alpha1.Node{
 			ObjectMeta: metav1.ObjectMeta{
@@ -272,4 +332,240 @@ var _ = Describe("Besu Client", func() {
 
 	})
 
+	Context("private transactions node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "besu-privacy-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Genesis: &ethereumv1alpha1.Genesis{
+					ChainID:   12345,
+					NetworkID: 12345,
+					Ethash:    &ethereumv1alpha1.Ethash{},
+				},
+				Client: ethereumv1alpha1.BesuClient,
+				Privacy: &ethereumv1alpha1.Privacy{
+					TransactionManager: "tessera",
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).NotTo(ContainElements(BesuPrivacyMultiTenancyEnabled))
+			Expect(client.Args()).To(ContainElements(
+				BesuPrivacyEnabled,
+				BesuPrivacyURL,
+				fmt.Sprintf("http://tessera:%d", TesseraQ2TPort),
+				BesuPrivacyPublicKeyFile,
+				fmt.Sprintf("%s/privacy.pub", shared.PathSecrets(client.HomeDir())),
+			))
+		})
+
+	})
+
+	Context("multi-tenant private transactions node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "besu-multi-tenant-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Genesis: &ethereumv1alpha1.Genesis{
+					ChainID:   12345,
+					NetworkID: 12345,
+					Ethash:    &ethereumv1alpha1.Ethash{},
+				},
+				Client: ethereumv1alpha1.BesuClient,
+				RPC:    true,
+				WS:     true,
+				Privacy: &ethereumv1alpha1.Privacy{
+					TransactionManager:     "tessera",
+					MultiTenancy:           true,
+					JWTPublicKeySecretName: "jwt-public-key",
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+
+			client, err := NewClient(node)
+			jwtPublicKeyPath := fmt.Sprintf("%s/privacy-jwt.pub", shared.PathSecrets(client.HomeDir()))
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).NotTo(ContainElements(BesuPrivacyPublicKeyFile))
+			Expect(client.Args()).To(ContainElements(
+				BesuPrivacyEnabled,
+				BesuPrivacyURL,
+				fmt.Sprintf("http://tessera:%d", TesseraQ2TPort),
+				BesuPrivacyMultiTenancyEnabled,
+				BesuRPCHTTPAuthenticationEnabled,
+				BesuRPCHTTPAuthenticationJWTPublicKeyFile,
+				jwtPublicKeyPath,
+				BesuRPCWSAuthenticationEnabled,
+				BesuRPCWSAuthenticationJWTPublicKeyFile,
+				jwtPublicKeyPath,
+			))
+		})
+
+	})
+
+	Context("tuned miner node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "besu-tuned-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Genesis: &ethereumv1alpha1.Genesis{
+					ChainID:   12345,
+					NetworkID: 12345,
+					Ethash:    &ethereumv1alpha1.Ethash{},
+				},
+				Client:   ethereumv1alpha1.BesuClient,
+				Miner:    true,
+				Coinbase: sharedAPI.EthereumAddress(coinbase),
+				Performance: &ethereumv1alpha1.Performance{
+					Pruning: ethereumv1alpha1.PrunedPruning,
+				},
+				TxPool: &ethereumv1alpha1.TxPool{
+					Size:     10000,
+					Lifetime: 6,
+				},
+				Mining: &ethereumv1alpha1.Mining{
+					MinGasPrice: 1000000000,
+					GasTarget:   30000000,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				BesuPruningEnabled,
+				BesuTxPoolMaxSize,
+				"10000",
+				BesuTxPoolRetentionHours,
+				"6",
+				BesuMinGasPrice,
+				"1000000000",
+				BesuTargetGasLimit,
+				"30000000",
+			))
+		})
+
+	})
+
+	Context("bonsai storage node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "besu-bonsai-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.BesuClient,
+				Network: ethereumv1alpha1.MainNetwork,
+				Performance: &ethereumv1alpha1.Performance{
+					StorageFormat: ethereumv1alpha1.BonsaiStorageFormat,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				BesuDataStorageFormat,
+				"BONSAI",
+			))
+			Expect(client.Args()).NotTo(ContainElement(BesuPruningEnabled))
+		})
+
+	})
+
+	Context("node advertising external hostname", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "besu-external-hostname-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.BesuClient,
+				Network: ethereumv1alpha1.MainNetwork,
+				P2P: &ethereumv1alpha1.P2P{
+					ExternalHostname: "besu.example.com",
+					MaxPeers:         50,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			args := client.Args()
+			Expect(args).To(ContainElements(
+				BesuNatMethod,
+				"NONE",
+				BesuP2PHost,
+				"besu.example.com",
+				BesuDNSEnabled,
+				"true",
+				BesuMaxPeers,
+				"50",
+			))
+			Expect(args).NotTo(ContainElement("KUBERNETES"))
+		})
+
+	})
+
+	Context("snap serving node", func() {
+		snap := true
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "besu-snap-server-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:   ethereumv1alpha1.BesuClient,
+				Network:  ethereumv1alpha1.SepoliaNetwork,
+				SyncMode: ethereumv1alpha1.FastSynchronization,
+				Image:    "hyperledger/besu:25.7.0",
+				Serve: &ethereumv1alpha1.Serve{
+					Snap:          &snap,
+					HistoryExpiry: true,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				BesuSnapSyncServerEnabled,
+				BesuHistoryExpiryPrune,
+			))
+		})
+
+		It("should not set serving options of besu images without them", func() {
+			older := node.DeepCopy()
+			older.Spec.Image = ethereumv1alpha1.DefaultBesuImage
+
+			client, err := NewClient(older)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).NotTo(ContainElement(BesuSnapSyncServerEnabled))
+			Expect(client.Args()).NotTo(ContainElement(BesuHistoryExpiryPrune))
+		})
+
+	})
 })
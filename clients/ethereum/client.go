@@ -5,6 +5,7 @@ import (
 
 	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
 	"github.com/kotalco/kotal/clients"
+	"github.com/kotalco/kotal/controllers/shared"
 )
 
 // EthereumClient is Ethereum client
@@ -27,3 +28,31 @@ func NewClient(node *ethereumv1alpha1.Node) (EthereumClient, error) {
 		return nil, fmt.Errorf("client %s is not supported", node.Spec.Client)
 	}
 }
+
+// NewTransactionManagerClient returns a private transaction manager client instance
+func NewTransactionManagerClient(tm *ethereumv1alpha1.TransactionManager) (clients.Interface, error) {
+	switch tm.Spec.Client {
+	case ethereumv1alpha1.TesseraTransactionManager:
+		return &TesseraClient{tm}, nil
+	default:
+		return nil, fmt.Errorf("transaction manager client %s is not supported", tm.Spec.Client)
+	}
+}
+
+// rpcServer returns node JSON-RPC server host and port
+// server is bound to localhost behind the gateway, which listens on node rpc port
+func rpcServer(node *ethereumv1alpha1.Node) (string, uint) {
+	if node.Spec.Gateway != nil {
+		return shared.Host(false), shared.GatewayRPCUpstreamPort
+	}
+	return shared.Host(node.Spec.RPC), node.Spec.RPCPort
+}
+
+// wsServer returns node web socket server host and port
+// server is bound to localhost behind the gateway, which listens on node ws port
+func wsServer(node *ethereumv1alpha1.Node) (string, uint) {
+	if node.Spec.Gateway != nil {
+		return shared.Host(false), shared.GatewayWSUpstreamPort
+	}
+	return shared.Host(node.Spec.WS), node.Spec.WSPort
+}
@@ -0,0 +1,692 @@
+package ethereum
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// updateGolden rewrites genesis golden files with currently rendered genesis files
+var updateGolden = flag.Bool("update", false, "update genesis golden files")
+
+// genesisGoldenDir is rendered genesis golden files directory
+const genesisGoldenDir = "testdata/genesis"
+
+// forkNames is forks compared across clients, in activation order
+var forkNames = []string{
+	"homestead",
+	"eip150",
+	"eip155",
+	"eip158",
+	"byzantium",
+	"constantinople",
+	"petersburg",
+	"istanbul",
+	"muirGlacier",
+	"berlin",
+	"london",
+	"arrowGlacier",
+}
+
+// builtinActivations is builtin contracts and the forks changing their behavior
+var builtinActivations = map[string][]string{
+	"ecrecover":         nil,
+	"sha256":            nil,
+	"ripemd160":         nil,
+	"identity":          nil,
+	"modexp":            {"byzantium", "berlin"},
+	"alt_bn128_add":     {"byzantium", "istanbul"},
+	"alt_bn128_mul":     {"byzantium", "istanbul"},
+	"alt_bn128_pairing": {"byzantium", "istanbul"},
+	"blake2_f":          {"istanbul"},
+}
+
+// normalizedGenesis is client independent view of rendered genesis
+type normalizedGenesis struct {
+	ChainID uint64
+	Forks   map[string]uint64
+	// DAO is DAO fork block, nil if the node doesn't support DAO fork
+	DAO *uint64
+	// Rewards is ethash block reward at every fork boundary
+	Rewards map[uint64]string
+	// BombDelays is ethash difficulty bomb delay at every fork boundary
+	BombDelays map[uint64]uint64
+	Alloc      map[common.Address]normalizedAccount
+	Hash       string
+}
+
+// normalizedAccount is client independent view of genesis account
+type normalizedAccount struct {
+	Balance string
+	Code    string
+	Storage map[common.Hash]common.Hash
+}
+
+// normalizeAlloc normalizes genesis accounts
+func normalizeAlloc(alloc core.GenesisAlloc) map[common.Address]normalizedAccount {
+	accounts := map[common.Address]normalizedAccount{}
+	for address, account := range alloc {
+		normalized := normalizedAccount{
+			Balance: account.Balance.String(),
+			Code:    common.Bytes2Hex(account.Code),
+			Storage: map[common.Hash]common.Hash{},
+		}
+		for key, value := range account.Storage {
+			normalized.Storage[key] = value
+		}
+		accounts[address] = normalized
+	}
+	return accounts
+}
+
+// genesisCase is a genesis spec fed to all clients
+type genesisCase struct {
+	name    string
+	genesis *ethereumv1alpha1.Genesis
+}
+
+// renderGenesis renders genesis using the given client
+func renderGenesis(client ethereumv1alpha1.EthereumClient, genesis *ethereumv1alpha1.Genesis) string {
+	node := &ethereumv1alpha1.Node{
+		Spec: ethereumv1alpha1.NodeSpec{
+			Client:  client,
+			Genesis: genesis,
+		},
+	}
+
+	var content string
+	var err error
+
+	switch client {
+	case ethereumv1alpha1.GethClient:
+		content, err = (&GethClient{node: node}).Genesis()
+	case ethereumv1alpha1.BesuClient:
+		content, err = (&BesuClient{node: node}).Genesis()
+	case ethereumv1alpha1.NethermindClient:
+		content, err = (&ParityGenesis{}).Genesis(node)
+	}
+
+	Expect(err).To(BeNil())
+	return content
+}
+
+// parseUint parses hex or decimal number
+func parseUint(value string) uint64 {
+	n, err := strconv.ParseUint(strings.TrimPrefix(value, "0x"), 16, 64)
+	if !strings.HasPrefix(value, "0x") {
+		n, err = strconv.ParseUint(value, 10, 64)
+	}
+	Expect(err).To(BeNil(), "invalid number %s", value)
+	return n
+}
+
+// forkBoundaries returns genesis block and the given blocks, sorted and deduplicated
+func forkBoundaries(blocks ...map[string]uint64) []uint64 {
+	unique := map[uint64]bool{0: true}
+	for _, m := range blocks {
+		for _, block := range m {
+			unique[block] = true
+		}
+	}
+	boundaries := []uint64{}
+	for block := range unique {
+		boundaries = append(boundaries, block)
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i] < boundaries[j] })
+	return boundaries
+}
+
+// ethashSchedule returns geth and besu block rewards and difficulty bomb delays at fork boundaries
+func ethashSchedule(forks map[string]uint64) (map[uint64]string, map[uint64]uint64) {
+	rewards := map[uint64]string{}
+	delays := map[uint64]uint64{}
+
+	for _, block := range forkBoundaries(forks) {
+		switch {
+		case block >= forks["constantinople"]:
+			rewards[block] = "2000000000000000000"
+		case block >= forks["byzantium"]:
+			rewards[block] = "3000000000000000000"
+		default:
+			rewards[block] = "5000000000000000000"
+		}
+
+		switch {
+		case block >= forks["arrowGlacier"]:
+			delays[block] = 10700000
+		case block >= forks["london"]:
+			delays[block] = 9700000
+		case block >= forks["muirGlacier"]:
+			delays[block] = 9000000
+		case block >= forks["constantinople"]:
+			delays[block] = 5000000
+		case block >= forks["byzantium"]:
+			delays[block] = 3000000
+		default:
+			delays[block] = 0
+		}
+	}
+
+	return rewards, delays
+}
+
+// normalizeChainConfig normalizes geth and besu rendered genesis
+// besu supports DAO fork if DAO fork block is provided, geth requires explicit DAO fork support
+func normalizeChainConfig(content string, engine string, daoSupportRequired bool) normalizedGenesis {
+	genesis := &core.Genesis{}
+	Expect(json.Unmarshal([]byte(content), genesis)).To(Succeed())
+
+	config := genesis.Config
+	blocks := []*big.Int{
+		config.HomesteadBlock,
+		config.EIP150Block,
+		config.EIP155Block,
+		config.EIP158Block,
+		config.ByzantiumBlock,
+		config.ConstantinopleBlock,
+		config.PetersburgBlock,
+		config.IstanbulBlock,
+		config.MuirGlacierBlock,
+		config.BerlinBlock,
+		config.LondonBlock,
+		config.ArrowGlacierBlock,
+	}
+
+	forks := map[string]uint64{}
+	for i, block := range blocks {
+		Expect(block).NotTo(BeNil(), "fork %s is not activated", forkNames[i])
+		forks[forkNames[i]] = block.Uint64()
+	}
+
+	normalized := normalizedGenesis{
+		ChainID: config.ChainID.Uint64(),
+		Forks:   forks,
+		Alloc:   normalizeAlloc(genesis.Alloc),
+		Hash:    genesis.ToBlock().Hash().Hex(),
+	}
+
+	if config.DAOForkBlock != nil && (config.DAOForkSupport || !daoSupportRequired) {
+		dao := config.DAOForkBlock.Uint64()
+		normalized.DAO = &dao
+	}
+
+	if engine == "ethash" {
+		normalized.Rewards, normalized.BombDelays = ethashSchedule(forks)
+	}
+
+	// muir glacier and arrow glacier forks only delay the difficulty bomb
+	// they're compared using the difficulty bomb delays at fork boundaries
+	delete(forks, "muirGlacier")
+	delete(forks, "arrowGlacier")
+
+	return normalized
+}
+
+// parityChainspec is parity chainspec fields compared across clients
+type parityChainspec struct {
+	Genesis struct {
+		Seal struct {
+			Ethereum struct {
+				Nonce   string `json:"nonce"`
+				MixHash string `json:"mixHash"`
+			} `json:"ethereum"`
+		} `json:"seal"`
+		Timestamp     string `json:"timestamp"`
+		GasLimit      string `json:"gasLimit"`
+		Difficulty    string `json:"difficulty"`
+		Author        string `json:"author"`
+		ExtraData     string `json:"extraData"`
+		BaseFeePerGas string `json:"baseFeePerGas"`
+	} `json:"genesis"`
+	Params map[string]string `json:"params"`
+	Engine struct {
+		Ethash *struct {
+			Params struct {
+				HomesteadTransition   string            `json:"homesteadTransition"`
+				EIP100bTransition     string            `json:"eip100bTransition"`
+				DAOHardforkTransition *string           `json:"daoHardforkTransition"`
+				BlockReward           map[string]string `json:"blockReward"`
+				DifficultyBombDelays  map[string]string `json:"difficultyBombDelays"`
+			} `json:"params"`
+		} `json:"Ethash"`
+	} `json:"engine"`
+	Accounts map[string]struct {
+		Balance string            `json:"balance"`
+		Code    string            `json:"code"`
+		Storage map[string]string `json:"storage"`
+		Builtin *struct {
+			Name       string                     `json:"name"`
+			ActivateAt string                     `json:"activate_at"`
+			Pricing    map[string]json.RawMessage `json:"pricing"`
+		} `json:"builtin"`
+	} `json:"accounts"`
+}
+
+// parityTransition returns fork block from its eip transitions, all eip transitions must be activated at the same block
+func parityTransition(params map[string]string, eips ...string) uint64 {
+	block := parseUint(params[eips[0]+"Transition"])
+	for _, eip := range eips[1:] {
+		Expect(parseUint(params[eip+"Transition"])).To(Equal(block), "%s transition must be activated with %s transition", eip, eips[0])
+	}
+	return block
+}
+
+// builtinBlocks returns builtin contract pricing activation blocks
+func builtinBlocks(activateAt string, pricing map[string]json.RawMessage) []uint64 {
+	blocks := []uint64{}
+	if activateAt != "" {
+		blocks = append(blocks, parseUint(activateAt))
+	}
+	for key := range pricing {
+		if strings.HasPrefix(key, "0x") {
+			blocks = append(blocks, parseUint(key))
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i] < blocks[j] })
+	return blocks
+}
+
+// expectedBuiltinBlocks returns builtin contract activation blocks derived from forks
+func expectedBuiltinBlocks(name string, forks map[string]uint64) []uint64 {
+	unique := map[uint64]bool{}
+	for _, fork := range builtinActivations[name] {
+		unique[forks[fork]] = true
+	}
+	blocks := []uint64{}
+	for block := range unique {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i] < blocks[j] })
+	return blocks
+}
+
+// normalizeChainspec normalizes nethermind parity chainspec
+// homestead isn't configurable in non ethash chainspecs, it's activated at genesis block
+func normalizeChainspec(content string) normalizedGenesis {
+	spec := parityChainspec{}
+	Expect(json.Unmarshal([]byte(content), &spec)).To(Succeed())
+
+	transitions := spec.Params
+	ethash := spec.Engine.Ethash
+
+	forks := map[string]uint64{
+		"eip150":         parityTransition(transitions, "eip150"),
+		"eip155":         parityTransition(transitions, "eip155"),
+		"eip158":         parityTransition(transitions, "eip161abc", "eip161d", "eip160", "maxCodeSize"),
+		"byzantium":      parityTransition(transitions, "eip140", "eip211", "eip214", "eip658"),
+		"constantinople": parityTransition(transitions, "eip145", "eip1014", "eip1052", "eip1283"),
+		"petersburg":     parityTransition(transitions, "eip1283Disable"),
+		"istanbul":       parityTransition(transitions, "eip1283Reenable", "eip1344", "eip1706", "eip1884", "eip2028"),
+		"berlin":         parityTransition(transitions, "eip2929", "eip2930"),
+		"london":         parityTransition(transitions, "eip1559", "eip3198", "eip3541", "eip3529"),
+	}
+
+	normalized := normalizedGenesis{
+		ChainID: parseUint(transitions["chainID"]),
+		Forks:   forks,
+	}
+
+	if ethash != nil {
+		forks["homestead"] = parseUint(ethash.Params.HomesteadTransition)
+		Expect(parseUint(ethash.Params.EIP100bTransition)).To(Equal(forks["byzantium"]))
+
+		if ethash.Params.DAOHardforkTransition != nil {
+			dao := parseUint(*ethash.Params.DAOHardforkTransition)
+			normalized.DAO = &dao
+		}
+
+		// block rewards are replaced, and difficulty bomb delays are accumulated
+		rewardBlocks := map[uint64]string{}
+		rewardKeys := map[string]uint64{}
+		for block, reward := range ethash.Params.BlockReward {
+			wei, ok := new(big.Int).SetString(strings.TrimPrefix(reward, "0x"), 16)
+			Expect(ok).To(BeTrue())
+			rewardBlocks[parseUint(block)] = wei.String()
+			rewardKeys[block] = parseUint(block)
+		}
+		delayBlocks := map[uint64]uint64{}
+		delayKeys := map[string]uint64{}
+		for block, delay := range ethash.Params.DifficultyBombDelays {
+			delayBlocks[parseUint(block)] = parseUint(delay)
+			delayKeys[block] = parseUint(block)
+		}
+
+		normalized.Rewards = map[uint64]string{}
+		normalized.BombDelays = map[uint64]uint64{}
+		// muir glacier and arrow glacier blocks are the difficulty bomb delays blocks
+		for _, block := range forkBoundaries(forks, rewardKeys, delayKeys) {
+			var latest uint64
+			normalized.Rewards[block] = "0"
+			normalized.BombDelays[block] = 0
+			for rewardBlock, reward := range rewardBlocks {
+				if rewardBlock <= block && rewardBlock >= latest {
+					latest = rewardBlock
+					normalized.Rewards[block] = reward
+				}
+			}
+			for delayBlock, delay := range delayBlocks {
+				if delayBlock <= block {
+					normalized.BombDelays[block] += delay
+				}
+			}
+		}
+	} else {
+		forks["homestead"] = 0
+	}
+
+	genesis := &core.Genesis{
+		Config:     &params.ChainConfig{ChainID: new(big.Int).SetUint64(normalized.ChainID)},
+		Nonce:      parseUint(spec.Genesis.Seal.Ethereum.Nonce),
+		Timestamp:  parseUint(spec.Genesis.Timestamp),
+		GasLimit:   parseUint(spec.Genesis.GasLimit),
+		Difficulty: new(big.Int).SetUint64(parseUint(spec.Genesis.Difficulty)),
+		Mixhash:    common.HexToHash(spec.Genesis.Seal.Ethereum.MixHash),
+		Coinbase:   common.HexToAddress(spec.Genesis.Author),
+		ExtraData:  common.FromHex(spec.Genesis.ExtraData),
+	}
+	if spec.Genesis.BaseFeePerGas != "" {
+		genesis.BaseFee = new(big.Int).SetUint64(parseUint(spec.Genesis.BaseFeePerGas))
+	}
+
+	alloc := core.GenesisAlloc{}
+	for address, account := range spec.Accounts {
+		balance, ok := new(big.Int).SetString(strings.TrimPrefix(account.Balance, "0x"), 16)
+		Expect(ok).To(BeTrue(), "invalid balance %s", account.Balance)
+
+		genesisAccount := core.GenesisAccount{
+			Balance: balance,
+			Code:    common.FromHex(account.Code),
+		}
+		if account.Storage != nil {
+			genesisAccount.Storage = map[common.Hash]common.Hash{}
+			for key, value := range account.Storage {
+				genesisAccount.Storage[common.HexToHash(key)] = common.HexToHash(value)
+			}
+		}
+		alloc[common.HexToAddress(address)] = genesisAccount
+
+		// builtin contracts must be activated, and repriced at the same blocks as geth and besu
+		if builtin := account.Builtin; builtin != nil {
+			_, known := builtinActivations[builtin.Name]
+			Expect(known).To(BeTrue(), "unknown builtin contract %s", builtin.Name)
+			Expect(builtinBlocks(builtin.ActivateAt, builtin.Pricing)).To(Equal(expectedBuiltinBlocks(builtin.Name, forks)), "builtin contract %s activation blocks", builtin.Name)
+		}
+	}
+
+	genesis.Alloc = alloc
+	normalized.Alloc = normalizeAlloc(alloc)
+	normalized.Hash = genesis.ToBlock().Hash().Hex()
+
+	return normalized
+}
+
+// expectGolden compares rendered genesis with golden file
+func expectGolden(name string, client ethereumv1alpha1.EthereumClient, content string) {
+	var indented bytes.Buffer
+	Expect(json.Indent(&indented, []byte(content), "", "  ")).To(Succeed())
+	indented.WriteString("\n")
+
+	path := filepath.Join(genesisGoldenDir, name, fmt.Sprintf("%s.json", client))
+
+	if *updateGolden {
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, indented.Bytes(), 0644)).To(Succeed())
+	}
+
+	golden, err := os.ReadFile(path)
+	Expect(err).To(BeNil(), "missing golden file, run go test ./clients/ethereum/ -update")
+	Expect(indented.String()).To(Equal(string(golden)), "rendered genesis doesn't match golden file %s", path)
+}
+
+// expectConsistentGenesis renders the genesis using all clients supporting its consensus and compares them
+func expectConsistentGenesis(genesis *ethereumv1alpha1.Genesis, golden string) {
+	hash, err := GenesisBlockHash(genesis)
+	Expect(err).To(BeNil())
+
+	besu := renderGenesis(ethereumv1alpha1.BesuClient, genesis)
+	if golden != "" {
+		expectGolden(golden, ethereumv1alpha1.BesuClient, besu)
+	}
+
+	// ibft2 is supported by besu only
+	if genesis.IBFT2 != nil {
+		Expect(normalizeChainConfig(besu, "ibft2", false).Hash).To(Equal(hash))
+		return
+	}
+
+	engine := "clique"
+	if genesis.Ethash != nil {
+		engine = "ethash"
+	}
+
+	geth := renderGenesis(ethereumv1alpha1.GethClient, genesis)
+	nethermind := renderGenesis(ethereumv1alpha1.NethermindClient, genesis)
+	if golden != "" {
+		expectGolden(golden, ethereumv1alpha1.GethClient, geth)
+		expectGolden(golden, ethereumv1alpha1.NethermindClient, nethermind)
+	}
+
+	gethGenesis := normalizeChainConfig(geth, engine, true)
+	Expect(gethGenesis.Hash).To(Equal(hash))
+	Expect(normalizeChainConfig(besu, engine, false)).To(Equal(gethGenesis), "besu genesis doesn't match geth genesis")
+	Expect(normalizeChainspec(nethermind)).To(Equal(gethGenesis), "nethermind chainspec doesn't match geth genesis")
+}
+
+// genesisForks returns named fork sets
+func genesisForks() map[string]*ethereumv1alpha1.Forks {
+	dao := uint(5)
+	return map[string]*ethereumv1alpha1.Forks{
+		"genesis-forks": {},
+		"staggered-forks": {
+			Homestead:      1,
+			EIP150:         2,
+			EIP155:         3,
+			EIP158:         4,
+			Byzantium:      10,
+			Constantinople: 20,
+			Petersburg:     30,
+			Istanbul:       40,
+			MuirGlacier:    50,
+			Berlin:         60,
+			London:         70,
+			ArrowGlacier:   80,
+		},
+		"dao-forks": {
+			Homestead:      1,
+			DAO:            &dao,
+			EIP150:         10,
+			EIP155:         10,
+			EIP158:         10,
+			Byzantium:      20,
+			Constantinople: 20,
+			Petersburg:     20,
+			Istanbul:       30,
+			MuirGlacier:    30,
+			Berlin:         40,
+			London:         40,
+			ArrowGlacier:   40,
+		},
+	}
+}
+
+// genesisAccountSets returns named prefunded accounts sets
+func genesisAccountSets() map[string][]ethereumv1alpha1.Account {
+	return map[string][]ethereumv1alpha1.Account{
+		"balances": {
+			{
+				Address: "0x48c5F25a884116d58A6287B72C9b069F936C9489",
+				Balance: "0xffffffffffffffffffff",
+			},
+			{
+				Address: "0x0000000000000000000000000000000000000005",
+				Balance: "0x10",
+			},
+		},
+		"contracts": {
+			{
+				Address: "0x1000000000000000000000000000000000000001",
+				Balance: "0x0",
+				Code:    "0x6080604052348015600f57600080fd5b50",
+				Storage: map[ethereumv1alpha1.HexString]ethereumv1alpha1.HexString{
+					"0x00": "0x01",
+					"0x0000000000000000000000000000000000000000000000000000000000000002": "0xdeadbeef",
+				},
+			},
+		},
+	}
+}
+
+// genesisConsensus returns genesis with the given consensus
+func genesisConsensus(consensus string) *ethereumv1alpha1.Genesis {
+	genesis := &ethereumv1alpha1.Genesis{
+		ChainID:   7777,
+		NetworkID: 7777,
+	}
+
+	switch consensus {
+	case "ethash":
+		genesis.Ethash = &ethereumv1alpha1.Ethash{}
+	case "clique":
+		genesis.Clique = &ethereumv1alpha1.Clique{
+			Signers: []sharedAPI.EthereumAddress{
+				"0xd2c21213027cbf4d46c16b55fa98e5252b048706",
+				"0x427e2c7cecd72bc4cdd4f7ebb8bb6e49789c8044",
+			},
+		}
+	case "ibft2":
+		genesis.IBFT2 = &ethereumv1alpha1.IBFT2{
+			Validators: []sharedAPI.EthereumAddress{
+				"0x427e2c7cecd72bc4cdd4f7ebb8bb6e49789c8044",
+			},
+		}
+	}
+
+	return genesis
+}
+
+// genesisMatrix returns consensus x fork set x accounts genesis cases
+// dao fork and homestead transition are configurable in ethash networks only
+func genesisMatrix() []genesisCase {
+	cases := []genesisCase{}
+
+	for _, consensus := range []string{"ethash", "clique", "ibft2"} {
+		for forksName, forks := range genesisForks() {
+			if consensus != "ethash" && forksName != "genesis-forks" {
+				continue
+			}
+			for accountsName, accounts := range genesisAccountSets() {
+				genesis := genesisConsensus(consensus)
+				genesis.Forks = forks
+				genesis.Accounts = accounts
+				genesis.Default()
+
+				cases = append(cases, genesisCase{
+					name:    fmt.Sprintf("%s-%s-%s", consensus, forksName, accountsName),
+					genesis: genesis,
+				})
+			}
+		}
+	}
+
+	sort.Slice(cases, func(i, j int) bool { return cases[i].name < cases[j].name })
+
+	return cases
+}
+
+// randomGenesis returns random genesis with ordered forks, and random accounts with code and storage
+func randomGenesis(r *rand.Rand) *ethereumv1alpha1.Genesis {
+	consensus := []string{"ethash", "clique"}[r.Intn(2)]
+	genesis := genesisConsensus(consensus)
+	genesis.ChainID = uint(r.Intn(100000) + 1)
+	genesis.NetworkID = genesis.ChainID
+
+	// forks can be activated at the same block
+	blocks := make([]uint, len(forkNames))
+	var block uint
+	for i := range blocks {
+		block += uint(r.Intn(3)) * uint(r.Intn(1000))
+		blocks[i] = block
+	}
+
+	genesis.Forks = &ethereumv1alpha1.Forks{
+		Homestead:      blocks[0],
+		EIP150:         blocks[1],
+		EIP155:         blocks[2],
+		EIP158:         blocks[3],
+		Byzantium:      blocks[4],
+		Constantinople: blocks[5],
+		Petersburg:     blocks[6],
+		Istanbul:       blocks[7],
+		MuirGlacier:    blocks[8],
+		Berlin:         blocks[9],
+		London:         blocks[10],
+		ArrowGlacier:   blocks[11],
+	}
+
+	if consensus == "ethash" {
+		if r.Intn(2) == 0 {
+			dao := blocks[0] + uint(r.Intn(100))
+			genesis.Forks.DAO = &dao
+		}
+	} else {
+		genesis.Forks.Homestead = 0
+	}
+
+	for i := r.Intn(4); i > 0; i-- {
+		account := ethereumv1alpha1.Account{
+			Address: sharedAPI.EthereumAddress(fmt.Sprintf("0x%040x", r.Int63())),
+			Balance: ethereumv1alpha1.HexString(fmt.Sprintf("%#x", r.Int63())),
+		}
+		if r.Intn(2) == 0 {
+			account.Code = ethereumv1alpha1.HexString(fmt.Sprintf("0x%016x", r.Int63()))
+			account.Storage = map[ethereumv1alpha1.HexString]ethereumv1alpha1.HexString{
+				ethereumv1alpha1.HexString(fmt.Sprintf("0x%02x", r.Intn(100))): ethereumv1alpha1.HexString(fmt.Sprintf("0x%016x", r.Int63())),
+			}
+		}
+		genesis.Accounts = append(genesis.Accounts, account)
+	}
+
+	genesis.Default()
+
+	return genesis
+}
+
+var _ = Describe("Genesis consistency across clients", func() {
+
+	for _, c := range genesisMatrix() {
+		c := c
+		It(fmt.Sprintf("should render consistent %s genesis", c.name), func() {
+			Expect(c.genesis.ValidateForksOrder()).To(BeEmpty())
+			expectConsistentGenesis(c.genesis, c.name)
+		})
+	}
+
+	It("should render consistent random genesis", func() {
+		seed := GinkgoRandomSeed()
+		r := rand.New(rand.NewSource(seed))
+
+		for i := 0; i < 50; i++ {
+			genesis := randomGenesis(r)
+			Expect(genesis.ValidateForksOrder()).To(BeEmpty())
+			By(fmt.Sprintf("random genesis #%d with seed %d", i, seed))
+			expectConsistentGenesis(genesis, "")
+		}
+	})
+
+})
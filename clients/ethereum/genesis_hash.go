@@ -0,0 +1,96 @@
+package ethereum
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/core"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+)
+
+// IBFT2MixHash is the mix hash identifying istanbul byzantine fault tolerant blocks
+const IBFT2MixHash = "0x63746963616c2062797a616e74696e65206661756c7420746f6c6572616e6365"
+
+// canonicalGenesis returns genesis block header fields and state as used by all clients
+// forks other than london don't affect genesis block, they're not part of the chain config
+func canonicalGenesis(genesis *ethereumv1alpha1.Genesis) (*core.Genesis, error) {
+	mixHash := genesis.MixHash
+	nonce := genesis.Nonce
+	difficulty := genesis.Difficulty
+	extraData := "0x00"
+
+	if genesis.Clique != nil {
+		extraData = createExtraDataFromSigners(genesis.Clique.Signers)
+	}
+
+	if genesis.IBFT2 != nil {
+		mixHash = IBFT2MixHash
+		nonce = "0x0"
+		difficulty = "0x1"
+		var err error
+		if extraData, err = createExtraDataFromValidators(genesis.IBFT2.Validators); err != nil {
+			return nil, err
+		}
+	}
+
+	var london uint
+	if genesis.Forks != nil {
+		london = genesis.Forks.London
+	}
+
+	// builtin contracts are funded with 1 wei by all clients
+	alloc := genesisAccounts(false, genesis.Forks)
+	for _, account := range genesis.Accounts {
+		m := map[string]interface{}{
+			"balance": account.Balance,
+		}
+
+		if account.Code != "" {
+			m["code"] = account.Code
+		}
+
+		if account.Storage != nil {
+			m["storage"] = account.Storage
+		}
+
+		alloc[string(account.Address)] = m
+	}
+
+	result := map[string]interface{}{
+		"config": map[string]interface{}{
+			"chainId":     genesis.ChainID,
+			"londonBlock": london,
+		},
+		"nonce":      nonce,
+		"timestamp":  genesis.Timestamp,
+		"gasLimit":   genesis.GasLimit,
+		"difficulty": difficulty,
+		"coinbase":   genesis.Coinbase,
+		"mixHash":    mixHash,
+		"extraData":  extraData,
+		"alloc":      alloc,
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical := &core.Genesis{}
+	if err := json.Unmarshal(data, canonical); err != nil {
+		return nil, err
+	}
+
+	return canonical, nil
+}
+
+// GenesisBlockHash computes genesis block hash from the genesis spec
+// state root is computed from genesis accounts and builtin contracts accounts
+// base fee is set to initial base fee if london fork is activated at genesis block
+func GenesisBlockHash(genesis *ethereumv1alpha1.Genesis) (string, error) {
+	canonical, err := canonicalGenesis(genesis)
+	if err != nil {
+		return "", err
+	}
+
+	return canonical.ToBlock().Hash().Hex(), nil
+}
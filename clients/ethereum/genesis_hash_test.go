@@ -0,0 +1,199 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Genesis block hash", func() {
+
+	// renderedGenesisHash computes genesis block hash from client rendered genesis
+	renderedGenesisHash := func(node *ethereumv1alpha1.Node) string {
+		client, err := NewClient(node)
+		Expect(err).To(BeNil())
+		content, err := client.Genesis()
+		Expect(err).To(BeNil())
+		genesis := &core.Genesis{}
+		Expect(json.Unmarshal([]byte(content), genesis)).To(Succeed())
+		return genesis.ToBlock().Hash().Hex()
+	}
+
+	accounts := []ethereumv1alpha1.Account{
+		{
+			Address: "0x48c5F25a884116d58A6287B72C9b069F936C9489",
+			Balance: "0xffffffffffffffffffff",
+			Storage: map[ethereumv1alpha1.HexString]ethereumv1alpha1.HexString{
+				"0x01": "0x02",
+			},
+		},
+	}
+
+	// expected hashes are genesis block hashes of the fixtures below as computed by geth
+	// changing them means nodes created by older versions would be flagged with genesis mismatch
+	cases := []struct {
+		title   string
+		genesis *ethereumv1alpha1.Genesis
+		hash    string
+	}{
+		{
+			title: "clique genesis",
+			genesis: &ethereumv1alpha1.Genesis{
+				ChainID:   4444,
+				NetworkID: 4444,
+				Clique: &ethereumv1alpha1.Clique{
+					Signers: []sharedAPI.EthereumAddress{
+						"0xd2c21213027cbf4d46c16b55fa98e5252b048706",
+					},
+				},
+				Accounts: accounts,
+			},
+			hash: "0x1ad657d09efd69302f5a9d86403a42378b44872e3160143d96f6246599489066",
+		},
+		{
+			title: "ibft2 genesis",
+			genesis: &ethereumv1alpha1.Genesis{
+				ChainID:   5555,
+				NetworkID: 5555,
+				IBFT2: &ethereumv1alpha1.IBFT2{
+					Validators: []sharedAPI.EthereumAddress{
+						"0x427e2c7cecd72bc4cdd4f7ebb8bb6e49789c8044",
+					},
+				},
+				Accounts: accounts,
+			},
+			hash: "0xe08012fd1fb50e17857599f21c64ed5cc68cc24daed62e6fdc553c85fd61bb92",
+		},
+		{
+			title: "ethash genesis",
+			genesis: &ethereumv1alpha1.Genesis{
+				ChainID:   4444,
+				NetworkID: 4444,
+				Ethash:    &ethereumv1alpha1.Ethash{},
+			},
+			hash: "0x92568395cc8dab395882d091be30c38821f67993d7dc53c050c78858c3571240",
+		},
+	}
+
+	for _, c := range cases {
+		func() {
+			cc := c
+			It(fmt.Sprintf("should compute %s block hash", cc.title), func() {
+				cc.genesis.Default()
+				hash, err := GenesisBlockHash(cc.genesis)
+				Expect(err).To(BeNil())
+				Expect(hash).To(Equal(cc.hash))
+			})
+		}()
+	}
+
+	It("should compute ethash genesis block hash as built by geth from genesis alloc", func() {
+		genesis := &ethereumv1alpha1.Genesis{
+			ChainID:   4444,
+			NetworkID: 4444,
+			Ethash:    &ethereumv1alpha1.Ethash{},
+		}
+		genesis.Default()
+
+		hash, err := GenesisBlockHash(genesis)
+		Expect(err).To(BeNil())
+
+		// builtin contracts and first 256 addresses are funded with 1 wei
+		alloc := core.GenesisAlloc{}
+		for i := int64(0); i < 256; i++ {
+			alloc[common.BigToAddress(big.NewInt(i))] = core.GenesisAccount{Balance: big.NewInt(1)}
+		}
+
+		expected := &core.Genesis{
+			Config: &params.ChainConfig{
+				ChainID:     big.NewInt(4444),
+				LondonBlock: big.NewInt(0),
+			},
+			GasLimit:   0x47b760,
+			Difficulty: big.NewInt(1),
+			ExtraData:  []byte{0},
+			Alloc:      alloc,
+		}
+
+		Expect(hash).To(Equal(expected.ToBlock().Hash().Hex()))
+	})
+
+	It("should compute the same hash as geth and besu rendered clique genesis", func() {
+		genesis := &ethereumv1alpha1.Genesis{
+			ChainID:   4444,
+			NetworkID: 4444,
+			Clique: &ethereumv1alpha1.Clique{
+				Signers: []sharedAPI.EthereumAddress{
+					"0xd2c21213027cbf4d46c16b55fa98e5252b048706",
+				},
+			},
+			Accounts: accounts,
+		}
+		genesis.Default()
+
+		hash, err := GenesisBlockHash(genesis)
+		Expect(err).To(BeNil())
+
+		for _, client := range []ethereumv1alpha1.EthereumClient{ethereumv1alpha1.GethClient, ethereumv1alpha1.BesuClient} {
+			node := &ethereumv1alpha1.Node{
+				Spec: ethereumv1alpha1.NodeSpec{
+					Client:  client,
+					Genesis: genesis,
+				},
+			}
+			Expect(renderedGenesisHash(node)).To(Equal(hash))
+		}
+	})
+
+	It("should compute the same hash as besu rendered ibft2 genesis", func() {
+		genesis := &ethereumv1alpha1.Genesis{
+			ChainID:   5555,
+			NetworkID: 5555,
+			IBFT2: &ethereumv1alpha1.IBFT2{
+				Validators: []sharedAPI.EthereumAddress{
+					"0x427e2c7cecd72bc4cdd4f7ebb8bb6e49789c8044",
+				},
+			},
+			Accounts: accounts,
+		}
+		genesis.Default()
+
+		hash, err := GenesisBlockHash(genesis)
+		Expect(err).To(BeNil())
+
+		node := &ethereumv1alpha1.Node{
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.BesuClient,
+				Genesis: genesis,
+			},
+		}
+		Expect(renderedGenesisHash(node)).To(Equal(hash))
+	})
+
+	It("should compute different hashes for different genesis blocks", func() {
+		genesis := &ethereumv1alpha1.Genesis{
+			ChainID:   4444,
+			NetworkID: 4444,
+			Ethash:    &ethereumv1alpha1.Ethash{},
+		}
+		genesis.Default()
+
+		hash, err := GenesisBlockHash(genesis)
+		Expect(err).To(BeNil())
+
+		genesis.Accounts = accounts
+		funded, err := GenesisBlockHash(genesis)
+		Expect(err).To(BeNil())
+
+		Expect(funded).NotTo(Equal(hash))
+	})
+
+})
@@ -31,6 +31,12 @@ var (
 		sharedAPI.DebugLogs: "4",
 		sharedAPI.AllLogs:   "5",
 	}
+	gethNatMethods = map[ethereumv1alpha1.NATMethod]string{
+		ethereumv1alpha1.AutoNATMethod: "any",
+		ethereumv1alpha1.NoNATMethod:   "none",
+		ethereumv1alpha1.UPnPNATMethod: "upnp",
+		ethereumv1alpha1.PMPNATMethod:  "pmp",
+	}
 )
 
 // HomeDir returns go-ethereum docker image home directory
@@ -55,11 +61,89 @@ func (g *GethClient) Args() (args []string) {
 	args = append(args, GethDisableIPC)
 	args = append(args, GethP2PPort, fmt.Sprintf("%d", node.Spec.P2PPort))
 	args = append(args, GethSyncMode, string(node.Spec.SyncMode))
-	if g.node.Spec.SyncMode == ethereumv1alpha1.FullSynchronization {
+
+	if p2p := node.Spec.P2P; p2p != nil {
+		if p2p.ExternalIP != "" {
+			args = append(args, GethNAT, fmt.Sprintf("extip:%s", p2p.ExternalIP))
+		} else if p2p.NAT != "" {
+			args = append(args, GethNAT, gethNatMethods[p2p.NAT])
+		}
+		if p2p.DiscoveryV5 {
+			args = append(args, GethDiscoveryV5)
+		}
+		if p2p.MaxPeers != 0 {
+			args = append(args, GethMaxPeers, fmt.Sprintf("%d", p2p.MaxPeers))
+		}
+	}
+
+	// full sync nodes are archive nodes unless pruning mode is set explicitly
+	var pruning ethereumv1alpha1.PruningMode
+	if node.Spec.SyncMode == ethereumv1alpha1.FullSynchronization {
+		pruning = ethereumv1alpha1.ArchivePruning
+	}
+	if node.Spec.Performance != nil && node.Spec.Performance.Pruning != "" {
+		pruning = node.Spec.Performance.Pruning
+	}
+
+	switch pruning {
+	case ethereumv1alpha1.ArchivePruning:
 		args = append(args, GethGcMode, "archive")
 		args = append(args, GethTxLookupLimit, "0")
 		args = append(args, GethCachePreImages)
+	case ethereumv1alpha1.PrunedPruning:
+		args = append(args, GethGcMode, "full")
+	}
+
+	if node.Spec.Performance != nil && node.Spec.Performance.Cache != 0 {
+		args = append(args, GethCache, fmt.Sprintf("%d", node.Spec.Performance.Cache))
+	}
+
+	// state scheme isn't supported by older geth images
+	if node.Spec.Performance != nil && node.Spec.Performance.StorageFormat != "" && sharedAPI.ImageVersionAtLeast(node.Spec.Image, node.Spec.Client.StorageFormatMinimumVersion()) {
+		args = append(args, GethStateScheme, string(node.Spec.Performance.StorageFormat))
+	}
+
+	if serve := node.Spec.Serve; serve != nil {
+		// light server has been removed from newer geth images
+		if !sharedAPI.ImageVersionAtLeast(node.Spec.Image, node.Spec.Client.LightServerMaximumVersion()) {
+			if serve.LightServer != 0 {
+				args = append(args, GethLightServe, fmt.Sprintf("%d", serve.LightServer))
+			}
+			if serve.LightPeers != 0 {
+				args = append(args, GethLightMaxPeers, fmt.Sprintf("%d", serve.LightPeers))
+			}
+		}
+		// snap sync requests are served from state snapshot, which is enabled by default
+		if serve.Snap != nil && !*serve.Snap {
+			args = append(args, fmt.Sprintf("%s=false", GethSnapshot))
+		}
+		// history chain isn't supported by older geth images
+		if serve.HistoryExpiry && sharedAPI.ImageVersionAtLeast(node.Spec.Image, node.Spec.Client.HistoryExpiryMinimumVersion()) {
+			args = append(args, GethHistoryChain, "postmerge")
+		}
 	}
+
+	if txPool := node.Spec.TxPool; txPool != nil {
+		if txPool.Size != 0 {
+			args = append(args, GethTxPoolGlobalSlots, fmt.Sprintf("%d", txPool.Size))
+		}
+		if txPool.SenderLimit != 0 {
+			args = append(args, GethTxPoolAccountSlots, fmt.Sprintf("%d", txPool.SenderLimit))
+		}
+		if txPool.Lifetime != 0 {
+			args = append(args, GethTxPoolLifetime, fmt.Sprintf("%dh", txPool.Lifetime))
+		}
+	}
+
+	if mining := node.Spec.Mining; mining != nil {
+		if mining.MinGasPrice != 0 {
+			args = append(args, GethMinerGasPrice, fmt.Sprintf("%d", mining.MinGasPrice))
+		}
+		if mining.GasTarget != 0 {
+			args = append(args, GethMinerGasLimit, fmt.Sprintf("%d", mining.GasTarget))
+		}
+	}
+
 	args = append(args, GethLogging, verbosityLevels[node.Spec.Logging])
 
 	// config.toml holding static nodes
@@ -86,17 +170,33 @@ func (g *GethClient) Args() (args []string) {
 		args = append(args, GethNetworkID, fmt.Sprintf("%d", node.Spec.Genesis.NetworkID))
 	}
 
+	// development chain genesis is generated from dev spec, and its first account is imported
+	if dev := node.Spec.Dev; dev != nil && node.Spec.Genesis != nil {
+		args = append(args, GethDev)
+		args = append(args, GethDevPeriod, fmt.Sprintf("%d", dev.BlockPeriod))
+		args = append(args, GethAllowInsecureUnlock)
+	}
+
 	if node.Spec.Miner {
 		args = append(args, GethMinerEnabled)
 		args = append(args, GethMinerCoinbase, string(node.Spec.Coinbase))
-		args = append(args, GethUnlock, string(node.Spec.Coinbase))
+	}
+
+	// imported accounts passwords are written line by line in the same order
+	if accounts := node.Spec.ImportedAccounts(); len(accounts) != 0 {
+		addresses := []string{}
+		for _, account := range accounts {
+			addresses = append(addresses, string(account.Address))
+		}
+		args = append(args, GethUnlock, strings.Join(addresses, ","))
 		args = append(args, GethPassword, fmt.Sprintf("%s/account.password", shared.PathSecrets(g.HomeDir())))
 	}
 
 	if node.Spec.RPC {
 		args = append(args, GethRPCHTTPEnabled)
-		args = append(args, GethRPCHTTPHost, shared.Host(node.Spec.RPC))
-		args = append(args, GethRPCHTTPPort, fmt.Sprintf("%d", node.Spec.RPCPort))
+		rpcHost, rpcPort := rpcServer(node)
+		args = append(args, GethRPCHTTPHost, rpcHost)
+		args = append(args, GethRPCHTTPPort, fmt.Sprintf("%d", rpcPort))
 		// JSON-RPC API
 		apis := []string{}
 		for _, api := range node.Spec.RPCAPI {
@@ -115,8 +215,9 @@ func (g *GethClient) Args() (args []string) {
 
 	if node.Spec.WS {
 		args = append(args, GethRPCWSEnabled)
-		args = append(args, GethRPCWSHost, shared.Host(node.Spec.WS))
-		args = append(args, GethRPCWSPort, fmt.Sprintf("%d", node.Spec.WSPort))
+		wsHost, wsPort := wsServer(node)
+		args = append(args, GethRPCWSHost, wsHost)
+		args = append(args, GethRPCWSPort, fmt.Sprintf("%d", wsPort))
 		// WebSocket API
 		apis := []string{}
 		for _, api := range node.Spec.WSAPI {
@@ -137,6 +137,29 @@ var _ = Describe("Geth Client", func() {
 		})
 	})
 
+	Context("Joining holesky", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "geth-holesky-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.HoleskyNetwork,
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				"--holesky",
+			))
+		})
+
+	})
+
 	Context("miner in private PoW network", func() {
 		node := &ethereumv1alpha1.Node{
 			ObjectMeta: metav1.ObjectMeta{
@@ -205,6 +228,13 @@ var _ = Describe("Geth Client", func() {
 					PrivateKeySecretName: "geth-poa-account-key",
 					PasswordSecretName:   "geth-poa-account-password",
 				},
+				Imports: []ethereumv1alpha1.ImportedAccount{
+					{
+						Address:            "0x1990E5760d9f8Ae0ec55dF8B0819C77e59846Ff2",
+						KeystoreSecretName: "geth-faucet-keystore",
+						PasswordSecretName: "geth-faucet-password",
+					},
+				},
 			},
 		}
 		node.Default()
@@ -224,7 +254,7 @@ var _ = Describe("Geth Client", func() {
 				GethMinerCoinbase,
 				coinbase,
 				GethUnlock,
-				coinbase,
+				fmt.Sprintf("%s,0x1990E5760d9f8Ae0ec55dF8B0819C77e59846Ff2", coinbase),
 				GethPassword,
 				fmt.Sprintf("%s/account.password", shared.PathSecrets(client.HomeDir())),
 				GethNetworkID,
@@ -235,4 +265,270 @@ var _ = Describe("Geth Client", func() {
 
 	})
 
+	Context("tuned miner node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "geth-tuned-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Genesis: &ethereumv1alpha1.Genesis{
+					ChainID:   12345,
+					NetworkID: 12345,
+					Ethash:    &ethereumv1alpha1.Ethash{},
+				},
+				Client:   ethereumv1alpha1.GethClient,
+				SyncMode: ethereumv1alpha1.FullSynchronization,
+				Miner:    true,
+				Coinbase: sharedAPI.EthereumAddress(coinbase),
+				Import: &ethereumv1alpha1.ImportedAccount{
+					PrivateKeySecretName: "geth-tuned-account-key",
+					PasswordSecretName:   "geth-tuned-account-password",
+				},
+				Performance: &ethereumv1alpha1.Performance{
+					Cache:   4096,
+					Pruning: ethereumv1alpha1.PrunedPruning,
+				},
+				TxPool: &ethereumv1alpha1.TxPool{
+					Size:        10000,
+					SenderLimit: 32,
+					Lifetime:    6,
+				},
+				Mining: &ethereumv1alpha1.Mining{
+					MinGasPrice: 1000000000,
+					GasTarget:   30000000,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).NotTo(ContainElements(GethCachePreImages))
+			Expect(client.Args()).To(ContainElements(
+				GethGcMode,
+				"full",
+				GethCache,
+				"4096",
+				GethTxPoolGlobalSlots,
+				"10000",
+				GethTxPoolAccountSlots,
+				"32",
+				GethTxPoolLifetime,
+				"6h",
+				GethMinerGasPrice,
+				"1000000000",
+				GethMinerGasLimit,
+				"30000000",
+			))
+		})
+
+	})
+
+	Context("path scheme storage node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "geth-path-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.MainNetwork,
+				Image:   "ethereum/client-go:v1.14.8",
+				Performance: &ethereumv1alpha1.Performance{
+					Pruning:       ethereumv1alpha1.PrunedPruning,
+					StorageFormat: ethereumv1alpha1.PathStorageFormat,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				GethGcMode,
+				"full",
+				GethStateScheme,
+				"path",
+			))
+		})
+
+		It("should not set state scheme of geth images without state scheme", func() {
+			older := node.DeepCopy()
+			older.Spec.Image = ethereumv1alpha1.DefaultGethImage
+
+			client, err := NewClient(older)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).NotTo(ContainElement(GethStateScheme))
+		})
+
+	})
+
+	Context("node advertising external IP", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "geth-external-ip-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.MainNetwork,
+				P2P: &ethereumv1alpha1.P2P{
+					ExternalIP:  "203.0.113.10",
+					DiscoveryV5: true,
+					MaxPeers:    100,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				GethNAT,
+				"extip:203.0.113.10",
+				GethDiscoveryV5,
+				GethMaxPeers,
+				"100",
+			))
+		})
+
+	})
+
+	Context("node with automatic nat", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "geth-auto-nat-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.MainNetwork,
+				P2P: &ethereumv1alpha1.P2P{
+					NAT: ethereumv1alpha1.AutoNATMethod,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				GethNAT,
+				"any",
+			))
+		})
+
+	})
+
+	Context("light serving node", func() {
+		snap := false
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "geth-light-server-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:   ethereumv1alpha1.GethClient,
+				Network:  ethereumv1alpha1.MainNetwork,
+				SyncMode: ethereumv1alpha1.FastSynchronization,
+				Image:    "ethereum/client-go:v1.13.15",
+				Serve: &ethereumv1alpha1.Serve{
+					LightServer:   50,
+					LightPeers:    25,
+					Snap:          &snap,
+					HistoryExpiry: true,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				GethLightServe,
+				"50",
+				GethLightMaxPeers,
+				"25",
+				"--snapshot=false",
+			))
+		})
+
+		It("should not set history chain of geth images without history expiry", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).NotTo(ContainElement(GethHistoryChain))
+		})
+
+		It("should set history chain and not serve light clients on newer geth images", func() {
+			newer := node.DeepCopy()
+			newer.Spec.Image = "ethereum/client-go:v1.16.1"
+
+			client, err := NewClient(newer)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(GethHistoryChain, "postmerge"))
+			Expect(client.Args()).NotTo(ContainElements(GethLightServe, GethLightMaxPeers))
+		})
+
+	})
+
+	Context("development chain node", func() {
+		coinbase := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "geth-dev-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.DevNetwork,
+				Dev: &ethereumv1alpha1.Dev{
+					BlockPeriod: 2,
+				},
+				// genesis, coinbase and import are generated by the controller
+				Genesis: &ethereumv1alpha1.Genesis{
+					ChainID:   ethereumv1alpha1.DefaultDevChainID,
+					NetworkID: ethereumv1alpha1.DefaultDevChainID,
+					Clique: &ethereumv1alpha1.Clique{
+						Signers: []sharedAPI.EthereumAddress{sharedAPI.EthereumAddress(coinbase)},
+					},
+				},
+				Miner:    true,
+				Coinbase: sharedAPI.EthereumAddress(coinbase),
+				Import: &ethereumv1alpha1.ImportedAccount{
+					PrivateKeySecretName: "geth-dev-node-dev",
+					PasswordSecretName:   "geth-dev-node-dev",
+				},
+				RPC: true,
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				GethDev,
+				GethDevPeriod,
+				"2",
+				GethAllowInsecureUnlock,
+				GethNetworkID,
+				"1337",
+				GethMinerEnabled,
+				GethMinerCoinbase,
+				coinbase,
+				GethUnlock,
+			))
+		})
+
+	})
 })
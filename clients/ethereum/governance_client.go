@@ -0,0 +1,151 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+)
+
+// GovernanceClient is JSON-RPC client used to vote on private network signers or validators
+type GovernanceClient interface {
+	// Validators returns current signers or validators
+	Validators(ctx context.Context) ([]string, error)
+	// Propose votes to add or remove signer or validator
+	Propose(ctx context.Context, address string, add bool) error
+	// Discard drops the node vote on signer or validator
+	Discard(ctx context.Context, address string) error
+}
+
+// governanceClient calls consensus specific JSON-RPC methods
+type governanceClient struct {
+	endpoint string
+	// token is gateway API key or JWT token, sent as bearer token if node is behind gateway
+	token string
+	// validators is the method used to get current signers or validators
+	validators string
+	// validatorsParams is the validators method params
+	validatorsParams []interface{}
+	// propose is the method used to vote on signer or validator
+	propose string
+	// discard is the method used to drop vote on signer or validator
+	discard string
+}
+
+// NewGovernanceClient returns governance client for the node network consensus
+// endpoint is the node JSON-RPC HTTP server url
+// token is the node gateway API key or JWT token, and is empty if node is not behind gateway
+func NewGovernanceClient(node *ethereumv1alpha1.Node, endpoint, token string) (GovernanceClient, error) {
+	genesis := node.Spec.Genesis
+
+	switch {
+	case genesis != nil && genesis.Clique != nil:
+		return &governanceClient{
+			endpoint:   endpoint,
+			token:      token,
+			validators: "clique_getSigners",
+			propose:    "clique_propose",
+			discard:    "clique_discard",
+		}, nil
+	case genesis != nil && genesis.IBFT2 != nil:
+		return &governanceClient{
+			endpoint:         endpoint,
+			token:            token,
+			validators:       "ibft_getValidatorsByBlockNumber",
+			validatorsParams: []interface{}{"latest"},
+			propose:          "ibft_proposeValidatorVote",
+			discard:          "ibft_discardValidatorVote",
+		}, nil
+	default:
+		return nil, fmt.Errorf("node %s is not running clique or ibft2 network", node.Name)
+	}
+}
+
+// Validators returns current signers or validators
+func (g *governanceClient) Validators(ctx context.Context) (validators []string, err error) {
+	err = call(ctx, g.endpoint, g.token, g.validators, g.validatorsParams, &validators)
+	return
+}
+
+// Propose votes to add or remove signer or validator
+func (g *governanceClient) Propose(ctx context.Context, address string, add bool) error {
+	return call(ctx, g.endpoint, g.token, g.propose, []interface{}{address, add}, nil)
+}
+
+// Discard drops the node vote on signer or validator
+func (g *governanceClient) Discard(ctx context.Context, address string) error {
+	return call(ctx, g.endpoint, g.token, g.discard, []interface{}{address}, nil)
+}
+
+// rpcRequest is JSON-RPC 2.0 request
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// rpcResponse is JSON-RPC 2.0 response
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// rpcTimeout is JSON-RPC call timeout
+const rpcTimeout = 10 * time.Second
+
+// call calls JSON-RPC method and decodes the result into result if not nil
+func call(ctx context.Context, endpoint, token, method string, params []interface{}, result interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s call failed with status %s", method, resp.Status)
+	}
+
+	var response rpcResponse
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return err
+	}
+
+	if response.Error != nil {
+		return fmt.Errorf("%s call failed: %s", method, response.Error.Message)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
@@ -0,0 +1,174 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+)
+
+// stubRPCServer records JSON-RPC calls and replies with the given results
+func stubRPCServer(calls *[]rpcRequest, results map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		*calls = append(*calls, req)
+
+		response := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if result, ok := results[req.Method]; ok {
+			response["result"] = result
+		} else {
+			response["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+var _ = Describe("Governance Client", func() {
+
+	signer := "0xcf2c3fb8f36a863fd1a8c72e2473f81744b4ca6c"
+	candidate := "0x1990e5760d9f8ae0ec55df8b0819c77e59846ff2"
+
+	Context("clique network", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "clique-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Genesis: &ethereumv1alpha1.Genesis{
+					ChainID:   12345,
+					NetworkID: 12345,
+					Clique: &ethereumv1alpha1.Clique{
+						Signers: []sharedAPI.EthereumAddress{sharedAPI.EthereumAddress(signer)},
+					},
+				},
+				Client: ethereumv1alpha1.GethClient,
+			},
+		}
+
+		It("should call clique methods", func() {
+			calls := []rpcRequest{}
+			server := stubRPCServer(&calls, map[string]interface{}{
+				"clique_getSigners": []string{signer},
+				"clique_propose":    nil,
+				"clique_discard":    nil,
+			})
+			defer server.Close()
+
+			client, err := NewGovernanceClient(node, server.URL, "")
+			Expect(err).To(BeNil())
+
+			validators, err := client.Validators(context.Background())
+			Expect(err).To(BeNil())
+			Expect(validators).To(ConsistOf(signer))
+
+			Expect(client.Propose(context.Background(), candidate, true)).To(Succeed())
+			Expect(client.Discard(context.Background(), candidate)).To(Succeed())
+
+			Expect(calls).To(HaveLen(3))
+			Expect(calls[0].Method).To(Equal("clique_getSigners"))
+			Expect(calls[0].Params).To(BeEmpty())
+			Expect(calls[1].Method).To(Equal("clique_propose"))
+			Expect(calls[1].Params).To(Equal([]interface{}{candidate, true}))
+			Expect(calls[2].Method).To(Equal("clique_discard"))
+			Expect(calls[2].Params).To(Equal([]interface{}{candidate}))
+		})
+	})
+
+	Context("ibft2 network", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ibft2-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Genesis: &ethereumv1alpha1.Genesis{
+					ChainID:   12345,
+					NetworkID: 12345,
+					IBFT2: &ethereumv1alpha1.IBFT2{
+						Validators: []sharedAPI.EthereumAddress{sharedAPI.EthereumAddress(signer)},
+					},
+				},
+				Client: ethereumv1alpha1.BesuClient,
+			},
+		}
+
+		It("should call ibft methods", func() {
+			calls := []rpcRequest{}
+			server := stubRPCServer(&calls, map[string]interface{}{
+				"ibft_getValidatorsByBlockNumber": []string{signer},
+				"ibft_proposeValidatorVote":       true,
+			})
+			defer server.Close()
+
+			client, err := NewGovernanceClient(node, server.URL, "")
+			Expect(err).To(BeNil())
+
+			validators, err := client.Validators(context.Background())
+			Expect(err).To(BeNil())
+			Expect(validators).To(ConsistOf(signer))
+
+			Expect(client.Propose(context.Background(), signer, false)).To(Succeed())
+
+			Expect(calls).To(HaveLen(2))
+			Expect(calls[0].Method).To(Equal("ibft_getValidatorsByBlockNumber"))
+			Expect(calls[0].Params).To(Equal([]interface{}{"latest"}))
+			Expect(calls[1].Method).To(Equal("ibft_proposeValidatorVote"))
+			Expect(calls[1].Params).To(Equal([]interface{}{signer, false}))
+		})
+
+		It("should report JSON-RPC errors", func() {
+			calls := []rpcRequest{}
+			server := stubRPCServer(&calls, map[string]interface{}{})
+			defer server.Close()
+
+			client, err := NewGovernanceClient(node, server.URL, "")
+			Expect(err).To(BeNil())
+
+			Expect(client.Discard(context.Background(), signer)).To(MatchError("ibft_discardValidatorVote call failed: method not found"))
+		})
+
+		It("should send gateway token as bearer token", func() {
+			authorization := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": []string{signer}})
+			}))
+			defer server.Close()
+
+			client, err := NewGovernanceClient(node, server.URL, "gateway-api-key")
+			Expect(err).To(BeNil())
+
+			_, err = client.Validators(context.Background())
+			Expect(err).To(BeNil())
+			Expect(authorization).To(Equal("Bearer gateway-api-key"))
+		})
+	})
+
+	Context("public network", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "goerli-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Network: ethereumv1alpha1.GoerliNetwork,
+				Client:  ethereumv1alpha1.GethClient,
+			},
+		}
+
+		It("should fail to create governance client", func() {
+			_, err := NewGovernanceClient(node, "http://goerli-node:8545", "")
+			Expect(err).To(MatchError("node goerli-node is not running clique or ibft2 network"))
+		})
+	})
+
+})
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
 	"github.com/kotalco/kotal/controllers/shared"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -15,6 +16,12 @@ const (
 	NethermindHomeDir = "/home/nethermind"
 )
 
+// mergeBlocks is public networks first proof of stake blocks, history before them can be expired
+var mergeBlocks = map[string]uint{
+	ethereumv1alpha1.MainNetwork:    15537394,
+	ethereumv1alpha1.SepoliaNetwork: 1450409,
+}
+
 // NethermindClient is nethermind client
 // https://github.com/NethermindEth/nethermind
 type NethermindClient struct {
@@ -46,6 +53,18 @@ func (n *NethermindClient) Args() (args []string) {
 	args = append(args, NethermindP2PPort, fmt.Sprintf("%d", node.Spec.P2PPort))
 	args = append(args, NethermindLogging, strings.ToUpper(string(node.Spec.Logging)))
 
+	if p2p := node.Spec.P2P; p2p != nil {
+		if p2p.ExternalIP != "" {
+			args = append(args, NethermindExternalIP, p2p.ExternalIP)
+		}
+		if p2p.NAT == ethereumv1alpha1.UPnPNATMethod {
+			args = append(args, NethermindEnableUPnP, "true")
+		}
+		if p2p.MaxPeers != 0 {
+			args = append(args, NethermindMaxActivePeers, fmt.Sprintf("%d", p2p.MaxPeers))
+		}
+	}
+
 	if node.Spec.NodePrivateKeySecretName != "" {
 		// use enode private key in binary format
 		// that has been converted using nethermind_convert_enode_privatekey.sh script
@@ -89,14 +108,68 @@ func (n *NethermindClient) Args() (args []string) {
 	if node.Spec.Miner {
 		args = append(args, NethermindMiningEnabled, "true")
 		args = append(args, NethermindMinerCoinbase, string(node.Spec.Coinbase))
-		args = append(args, NethermindUnlockAccounts, fmt.Sprintf("[%s]", node.Spec.Coinbase))
-		args = append(args, NethermindPasswordFiles, fmt.Sprintf("[%s/account.password]", shared.PathSecrets(n.HomeDir())))
+	}
+
+	// each imported account has its own password file
+	if accounts := node.Spec.ImportedAccounts(); len(accounts) != 0 {
+		addresses := []string{}
+		passwordFiles := []string{}
+		for i, account := range accounts {
+			addresses = append(addresses, string(account.Address))
+			passwordFiles = append(passwordFiles, fmt.Sprintf("%s/account-%d.password", shared.PathSecrets(n.HomeDir()), i))
+		}
+		args = append(args, NethermindUnlockAccounts, fmt.Sprintf("[%s]", strings.Join(addresses, ",")))
+		args = append(args, NethermindPasswordFiles, fmt.Sprintf("[%s]", strings.Join(passwordFiles, ",")))
+	}
+
+	if node.Spec.Performance != nil {
+		switch node.Spec.Performance.Pruning {
+		case ethereumv1alpha1.ArchivePruning:
+			args = append(args, NethermindPruningMode, "None")
+		case ethereumv1alpha1.PrunedPruning:
+			args = append(args, NethermindPruningMode, "Memory")
+		case ethereumv1alpha1.FullPruning:
+			args = append(args, NethermindPruningMode, "Full")
+		case ethereumv1alpha1.HybridPruning:
+			args = append(args, NethermindPruningMode, "Hybrid")
+		}
+	}
+
+	if serve := node.Spec.Serve; serve != nil {
+		// snap serving isn't supported by older nethermind images
+		if serve.Snap != nil && sharedAPI.ImageVersionAtLeast(node.Spec.Image, node.Spec.Client.SnapServingMinimumVersion()) {
+			args = append(args, NethermindSnapServingEnabled, fmt.Sprintf("%t", *serve.Snap))
+		}
+		// pre-merge block bodies and receipts aren't downloaded
+		if block, ok := mergeBlocks[node.Spec.Network]; serve.HistoryExpiry && ok {
+			args = append(args, NethermindAncientBodiesBarrier, fmt.Sprintf("%d", block))
+			args = append(args, NethermindAncientReceiptsBarrier, fmt.Sprintf("%d", block))
+		}
+	}
+
+	if txPool := node.Spec.TxPool; txPool != nil {
+		if txPool.Size != 0 {
+			args = append(args, NethermindTxPoolSize, fmt.Sprintf("%d", txPool.Size))
+		}
+		if txPool.SenderLimit != 0 {
+			args = append(args, NethermindTxPoolMaxPendingTxsPerSender, fmt.Sprintf("%d", txPool.SenderLimit))
+		}
+	}
+
+	if mining := node.Spec.Mining; mining != nil {
+		if mining.MinGasPrice != 0 {
+			args = append(args, NethermindMiningMinGasPrice, fmt.Sprintf("%d", mining.MinGasPrice))
+		}
+		if mining.GasTarget != 0 {
+			args = append(args, NethermindTargetBlockGasLimit, fmt.Sprintf("%d", mining.GasTarget))
+		}
 	}
 
 	if node.Spec.RPC {
 		args = append(args, NethermindRPCHTTPEnabled, "true")
-		args = append(args, NethermindRPCHTTPPort, fmt.Sprintf("%d", node.Spec.RPCPort))
-		args = append(args, NethermindRPCHTTPHost, shared.Host(node.Spec.RPC))
+		rpcHost, rpcPort := rpcServer(node)
+		args = append(args, NethermindRPCHTTPPort, fmt.Sprintf("%d", rpcPort))
+		args = append(args, NethermindRPCHTTPHost, rpcHost)
 		// JSON-RPC API
 		apis := []string{}
 		for _, api := range node.Spec.RPCAPI {
@@ -115,7 +188,8 @@ func (n *NethermindClient) Args() (args []string) {
 
 	if node.Spec.WS {
 		args = append(args, NethermindRPCWSEnabled, "true")
-		args = append(args, NethermindRPCWSPort, fmt.Sprintf("%d", node.Spec.WSPort))
+		_, wsPort := wsServer(node)
+		args = append(args, NethermindRPCWSPort, fmt.Sprintf("%d", wsPort))
 		// no option for ws host, ws uses same http host as JSON-RPC
 		// nethermind ws reuses enabled JSON-RPC modules
 	}
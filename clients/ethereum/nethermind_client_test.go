@@ -117,6 +117,30 @@ var _ = Describe("Nethermind Client", func() {
 
 	})
 
+	Context("Joining chiado", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nethermind-chiado-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.NethermindClient,
+				Network: ethereumv1alpha1.ChiadoNetwork,
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				NethermindNetwork,
+				ethereumv1alpha1.ChiadoNetwork,
+			))
+		})
+
+	})
+
 	Context("miner in private PoW network", func() {
 		node := &ethereumv1alpha1.Node{
 			ObjectMeta: metav1.ObjectMeta{
@@ -152,7 +176,7 @@ var _ = Describe("Nethermind Client", func() {
 				NethermindUnlockAccounts,
 				fmt.Sprintf("[%s]", coinbase),
 				NethermindPasswordFiles,
-				fmt.Sprintf("[%s/account.password]", shared.PathSecrets(client.HomeDir())),
+				fmt.Sprintf("[%s/account-0.password]", shared.PathSecrets(client.HomeDir())),
 				NethermindDiscoveryEnabled,
 				"false",
 				NethermindNetwork,
@@ -186,6 +210,13 @@ var _ = Describe("Nethermind Client", func() {
 					PrivateKeySecretName: "nethermind-poa-account-key",
 					PasswordSecretName:   "nethermind-poa-account-password",
 				},
+				Imports: []ethereumv1alpha1.ImportedAccount{
+					{
+						Address:            "0x1990E5760d9f8Ae0ec55dF8B0819C77e59846Ff2",
+						KeystoreSecretName: "nethermind-faucet-keystore",
+						PasswordSecretName: "nethermind-faucet-password",
+					},
+				},
 			},
 		}
 		node.Default()
@@ -201,9 +232,9 @@ var _ = Describe("Nethermind Client", func() {
 				NethermindMinerCoinbase,
 				coinbase,
 				NethermindUnlockAccounts,
-				fmt.Sprintf("[%s]", coinbase),
+				fmt.Sprintf("[%s,0x1990E5760d9f8Ae0ec55dF8B0819C77e59846Ff2]", coinbase),
 				NethermindPasswordFiles,
-				fmt.Sprintf("[%s/account.password]", shared.PathSecrets(client.HomeDir())),
+				fmt.Sprintf("[%s/account-0.password,%s/account-1.password]", shared.PathSecrets(client.HomeDir()), shared.PathSecrets(client.HomeDir())),
 				NethermindDiscoveryEnabled,
 				"false",
 				NethermindNetwork,
@@ -213,4 +244,159 @@ var _ = Describe("Nethermind Client", func() {
 
 	})
 
+	Context("tuned miner node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nethermind-tuned-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Genesis: &ethereumv1alpha1.Genesis{
+					ChainID:   12345,
+					NetworkID: 12345,
+					Ethash:    &ethereumv1alpha1.Ethash{},
+				},
+				Client:   ethereumv1alpha1.NethermindClient,
+				Miner:    true,
+				Coinbase: sharedAPI.EthereumAddress(coinbase),
+				Import: &ethereumv1alpha1.ImportedAccount{
+					PrivateKeySecretName: "nethermind-tuned-account-key",
+					PasswordSecretName:   "nethermind-tuned-account-password",
+				},
+				Performance: &ethereumv1alpha1.Performance{
+					Pruning: ethereumv1alpha1.ArchivePruning,
+				},
+				TxPool: &ethereumv1alpha1.TxPool{
+					Size:        10000,
+					SenderLimit: 32,
+				},
+				Mining: &ethereumv1alpha1.Mining{
+					MinGasPrice: 1000000000,
+					GasTarget:   30000000,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				NethermindPruningMode,
+				"None",
+				NethermindTxPoolSize,
+				"10000",
+				NethermindTxPoolMaxPendingTxsPerSender,
+				"32",
+				NethermindMiningMinGasPrice,
+				"1000000000",
+				NethermindTargetBlockGasLimit,
+				"30000000",
+			))
+		})
+
+	})
+
+	Context("full pruning node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nethermind-full-pruning-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.NethermindClient,
+				Network: ethereumv1alpha1.MainNetwork,
+				Performance: &ethereumv1alpha1.Performance{
+					Pruning: ethereumv1alpha1.HybridPruning,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				NethermindPruningMode,
+				"Hybrid",
+			))
+		})
+
+	})
+
+	Context("node advertising external IP", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nethermind-external-ip-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.NethermindClient,
+				Network: ethereumv1alpha1.MainNetwork,
+				P2P: &ethereumv1alpha1.P2P{
+					ExternalIP: "203.0.113.10",
+					MaxPeers:   75,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				NethermindExternalIP,
+				"203.0.113.10",
+				NethermindMaxActivePeers,
+				"75",
+			))
+		})
+
+	})
+
+	Context("snap serving node", func() {
+		snap := true
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nethermind-snap-server-node",
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:   ethereumv1alpha1.NethermindClient,
+				Network:  ethereumv1alpha1.MainNetwork,
+				SyncMode: ethereumv1alpha1.FastSynchronization,
+				Image:    "nethermind/nethermind:1.32.4",
+				Serve: &ethereumv1alpha1.Serve{
+					Snap:          &snap,
+					HistoryExpiry: true,
+				},
+			},
+		}
+		node.Default()
+
+		It("should generate correct arguments", func() {
+			client, err := NewClient(node)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).To(ContainElements(
+				NethermindSnapServingEnabled,
+				"true",
+				NethermindAncientBodiesBarrier,
+				"15537394",
+				NethermindAncientReceiptsBarrier,
+				"15537394",
+			))
+		})
+
+		It("should not set snap serving of nethermind images without snap serving", func() {
+			older := node.DeepCopy()
+			older.Spec.Image = ethereumv1alpha1.DefaultNethermindImage
+
+			client, err := NewClient(older)
+
+			Expect(err).To(BeNil())
+			Expect(client.Args()).NotTo(ContainElement(NethermindSnapServingEnabled))
+		})
+
+	})
 })
@@ -42,36 +42,43 @@ func (p *ParityGenesis) Genesis(node *ethereumv1alpha1.Node) (content string, er
 
 	tingerineWhistleBlock := hex(genesis.Forks.EIP150)
 	spuriousDragonBlock := hex(genesis.Forks.EIP155)
+	stateClearingBlock := hex(genesis.Forks.EIP158)
 	homesteadBlock := hex(genesis.Forks.Homestead)
 	byzantiumBlock := hex(genesis.Forks.Byzantium)
 	constantinopleBlock := hex(genesis.Forks.Constantinople)
 	petersburgBlock := hex(genesis.Forks.Petersburg)
 	istanbulBlock := hex(genesis.Forks.Istanbul)
-	muirGlacierBlock := hex(genesis.Forks.MuirGlacier)
 	berlinBlock := hex(genesis.Forks.Berlin)
 	londonBlock := hex(genesis.Forks.London)
-	arrowGlacierBlock := hex(genesis.Forks.ArrowGlacier)
 
 	// ethash PoW settings
 	if genesis.Ethash != nil {
+		// difficulty bomb delays are accumulated, forks activated at the same block add up their delays
+		bombDelays := map[uint]uint{}
+		bombDelays[genesis.Forks.Byzantium] += 3000000
+		bombDelays[genesis.Forks.Constantinople] += 2000000
+		bombDelays[genesis.Forks.MuirGlacier] += 4000000
+		bombDelays[genesis.Forks.London] += 700000
+		bombDelays[genesis.Forks.ArrowGlacier] += 1000000
+
+		difficultyBombDelays := map[string]string{}
+		for block, delay := range bombDelays {
+			difficultyBombDelays[hex(block)] = hex(delay)
+		}
+
 		params := map[string]interface{}{
 			"minimumDifficulty":      "0x020000",
 			"difficultyBoundDivisor": "0x0800",
 			"durationLimit":          "0x0d",
+			// block reward is replaced by later forks, forks activated at the same block keep the latest reward
 			"blockReward": map[string]string{
-				tingerineWhistleBlock: "0x4563918244f40000",
-				byzantiumBlock:        "0x29a2241af62c0000",
-				constantinopleBlock:   "0x1bc16d674ec80000",
-			},
-			"homesteadTransition": homesteadBlock,
-			"eip100bTransition":   byzantiumBlock,
-			"difficultyBombDelays": map[string]string{
-				byzantiumBlock:      "0x2dc6c0",
-				constantinopleBlock: "0x1e8480",
-				muirGlacierBlock:    "0x3d0900",
-				londonBlock:         "0xaae60",
-				arrowGlacierBlock:   "0xf4240",
+				"0x0":               "0x4563918244f40000",
+				byzantiumBlock:      "0x29a2241af62c0000",
+				constantinopleBlock: "0x1bc16d674ec80000",
 			},
+			"homesteadTransition":  homesteadBlock,
+			"eip100bTransition":    byzantiumBlock,
+			"difficultyBombDelays": difficultyBombDelays,
 		}
 
 		if genesis.Forks.DAO != nil {
@@ -117,11 +124,12 @@ func (p *ParityGenesis) Genesis(node *ethereumv1alpha1.Node) (content string, er
 		// Tingerine Whistle
 		"eip150Transition": tingerineWhistleBlock,
 		// Spurious Dragon
-		"eip155Transition":      spuriousDragonBlock,
-		"eip160Transition":      spuriousDragonBlock,
-		"eip161abcTransition":   spuriousDragonBlock,
-		"eip161dTransition":     spuriousDragonBlock,
-		"maxCodeSizeTransition": spuriousDragonBlock, //eip170
+		"eip155Transition": spuriousDragonBlock,
+		// state trie clearing, activated at eip158 block like geth and besu
+		"eip160Transition":      stateClearingBlock,
+		"eip161abcTransition":   stateClearingBlock,
+		"eip161dTransition":     stateClearingBlock,
+		"maxCodeSizeTransition": stateClearingBlock, //eip170
 		"maxCodeSize":           "0x6000",
 		// Byzantium
 		"eip140Transition": byzantiumBlock,
@@ -142,7 +150,6 @@ func (p *ParityGenesis) Genesis(node *ethereumv1alpha1.Node) (content string, er
 		"eip1884Transition":         istanbulBlock,
 		"eip2028Transition":         istanbulBlock,
 		// Berlin
-		"eip2315Transition": berlinBlock, // Simple Subroutines for the EVM
 		"eip2929Transition": berlinBlock, // Gas cost increases for state access opcodes
 		"eip2930Transition": berlinBlock, // Access lists. Requires eips 2718 (Typed Transaction Envelope), and 2929
 		// London
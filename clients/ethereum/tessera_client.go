@@ -0,0 +1,51 @@
+package ethereum
+
+import (
+	"fmt"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	"github.com/kotalco/kotal/controllers/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TesseraClient is ConsenSys Tessera private transaction manager client
+// https://github.com/ConsenSys/tessera
+type TesseraClient struct {
+	tm *ethereumv1alpha1.TransactionManager
+}
+
+const (
+	// TesseraHomeDir is tessera docker image home directory
+	TesseraHomeDir = "/home/tessera"
+)
+
+// Tessera ports
+const (
+	// TesseraP2PPort is the port used for communication between transaction managers
+	TesseraP2PPort uint = 9000
+	// TesseraThirdPartyPort is the port used by third party applications
+	TesseraThirdPartyPort uint = 9080
+	// TesseraQ2TPort is the port used by ethereum nodes to submit private transactions
+	TesseraQ2TPort uint = 9101
+)
+
+// HomeDir returns tessera client home directory
+func (t *TesseraClient) HomeDir() string {
+	return TesseraHomeDir
+}
+
+// Command returns nil, image entrypoint is used
+func (t *TesseraClient) Command() []string {
+	return nil
+}
+
+// Env returns environment variables for the client
+func (t *TesseraClient) Env() []corev1.EnvVar {
+	return nil
+}
+
+// Args returns command line arguments required for client run
+func (t *TesseraClient) Args() (args []string) {
+	args = append(args, TesseraConfigFile, fmt.Sprintf("%s/tessera-config.json", shared.PathConfig(t.HomeDir())))
+	return
+}
@@ -0,0 +1,38 @@
+package ethereum
+
+import (
+	"fmt"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	"github.com/kotalco/kotal/controllers/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Tessera Client", func() {
+
+	tm := &ethereumv1alpha1.TransactionManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tessera",
+		},
+		Spec: ethereumv1alpha1.TransactionManagerSpec{
+			PrivateKeySecretName: "tessera-key",
+		},
+	}
+	tm.Default()
+
+	client, _ := NewTransactionManagerClient(tm)
+
+	It("should return correct home directory", func() {
+		Expect(client.HomeDir()).To(Equal(TesseraHomeDir))
+	})
+
+	It("should generate correct arguments", func() {
+		Expect(client.Args()).To(ContainElements(
+			TesseraConfigFile,
+			fmt.Sprintf("%s/tessera-config.json", shared.PathConfig(client.HomeDir())),
+		))
+	})
+
+})
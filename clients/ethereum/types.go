@@ -8,6 +8,12 @@ const (
 	BesuNetworkID = "--network-id"
 	// BesuNatMethod is the argument used for nat method
 	BesuNatMethod = "--nat-method"
+	// BesuP2PHost is the argument used for advertised p2p host
+	BesuP2PHost = "--p2p-host"
+	// BesuDNSEnabled is the argument used to enable advertising hostnames in enode URLs
+	BesuDNSEnabled = "--Xdns-enabled"
+	// BesuMaxPeers is the argument used for maximum number of connected peers
+	BesuMaxPeers = "--max-peers"
 	// BesuNodePrivateKey is the argument used for node private key
 	BesuNodePrivateKey = "--node-private-key-file"
 	// BesuGenesisFile is the argument used for genesis file
@@ -68,6 +74,40 @@ const (
 	BesuHostAllowlist = "--host-allowlist"
 	// BesuStaticNodesFile is the argument used to locate static nodes file
 	BesuStaticNodesFile = "--static-nodes-file"
+
+	// BesuPrivacyEnabled is the argument used to enable private transactions
+	BesuPrivacyEnabled = "--privacy-enabled"
+	// BesuPrivacyURL is the argument used to set transaction manager URL
+	BesuPrivacyURL = "--privacy-url"
+	// BesuPrivacyPublicKeyFile is the argument used to locate transaction manager public key file
+	BesuPrivacyPublicKeyFile = "--privacy-public-key-file"
+	// BesuPrivacyMultiTenancyEnabled is the argument used to enable privacy multi-tenancy
+	BesuPrivacyMultiTenancyEnabled = "--privacy-multi-tenancy-enabled"
+	// BesuRPCHTTPAuthenticationEnabled is the argument used to enable RPC HTTP authentication
+	BesuRPCHTTPAuthenticationEnabled = "--rpc-http-authentication-enabled"
+	// BesuRPCHTTPAuthenticationJWTPublicKeyFile is the argument used to locate RPC HTTP JWT public key file
+	BesuRPCHTTPAuthenticationJWTPublicKeyFile = "--rpc-http-authentication-jwt-public-key-file"
+	// BesuRPCWSAuthenticationEnabled is the argument used to enable RPC WS authentication
+	BesuRPCWSAuthenticationEnabled = "--rpc-ws-authentication-enabled"
+	// BesuRPCWSAuthenticationJWTPublicKeyFile is the argument used to locate RPC WS JWT public key file
+	BesuRPCWSAuthenticationJWTPublicKeyFile = "--rpc-ws-authentication-jwt-public-key-file"
+
+	// BesuPruningEnabled is the argument used to enable state pruning
+	BesuPruningEnabled = "--pruning-enabled"
+	// BesuDataStorageFormat is the argument used to set state storage format
+	BesuDataStorageFormat = "--data-storage-format"
+	// BesuSnapSyncServerEnabled is the argument used to serve snap sync requests
+	BesuSnapSyncServerEnabled = "--snapsync-server-enabled"
+	// BesuHistoryExpiryPrune is the argument used to prune pre-merge block bodies and receipts
+	BesuHistoryExpiryPrune = "--history-expiry-prune"
+	// BesuTxPoolMaxSize is the argument used to set maximum number of pending transactions
+	BesuTxPoolMaxSize = "--tx-pool-max-size"
+	// BesuTxPoolRetentionHours is the argument used to set maximum hours to retain pending transactions
+	BesuTxPoolRetentionHours = "--tx-pool-retention-hours"
+	// BesuMinGasPrice is the argument used to set minimum gas price for transactions to be mined
+	BesuMinGasPrice = "--min-gas-price"
+	// BesuTargetGasLimit is the argument used to set block gas limit the miner targets
+	BesuTargetGasLimit = "--target-gas-limit"
 )
 
 // Go ethereum client arguments
@@ -86,6 +126,12 @@ const (
 	GethDataDir = "--datadir"
 	// GethDisableIPC is the argument used to disable ipc servr
 	GethDisableIPC = "--ipcdisable"
+	// GethNAT is the argument used for nat method
+	GethNAT = "--nat"
+	// GethDiscoveryV5 is the argument used to enable discovery v5
+	GethDiscoveryV5 = "--v5disc"
+	// GethMaxPeers is the argument used for maximum number of connected peers
+	GethMaxPeers = "--maxpeers"
 	// GethP2PPort is the argument used for p2p port
 	GethP2PPort = "--port"
 	// GethBootnodes is the argument used for bootnodes
@@ -99,6 +145,15 @@ const (
 	// GethCachePreImages is the argument used to enable recording the sha3 preimages of trie keys
 	GethCachePreImages = "--cache.preimages"
 
+	// GethLightServe is the argument used to set maximum percentage of time serving light clients
+	GethLightServe = "--light.serve"
+	// GethLightMaxPeers is the argument used to set maximum number of light clients to serve
+	GethLightMaxPeers = "--light.maxpeers"
+	// GethSnapshot is the argument used to enable state snapshot serving snap sync requests
+	GethSnapshot = "--snapshot"
+	// GethHistoryChain is the argument used to set kept blocks history
+	GethHistoryChain = "--history.chain"
+
 	// GethMinerEnabled is the argument used for turning on mining
 	GethMinerEnabled = "--mine"
 	// GethMinerCoinbase is the argument used for setting coinbase account
@@ -149,6 +204,27 @@ const (
 	GethUnlock = "--unlock"
 	// GethPassword is the argument used for locking imported ethereum address
 	GethPassword = "--password"
+	// GethAllowInsecureUnlock is the argument used to allow unlocking accounts with HTTP access
+	GethAllowInsecureUnlock = "--allow-insecure-unlock"
+	// GethDev is the argument used to enable development chain mode
+	GethDev = "--dev"
+	// GethDevPeriod is the argument used to set development chain block period
+	GethDevPeriod = "--dev.period"
+
+	// GethCache is the argument used to set database cache size in megabytes
+	GethCache = "--cache"
+	// GethStateScheme is the argument used to set state storage scheme
+	GethStateScheme = "--state.scheme"
+	// GethTxPoolGlobalSlots is the argument used to set maximum number of executable transaction slots
+	GethTxPoolGlobalSlots = "--txpool.globalslots"
+	// GethTxPoolAccountSlots is the argument used to set number of executable transaction slots per account
+	GethTxPoolAccountSlots = "--txpool.accountslots"
+	// GethTxPoolLifetime is the argument used to set maximum amount of time non-executable transactions are queued
+	GethTxPoolLifetime = "--txpool.lifetime"
+	// GethMinerGasPrice is the argument used to set minimum gas price for transactions to be mined
+	GethMinerGasPrice = "--miner.gasprice"
+	// GethMinerGasLimit is the argument used to set block gas limit the miner targets
+	GethMinerGasLimit = "--miner.gaslimit"
 )
 
 // Parity client arguments
@@ -228,6 +304,12 @@ const (
 	NethermindNetwork = "--config"
 	// NethermindDiscoveryEnabled is the argument used to enabled discovery
 	NethermindDiscoveryEnabled = "--Init.DiscoveryEnabled"
+	// NethermindExternalIP is the argument used for advertised external IP address
+	NethermindExternalIP = "--Network.ExternalIp"
+	// NethermindEnableUPnP is the argument used to enable UPnP port mapping
+	NethermindEnableUPnP = "--Network.EnableUPnP"
+	// NethermindMaxActivePeers is the argument used for maximum number of connected peers
+	NethermindMaxActivePeers = "--Network.MaxActivePeers"
 	// NethermindP2PPort is the argument used for p2p port
 	NethermindP2PPort = "--Network.P2PPort"
 	// NethermindFastSync is the argument used to enable beam sync
@@ -268,4 +350,26 @@ const (
 	NethermindPasswordFiles = "--KeyStore.PasswordFiles"
 	// NethermindMiningEnabled is the argument used for turning on mining
 	NethermindMiningEnabled = "--Mining.Enabled"
+	// NethermindPruningMode is the argument used to set state pruning mode
+	NethermindPruningMode = "--Pruning.Mode"
+	// NethermindSnapServingEnabled is the argument used to serve snap sync requests
+	NethermindSnapServingEnabled = "--Sync.SnapServingEnabled"
+	// NethermindAncientBodiesBarrier is the argument used to set block number from which bodies are downloaded
+	NethermindAncientBodiesBarrier = "--Sync.AncientBodiesBarrier"
+	// NethermindAncientReceiptsBarrier is the argument used to set block number from which receipts are downloaded
+	NethermindAncientReceiptsBarrier = "--Sync.AncientReceiptsBarrier"
+	// NethermindTxPoolSize is the argument used to set maximum number of pending transactions
+	NethermindTxPoolSize = "--TxPool.Size"
+	// NethermindTxPoolMaxPendingTxsPerSender is the argument used to set maximum number of pending transactions per sender
+	NethermindTxPoolMaxPendingTxsPerSender = "--TxPool.MaxPendingTxsPerSender"
+	// NethermindMiningMinGasPrice is the argument used to set minimum gas price for transactions to be mined
+	NethermindMiningMinGasPrice = "--Mining.MinGasPrice"
+	// NethermindTargetBlockGasLimit is the argument used to set block gas limit the miner targets
+	NethermindTargetBlockGasLimit = "--Blocks.TargetBlockGasLimit"
+)
+
+// Tessera client arguments
+const (
+	// TesseraConfigFile is the argument used to locate config file
+	TesseraConfigFile = "-configfile"
 )
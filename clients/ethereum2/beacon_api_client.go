@@ -0,0 +1,308 @@
+package ethereum2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+)
+
+// BeaconAPIClient is beacon node REST API client used to get validator keys status and submit voluntary exits
+type BeaconAPIClient interface {
+	// ValidatorKeys returns beacon chain status of validators with the given public keys
+	ValidatorKeys(ctx context.Context, publicKeys []string) ([]ethereum2v1alpha1.ValidatorKeyStatus, error)
+	// ForkInfo returns genesis validators root and fork version used to sign voluntary exits
+	ForkInfo(ctx context.Context) (*ForkInfo, error)
+	// CurrentEpoch returns beacon chain head epoch
+	CurrentEpoch(ctx context.Context) (uint64, error)
+	// EpochDuration returns beacon chain epoch duration
+	EpochDuration(ctx context.Context) (time.Duration, error)
+	// SubmitVoluntaryExit submits signed voluntary exit to beacon node operations pool
+	SubmitVoluntaryExit(ctx context.Context, exit *SignedVoluntaryExit) error
+}
+
+// ForkInfo is beacon chain fork info used to compute signing domains
+type ForkInfo struct {
+	// GenesisValidatorsRoot is beacon chain genesis validators root
+	GenesisValidatorsRoot string
+	// CapellaForkVersion is capella fork version
+	// voluntary exits are signed using capella fork version as per EIP-7044
+	CapellaForkVersion string
+}
+
+// VoluntaryExit is voluntary exit message
+type VoluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// SignedVoluntaryExit is signed voluntary exit message
+type SignedVoluntaryExit struct {
+	Message   VoluntaryExit `json:"message"`
+	Signature string        `json:"signature"`
+}
+
+// beaconAPIClient calls beacon node standard REST API
+type beaconAPIClient struct {
+	endpoint string
+}
+
+// NewBeaconAPIClient returns beacon node REST API client
+// endpoint is the beacon node REST API server url
+func NewBeaconAPIClient(endpoint string) (BeaconAPIClient, error) {
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		return nil, fmt.Errorf("beacon endpoint %s is not REST API url", endpoint)
+	}
+	return &beaconAPIClient{endpoint: strings.TrimSuffix(endpoint, "/")}, nil
+}
+
+// beaconAPITimeout is beacon node REST API call timeout
+const beaconAPITimeout = 10 * time.Second
+
+// farFutureEpoch is exit epoch of validators that haven't initiated exit
+const farFutureEpoch = "18446744073709551615"
+
+// validatorsPerRequest is the maximum number of validator ids queried in a single request
+const validatorsPerRequest = 30
+
+// beaconValidator is validator returned by beacon node /eth/v1/beacon/states/{state_id}/validators
+type beaconValidator struct {
+	Index     string `json:"index"`
+	Balance   string `json:"balance"`
+	Status    string `json:"status"`
+	Validator struct {
+		PublicKey        string `json:"pubkey"`
+		EffectiveBalance string `json:"effective_balance"`
+		ExitEpoch        string `json:"exit_epoch"`
+	} `json:"validator"`
+}
+
+// ValidatorKeys returns beacon chain status of validators with the given public keys
+// public keys unknown to the beacon chain are returned in unknown state
+func (c *beaconAPIClient) ValidatorKeys(ctx context.Context, publicKeys []string) ([]ethereum2v1alpha1.ValidatorKeyStatus, error) {
+	found := map[string]beaconValidator{}
+
+	for start := 0; start < len(publicKeys); start += validatorsPerRequest {
+		end := start + validatorsPerRequest
+		if end > len(publicKeys) {
+			end = len(publicKeys)
+		}
+
+		validators, err := c.validators(ctx, publicKeys[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, validator := range validators {
+			found[strings.ToLower(validator.Validator.PublicKey)] = validator
+		}
+	}
+
+	keys := []ethereum2v1alpha1.ValidatorKeyStatus{}
+	for _, publicKey := range publicKeys {
+		validator, ok := found[strings.ToLower(publicKey)]
+		if !ok {
+			keys = append(keys, ethereum2v1alpha1.ValidatorKeyStatus{
+				PublicKey: publicKey,
+				State:     ethereum2v1alpha1.UnknownValidatorKey,
+			})
+			continue
+		}
+		exitEpoch := validator.Validator.ExitEpoch
+		if exitEpoch == farFutureEpoch {
+			exitEpoch = ""
+		}
+		keys = append(keys, ethereum2v1alpha1.ValidatorKeyStatus{
+			PublicKey:        publicKey,
+			Index:            validator.Index,
+			State:            validatorKeyState(validator.Status),
+			Balance:          validator.Balance,
+			EffectiveBalance: validator.Validator.EffectiveBalance,
+			ExitEpoch:        exitEpoch,
+		})
+	}
+
+	return keys, nil
+}
+
+// validators returns head state validators with the given public keys
+func (c *beaconAPIClient) validators(ctx context.Context, publicKeys []string) ([]beaconValidator, error) {
+	query := url.Values{}
+	query.Set("id", strings.Join(publicKeys, ","))
+
+	var validators []beaconValidator
+	err := c.call(ctx, http.MethodGet, "/eth/v1/beacon/states/head/validators?"+query.Encode(), nil, &validators)
+	return validators, err
+}
+
+// ForkInfo returns genesis validators root and fork version used to sign voluntary exits
+func (c *beaconAPIClient) ForkInfo(ctx context.Context) (*ForkInfo, error) {
+	var genesis struct {
+		GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/eth/v1/beacon/genesis", nil, &genesis); err != nil {
+		return nil, err
+	}
+
+	capellaForkVersion, err := c.specValue(ctx, "CAPELLA_FORK_VERSION")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ForkInfo{
+		GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		CapellaForkVersion:    capellaForkVersion,
+	}, nil
+}
+
+// CurrentEpoch returns beacon chain head epoch
+func (c *beaconAPIClient) CurrentEpoch(ctx context.Context) (uint64, error) {
+	value, err := c.specValue(ctx, "SLOTS_PER_EPOCH")
+	if err != nil {
+		return 0, err
+	}
+
+	slotsPerEpoch, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || slotsPerEpoch == 0 {
+		return 0, fmt.Errorf("invalid slots per epoch %s", value)
+	}
+
+	var head struct {
+		Header struct {
+			Message struct {
+				Slot string `json:"slot"`
+			} `json:"message"`
+		} `json:"header"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/eth/v1/beacon/headers/head", nil, &head); err != nil {
+		return 0, err
+	}
+
+	slot, err := strconv.ParseUint(head.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return slot / slotsPerEpoch, nil
+}
+
+// EpochDuration returns beacon chain epoch duration
+// networks have different slot time and slots per epoch, like gnosis chain 16 slots of 5 seconds
+func (c *beaconAPIClient) EpochDuration(ctx context.Context) (time.Duration, error) {
+	var spec map[string]interface{}
+	if err := c.call(ctx, http.MethodGet, "/eth/v1/config/spec", nil, &spec); err != nil {
+		return 0, err
+	}
+
+	var values [2]uint64
+	for i, name := range []string{"SECONDS_PER_SLOT", "SLOTS_PER_EPOCH"} {
+		value, _ := spec[name].(string)
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || n == 0 {
+			return 0, fmt.Errorf("beacon node spec has invalid %s %q", name, value)
+		}
+		values[i] = n
+	}
+
+	return time.Duration(values[0]*values[1]) * time.Second, nil
+}
+
+// specValue returns beacon chain config spec value
+func (c *beaconAPIClient) specValue(ctx context.Context, name string) (string, error) {
+	// spec values are strings, except for few lists like blob schedule
+	var spec map[string]interface{}
+	if err := c.call(ctx, http.MethodGet, "/eth/v1/config/spec", nil, &spec); err != nil {
+		return "", err
+	}
+
+	value, ok := spec[name].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("beacon node spec has no %s", name)
+	}
+
+	return value, nil
+}
+
+// SubmitVoluntaryExit submits signed voluntary exit to beacon node operations pool
+func (c *beaconAPIClient) SubmitVoluntaryExit(ctx context.Context, exit *SignedVoluntaryExit) error {
+	return c.call(ctx, http.MethodPost, "/eth/v1/beacon/pool/voluntary_exits", exit, nil)
+}
+
+// call calls beacon node REST API and decodes response data into result if not nil
+func (c *beaconAPIClient) call(ctx context.Context, method, path string, body, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, beaconAPITimeout)
+	defer cancel()
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiError struct {
+			Message string `json:"message"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&apiError) == nil && apiError.Message != "" {
+			return fmt.Errorf("%s %s failed with status %s: %s", method, strings.Split(path, "?")[0], resp.Status, apiError.Message)
+		}
+		return fmt.Errorf("%s %s failed with status %s", method, strings.Split(path, "?")[0], resp.Status)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(response.Data, result)
+}
+
+// validatorKeyState maps beacon API validator status to validator key state
+func validatorKeyState(status string) ethereum2v1alpha1.ValidatorKeyState {
+	switch {
+	case strings.HasPrefix(status, "pending_"):
+		return ethereum2v1alpha1.PendingValidatorKey
+	case strings.HasSuffix(status, "_slashed"):
+		return ethereum2v1alpha1.SlashedValidatorKey
+	case status == "active_ongoing":
+		return ethereum2v1alpha1.ActiveValidatorKey
+	case status == "active_exiting":
+		return ethereum2v1alpha1.ExitingValidatorKey
+	case status == "exited_unslashed", strings.HasPrefix(status, "withdrawal_"):
+		return ethereum2v1alpha1.ExitedValidatorKey
+	default:
+		return ethereum2v1alpha1.UnknownValidatorKey
+	}
+}
@@ -0,0 +1,235 @@
+package ethereum2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// stubBeaconServer records requested validator ids and replies with the given validators
+func stubBeaconServer(requested *[]string, validators []beaconValidator) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/beacon/states/head/validators" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		ids := strings.Split(r.URL.Query().Get("id"), ",")
+		*requested = append(*requested, ids...)
+
+		data := []beaconValidator{}
+		for _, validator := range validators {
+			for _, id := range ids {
+				if id == validator.Validator.PublicKey {
+					data = append(data, validator)
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+}
+
+var _ = Describe("Beacon API client", func() {
+
+	active := "0x83dee0bd09cfb0d8e5c73b3bb66bc5a6d0d7cc69d5fbfbd0b1bc7ad5a3b3a5b1eb9c8f5f7b3d8b4bfc1b0f0bf3b2a1c0d"
+	slashed := "0xa4d5d1bf1a0e8f0e7f9c8d4c3d0a6a6a3c7e8b8f9f1e3b5d1c9a7b3c5d7e9f1a3b5c7d9e1f3a5b7c9d1e3f5a7b9c1d3e5"
+	unknown := "0xb1a5c6d1e8f9a0b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4"
+
+	validators := []beaconValidator{
+		{Index: "1", Balance: "32001000000", Status: "active_ongoing"},
+		{Index: "2", Balance: "31000000000", Status: "exited_slashed"},
+	}
+	validators[0].Validator.PublicKey = active
+	validators[0].Validator.EffectiveBalance = "32000000000"
+	validators[1].Validator.PublicKey = slashed
+	validators[1].Validator.EffectiveBalance = "31000000000"
+	validators[1].Validator.ExitEpoch = "200"
+	validators[0].Validator.ExitEpoch = farFutureEpoch
+
+	It("should get validator keys status", func() {
+		requested := []string{}
+		server := stubBeaconServer(&requested, validators)
+		defer server.Close()
+
+		client, err := NewBeaconAPIClient(server.URL)
+		Expect(err).To(BeNil())
+
+		keys, err := client.ValidatorKeys(context.Background(), []string{active, slashed, unknown})
+		Expect(err).To(BeNil())
+		Expect(requested).To(ConsistOf(active, slashed, unknown))
+		Expect(keys).To(Equal([]ethereum2v1alpha1.ValidatorKeyStatus{
+			{
+				PublicKey:        active,
+				Index:            "1",
+				State:            ethereum2v1alpha1.ActiveValidatorKey,
+				Balance:          "32001000000",
+				EffectiveBalance: "32000000000",
+			},
+			{
+				PublicKey:        slashed,
+				Index:            "2",
+				State:            ethereum2v1alpha1.SlashedValidatorKey,
+				Balance:          "31000000000",
+				EffectiveBalance: "31000000000",
+				ExitEpoch:        "200",
+			},
+			{
+				PublicKey: unknown,
+				State:     ethereum2v1alpha1.UnknownValidatorKey,
+			},
+		}))
+	})
+
+	It("should query validator keys in batches", func() {
+		requested := []string{}
+		server := stubBeaconServer(&requested, validators)
+		defer server.Close()
+
+		client, err := NewBeaconAPIClient(server.URL)
+		Expect(err).To(BeNil())
+
+		publicKeys := []string{}
+		for i := 0; i < validatorsPerRequest+1; i++ {
+			publicKeys = append(publicKeys, unknown)
+		}
+
+		keys, err := client.ValidatorKeys(context.Background(), publicKeys)
+		Expect(err).To(BeNil())
+		Expect(keys).To(HaveLen(validatorsPerRequest + 1))
+		Expect(requested).To(HaveLen(validatorsPerRequest + 1))
+	})
+
+	It("should map beacon API validator status", func() {
+		Expect(validatorKeyState("pending_initialized")).To(Equal(ethereum2v1alpha1.PendingValidatorKey))
+		Expect(validatorKeyState("pending_queued")).To(Equal(ethereum2v1alpha1.PendingValidatorKey))
+		Expect(validatorKeyState("active_ongoing")).To(Equal(ethereum2v1alpha1.ActiveValidatorKey))
+		Expect(validatorKeyState("active_exiting")).To(Equal(ethereum2v1alpha1.ExitingValidatorKey))
+		Expect(validatorKeyState("active_slashed")).To(Equal(ethereum2v1alpha1.SlashedValidatorKey))
+		Expect(validatorKeyState("exited_unslashed")).To(Equal(ethereum2v1alpha1.ExitedValidatorKey))
+		Expect(validatorKeyState("withdrawal_done")).To(Equal(ethereum2v1alpha1.ExitedValidatorKey))
+	})
+
+	It("should report beacon API errors", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client, err := NewBeaconAPIClient(server.URL)
+		Expect(err).To(BeNil())
+
+		_, err = client.ValidatorKeys(context.Background(), []string{active})
+		Expect(err).To(MatchError("GET /eth/v1/beacon/states/head/validators failed with status 503 Service Unavailable"))
+	})
+
+	It("should get fork info and current epoch", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/eth/v1/beacon/genesis":
+				w.Write([]byte(`{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b36","genesis_fork_version":"0x00000000"}}`))
+			case "/eth/v1/config/spec":
+				w.Write([]byte(`{"data":{"CAPELLA_FORK_VERSION":"0x03000000","SLOTS_PER_EPOCH":"32","BLOB_SCHEDULE":[]}}`))
+			case "/eth/v1/beacon/headers/head":
+				w.Write([]byte(`{"data":{"root":"0x01","header":{"message":{"slot":"6400"}}}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client, err := NewBeaconAPIClient(server.URL)
+		Expect(err).To(BeNil())
+
+		fork, err := client.ForkInfo(context.Background())
+		Expect(err).To(BeNil())
+		Expect(fork).To(Equal(&ForkInfo{
+			GenesisValidatorsRoot: "0x4b36",
+			CapellaForkVersion:    "0x03000000",
+		}))
+
+		epoch, err := client.CurrentEpoch(context.Background())
+		Expect(err).To(BeNil())
+		Expect(epoch).To(Equal(uint64(200)))
+	})
+
+	It("should get epoch duration from beacon node spec", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/eth/v1/config/spec" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			// gnosis chain
+			w.Write([]byte(`{"data":{"SECONDS_PER_SLOT":"5","SLOTS_PER_EPOCH":"16","BLOB_SCHEDULE":[]}}`))
+		}))
+		defer server.Close()
+
+		client, err := NewBeaconAPIClient(server.URL)
+		Expect(err).To(BeNil())
+
+		duration, err := client.EpochDuration(context.Background())
+		Expect(err).To(BeNil())
+		Expect(duration).To(Equal(80 * time.Second))
+	})
+
+	It("should report missing epoch timing in beacon node spec", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32"}}`))
+		}))
+		defer server.Close()
+
+		client, err := NewBeaconAPIClient(server.URL)
+		Expect(err).To(BeNil())
+
+		_, err = client.EpochDuration(context.Background())
+		Expect(err).To(MatchError(`beacon node spec has invalid SECONDS_PER_SLOT ""`))
+	})
+
+	It("should submit voluntary exit", func() {
+		var submitted SignedVoluntaryExit
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/eth/v1/beacon/pool/voluntary_exits" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewDecoder(r.Body).Decode(&submitted)
+		}))
+		defer server.Close()
+
+		client, err := NewBeaconAPIClient(server.URL)
+		Expect(err).To(BeNil())
+
+		exit := &SignedVoluntaryExit{
+			Message:   VoluntaryExit{Epoch: "200", ValidatorIndex: "1"},
+			Signature: "0xb3ba",
+		}
+		Expect(client.SubmitVoluntaryExit(context.Background(), exit)).To(Succeed())
+		Expect(submitted).To(Equal(*exit))
+	})
+
+	It("should report rejected voluntary exit", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":400,"message":"Invalid voluntary exit, it will never pass validation so it's rejected"}`))
+		}))
+		defer server.Close()
+
+		client, err := NewBeaconAPIClient(server.URL)
+		Expect(err).To(BeNil())
+
+		err = client.SubmitVoluntaryExit(context.Background(), &SignedVoluntaryExit{})
+		Expect(err).To(MatchError("POST /eth/v1/beacon/pool/voluntary_exits failed with status 400 Bad Request: Invalid voluntary exit, it will never pass validation so it's rejected"))
+	})
+
+	It("should fail to create client for gRPC endpoint", func() {
+		_, err := NewBeaconAPIClient("beacon-node:4000")
+		Expect(err).To(MatchError("beacon endpoint beacon-node:4000 is not REST API url"))
+	})
+
+})
@@ -5,15 +5,24 @@ import (
 
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
 	"github.com/kotalco/kotal/clients"
+	"github.com/kotalco/kotal/controllers/shared"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-// Ethereum2Client is Ethereum 2.0 beacon node or validator client
+// Ethereum2Client is Ethereum 2.0 beacon node, validator client, remote signer or mev-boost
 type Ethereum2Client interface {
 	clients.Interface
 }
 
-// NewClient creates new ethereum 2.0 beacon node or validator client
+// SlashingProtector is validator client importing and exporting EIP-3076 slashing protection interchange
+type SlashingProtector interface {
+	// ImportSlashingProtection returns command importing slashing protection interchange file
+	ImportSlashingProtection(file string) []string
+	// ExportSlashingProtection returns command exporting slashing protection interchange into dir/slashing_protection.json
+	ExportSlashingProtection(dir string) []string
+}
+
+// NewClient creates new ethereum 2.0 beacon node, validator client, remote signer or mev-boost
 func NewClient(obj runtime.Object) (Ethereum2Client, error) {
 
 	switch component := obj.(type) {
@@ -29,6 +38,8 @@ func NewClient(obj runtime.Object) (Ethereum2Client, error) {
 			return &LighthouseBeaconNode{component}, nil
 		case ethereum2v1alpha1.NimbusClient:
 			return &NimbusBeaconNode{component}, nil
+		case ethereum2v1alpha1.LodestarClient:
+			return &LodestarBeaconNode{component}, nil
 		default:
 			return nil, fmt.Errorf("client %s is not supported", component.Spec.Client)
 		}
@@ -44,10 +55,45 @@ func NewClient(obj runtime.Object) (Ethereum2Client, error) {
 			return &LighthouseValidatorClient{component}, nil
 		case ethereum2v1alpha1.NimbusClient:
 			return &NimbusValidatorClient{component}, nil
+		case ethereum2v1alpha1.LodestarClient:
+			return &LodestarValidatorClient{component}, nil
 		default:
 			return nil, fmt.Errorf("client %s is not supported", component.Spec.Client)
 		}
+
+	// create remote signers
+	case *ethereum2v1alpha1.RemoteSigner:
+		return &Web3Signer{component}, nil
+
+	// create mev-boost
+	case *ethereum2v1alpha1.MEVBoost:
+		return &MEVBoost{component}, nil
 	default:
 		return nil, fmt.Errorf("no client support for %s", obj)
 	}
 }
+
+// ProposerConfigured returns true if any validator keystore overrides fee recipient, graffiti or gas limit
+func ProposerConfigured(validator *ethereum2v1alpha1.Validator) bool {
+	for _, keystore := range validator.Spec.Keystores {
+		if keystore.FeeRecipient != "" || keystore.Graffiti != "" || keystore.GasLimit != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GenesisDir returns validator custom network genesis directory
+// validator config directory holds validator config map, genesis is mounted into its own directory
+func GenesisDir(homeDir string) string {
+	return fmt.Sprintf("%s/kotal-genesis", homeDir)
+}
+
+// restServer returns beacon node REST API server host and port
+// server is bound to localhost behind the gateway, which listens on node rest port
+func restServer(node *ethereum2v1alpha1.BeaconNode) (string, uint) {
+	if node.Spec.Gateway != nil {
+		return shared.Host(false), shared.GatewayRESTUpstreamPort
+	}
+	return shared.Host(node.Spec.REST), node.Spec.RESTPort
+}
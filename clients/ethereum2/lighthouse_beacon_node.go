@@ -34,7 +34,15 @@ func (t *LighthouseBeaconNode) Args() (args []string) {
 
 	args = append(args, LighthouseDebugLevel, string(t.node.Spec.Logging))
 
-	args = append(args, LighthouseNetwork, node.Spec.Network)
+	// custom network is loaded from genesis directory
+	if node.Spec.Genesis != nil {
+		args = append(args, LighthouseTestnetDir, shared.PathConfig(t.HomeDir()))
+		if len(node.Spec.Genesis.Bootnodes) != 0 {
+			args = append(args, LighthouseBootNodes, strings.Join(node.Spec.Genesis.Bootnodes, ","))
+		}
+	} else {
+		args = append(args, LighthouseNetwork, node.Spec.Network)
+	}
 
 	args = append(args, LighthouseExecutionEngineEndpoint, node.Spec.ExecutionEngineEndpoint)
 
@@ -46,14 +54,19 @@ func (t *LighthouseBeaconNode) Args() (args []string) {
 	if node.Spec.REST {
 		args = append(args, LighthouseHTTP)
 		args = append(args, LighthouseAllowOrigins, strings.Join(node.Spec.CORSDomains, ","))
-		args = append(args, LighthouseHTTPPort, fmt.Sprintf("%d", node.Spec.RESTPort))
-		args = append(args, LighthouseHTTPAddress, shared.Host(node.Spec.REST))
+		restHost, restPort := restServer(node)
+		args = append(args, LighthouseHTTPPort, fmt.Sprintf("%d", restPort))
+		args = append(args, LighthouseHTTPAddress, restHost)
 	}
 
 	if node.Spec.CheckpointSyncURL != "" {
 		args = append(args, LighthouseCheckpointSyncUrl, node.Spec.CheckpointSyncURL)
 	}
 
+	if node.Spec.Builder != nil {
+		args = append(args, LighthouseBuilder, node.Spec.Builder.Endpoint)
+	}
+
 	args = append(args, LighthousePort, fmt.Sprintf("%d", node.Spec.P2PPort))
 	args = append(args, LighthouseDiscoveryPort, fmt.Sprintf("%d", node.Spec.P2PPort))
 
@@ -1,6 +1,7 @@
 package ethereum2
 
 import (
+	"fmt"
 	"strings"
 
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
@@ -25,6 +26,7 @@ func (t *LighthouseValidatorClient) Env() []corev1.EnvVar {
 }
 
 // Args returns command line arguments required for client
+// keystores and remote signer keys are loaded from validator definitions file in data directory
 func (t *LighthouseValidatorClient) Args() (args []string) {
 
 	validator := t.validator
@@ -33,7 +35,12 @@ func (t *LighthouseValidatorClient) Args() (args []string) {
 
 	args = append(args, LighthouseDebugLevel, string(t.validator.Spec.Logging))
 
-	args = append(args, LighthouseNetwork, validator.Spec.Network)
+	// custom network is loaded from genesis directory
+	if validator.Spec.Genesis != nil {
+		args = append(args, LighthouseTestnetDir, GenesisDir(t.HomeDir()))
+	} else {
+		args = append(args, LighthouseNetwork, validator.Spec.Network)
+	}
 
 	args = append(args, LighthouseFeeRecipient, string(validator.Spec.FeeRecipient))
 
@@ -45,9 +52,54 @@ func (t *LighthouseValidatorClient) Args() (args []string) {
 		args = append(args, LighthouseGraffiti, validator.Spec.Graffiti)
 	}
 
+	if validator.Spec.Builder != nil {
+		args = append(args, LighthouseBuilderProposals)
+		args = append(args, LighthouseGasLimit, fmt.Sprintf("%d", validator.Spec.Builder.GasLimit))
+	}
+
+	if validator.Spec.DoppelgangerProtection {
+		args = append(args, LighthouseEnableDoppelgangerProtection)
+	}
+
 	return
 }
 
+// ImportSlashingProtection returns command importing slashing protection interchange file
+func (t *LighthouseValidatorClient) ImportSlashingProtection(file string) []string {
+	return []string{
+		"lighthouse",
+		"account",
+		"validator",
+		LighthouseSlashingProtection,
+		"import",
+		file,
+		argWithVal(LighthouseDataDir, shared.PathData(t.HomeDir())),
+		t.networkArg(),
+	}
+}
+
+// ExportSlashingProtection returns command exporting slashing protection interchange into dir
+func (t *LighthouseValidatorClient) ExportSlashingProtection(dir string) []string {
+	return []string{
+		"lighthouse",
+		"account",
+		"validator",
+		LighthouseSlashingProtection,
+		"export",
+		fmt.Sprintf("%s/%s", dir, SlashingProtectionFile),
+		argWithVal(LighthouseDataDir, shared.PathData(t.HomeDir())),
+		t.networkArg(),
+	}
+}
+
+// networkArg returns network argument, or genesis directory argument for custom networks
+func (t *LighthouseValidatorClient) networkArg() string {
+	if t.validator.Spec.Genesis != nil {
+		return argWithVal(LighthouseTestnetDir, GenesisDir(t.HomeDir()))
+	}
+	return argWithVal(LighthouseNetwork, t.validator.Spec.Network)
+}
+
 // Command returns command for running the client
 func (t *LighthouseValidatorClient) Command() (command []string) {
 	command = []string{"lighthouse", "vc"}
@@ -1,6 +1,8 @@
 package ethereum2
 
 import (
+	"fmt"
+
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
 	sharedAPI "github.com/kotalco/kotal/apis/shared"
 	"github.com/kotalco/kotal/controllers/shared"
@@ -19,9 +21,10 @@ var _ = Describe("Lighthouse validator client", func() {
 				"http://localhost:8899",
 				"http://localhost:9988",
 			},
-			Graffiti:     "Validated by Kotal",
-			Logging:      sharedAPI.WarnLogs,
-			FeeRecipient: "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			Graffiti:               "Validated by Kotal",
+			Logging:                sharedAPI.WarnLogs,
+			FeeRecipient:           "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			DoppelgangerProtection: true,
 		},
 	}
 
@@ -36,6 +39,21 @@ var _ = Describe("Lighthouse validator client", func() {
 		Expect(client.Env()).To(BeNil())
 	})
 
+	It("Should get correct slashing protection commands", func() {
+		protector := client.(SlashingProtector)
+		Expect(protector.ImportSlashingProtection("/tmp/interchange.json")).To(ContainElements(
+			"lighthouse",
+			LighthouseSlashingProtection,
+			"import",
+			"/tmp/interchange.json",
+			"--network=mainnet",
+		))
+		Expect(protector.ExportSlashingProtection("/tmp")).To(ContainElements(
+			"export",
+			"/tmp/"+SlashingProtectionFile,
+		))
+	})
+
 	It("Should get correct home dir", func() {
 		Expect(client.HomeDir()).To(Equal(LighthouseHomeDir))
 	})
@@ -56,7 +74,72 @@ var _ = Describe("Lighthouse validator client", func() {
 			string(sharedAPI.WarnLogs),
 			LighthouseFeeRecipient,
 			"0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			LighthouseEnableDoppelgangerProtection,
 		}))
 	})
 
 })
+
+var _ = Describe("Lighthouse validator client with block builder", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.LighthouseClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:9988"},
+			Builder: &ethereum2v1alpha1.ValidatorBuilder{
+				GasLimit: 36000000,
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			LighthouseBuilderProposals,
+			LighthouseGasLimit,
+			"36000000",
+		}))
+	})
+
+})
+
+var _ = Describe("Lighthouse validator client on custom network", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:  ethereum2v1alpha1.LighthouseClient,
+			Network: "devnet",
+			Genesis: &ethereum2v1alpha1.ValidatorGenesis{
+				ConfigMapName: "devnet-genesis",
+			},
+			BeaconEndpoints: []string{"http://localhost:9988"},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		args := client.Args()
+
+		Expect(args).To(ContainElements([]string{
+			LighthouseTestnetDir,
+			GenesisDir(client.HomeDir()),
+		}))
+		Expect(args).NotTo(ContainElement(LighthouseNetwork))
+	})
+
+	It("Should get correct slashing protection commands", func() {
+		protector := client.(SlashingProtector)
+		Expect(protector.ImportSlashingProtection("/tmp/interchange.json")).To(ContainElement(
+			fmt.Sprintf("%s=%s", LighthouseTestnetDir, GenesisDir(client.HomeDir())),
+		))
+		Expect(protector.ExportSlashingProtection("/tmp")).To(ContainElement(
+			fmt.Sprintf("%s=%s", LighthouseTestnetDir, GenesisDir(client.HomeDir())),
+		))
+	})
+
+})
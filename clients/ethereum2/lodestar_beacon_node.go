@@ -0,0 +1,84 @@
+package ethereum2
+
+import (
+	"fmt"
+	"strings"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	"github.com/kotalco/kotal/controllers/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// LodestarBeaconNode is ChainSafe Ethereum 2.0 client
+// https://github.com/ChainSafe/lodestar
+type LodestarBeaconNode struct {
+	node *ethereum2v1alpha1.BeaconNode
+}
+
+// HomeDir returns container home directory
+func (l *LodestarBeaconNode) HomeDir() string {
+	return LodestarHomeDir
+}
+
+// Env returns environment variables for running the client
+func (l *LodestarBeaconNode) Env() []corev1.EnvVar {
+	return nil
+}
+
+// Args returns command line arguments required for client
+func (l *LodestarBeaconNode) Args() (args []string) {
+
+	node := l.node
+
+	args = append(args, LodestarDataDir, shared.PathData(l.HomeDir()))
+
+	args = append(args, LodestarLogLevel, string(node.Spec.Logging))
+
+	// custom network is loaded from genesis chain config and state files
+	if node.Spec.Genesis != nil {
+		args = append(args, LodestarParamsFile, fmt.Sprintf("%s/%s", shared.PathConfig(l.HomeDir()), GenesisConfigFile))
+		if node.Spec.CheckpointSyncURL == "" {
+			args = append(args, LodestarGenesisStateFile, fmt.Sprintf("%s/%s", shared.PathConfig(l.HomeDir()), GenesisStateFile))
+		}
+		if len(node.Spec.Genesis.Bootnodes) != 0 {
+			args = append(args, LodestarBootnodes, strings.Join(node.Spec.Genesis.Bootnodes, ","))
+		}
+	} else {
+		args = append(args, LodestarNetwork, node.Spec.Network)
+	}
+
+	args = append(args, LodestarExecutionEngineEndpoint, node.Spec.ExecutionEngineEndpoint)
+
+	args = append(args, LodestarFeeRecipient, string(node.Spec.FeeRecipient))
+
+	jwtSecretPath := fmt.Sprintf("%s/jwt.secret", shared.PathSecrets(l.HomeDir()))
+	args = append(args, LodestarJwtSecretFile, jwtSecretPath)
+
+	if node.Spec.REST {
+		args = append(args, LodestarREST)
+		args = append(args, LodestarRESTCors, strings.Join(node.Spec.CORSDomains, ","))
+		restHost, restPort := restServer(node)
+		args = append(args, LodestarRESTPort, fmt.Sprintf("%d", restPort))
+		args = append(args, LodestarRESTAddress, restHost)
+	}
+
+	if node.Spec.CheckpointSyncURL != "" {
+		args = append(args, LodestarCheckpointSyncUrl, node.Spec.CheckpointSyncURL)
+	}
+
+	if node.Spec.Builder != nil {
+		args = append(args, LodestarBuilder)
+		args = append(args, LodestarBuilderURLs, node.Spec.Builder.Endpoint)
+	}
+
+	args = append(args, LodestarPort, fmt.Sprintf("%d", node.Spec.P2PPort))
+	args = append(args, LodestarDiscoveryPort, fmt.Sprintf("%d", node.Spec.P2PPort))
+
+	return
+}
+
+// Command returns command for running the client
+func (l *LodestarBeaconNode) Command() (command []string) {
+	command = []string{"node", LodestarCLI, "beacon"}
+	return
+}
@@ -0,0 +1,157 @@
+package ethereum2
+
+import (
+	"fmt"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	"github.com/kotalco/kotal/controllers/shared"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lodestar beacon node", func() {
+
+	node := ethereum2v1alpha1.BeaconNode{
+		Spec: ethereum2v1alpha1.BeaconNodeSpec{
+			Client:  ethereum2v1alpha1.LodestarClient,
+			Network: "mainnet",
+		},
+	}
+	client, _ := NewClient(&node)
+
+	It("Should get correct command", func() {
+		Expect(client.Command()).To(ConsistOf("node", LodestarCLI, "beacon"))
+	})
+
+	It("Should get correct env", func() {
+		Expect(client.Env()).To(BeNil())
+	})
+
+	It("Should get correct home dir", func() {
+		Expect(client.HomeDir()).To(Equal(LodestarHomeDir))
+	})
+
+	cases := []struct {
+		title  string
+		node   *ethereum2v1alpha1.BeaconNode
+		result []string
+	}{
+		{
+			title: "beacon node syncing mainnet",
+			node: &ethereum2v1alpha1.BeaconNode{
+				Spec: ethereum2v1alpha1.BeaconNodeSpec{
+					Client:                  ethereum2v1alpha1.LodestarClient,
+					Network:                 "mainnet",
+					Logging:                 sharedAPI.DebugLogs,
+					ExecutionEngineEndpoint: "https://localhost:8551",
+					JWTSecretName:           "jwt-secret",
+					FeeRecipient:            "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+				},
+			},
+			result: []string{
+				LodestarDataDir,
+				shared.PathData(client.HomeDir()),
+				LodestarNetwork,
+				"mainnet",
+				LodestarLogLevel,
+				string(sharedAPI.DebugLogs),
+				LodestarExecutionEngineEndpoint,
+				"https://localhost:8551",
+				LodestarJwtSecretFile,
+				fmt.Sprintf("%s/jwt.secret", shared.PathSecrets(client.HomeDir())),
+				LodestarFeeRecipient,
+				"0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			},
+		},
+		{
+			title: "beacon node syncing mainnet with p2p port, rest enabled with port and checkpoint syncing",
+			node: &ethereum2v1alpha1.BeaconNode{
+				Spec: ethereum2v1alpha1.BeaconNodeSpec{
+					Client:                  ethereum2v1alpha1.LodestarClient,
+					P2PPort:                 7891,
+					Network:                 "mainnet",
+					ExecutionEngineEndpoint: "https://localhost:8551",
+					JWTSecretName:           "jwt-secret",
+					REST:                    true,
+					RESTPort:                4444,
+					CheckpointSyncURL:       "https://kotal.cloud/eth2/beacon/checkpoint",
+				},
+			},
+			result: []string{
+				LodestarPort,
+				"7891",
+				LodestarDiscoveryPort,
+				"7891",
+				LodestarREST,
+				LodestarRESTPort,
+				"4444",
+				LodestarRESTAddress,
+				"0.0.0.0",
+				LodestarRESTCors,
+				"*",
+				LodestarCheckpointSyncUrl,
+				"https://kotal.cloud/eth2/beacon/checkpoint",
+			},
+		},
+		{
+			title: "beacon node syncing mainnet with external block builder",
+			node: &ethereum2v1alpha1.BeaconNode{
+				Spec: ethereum2v1alpha1.BeaconNodeSpec{
+					Client:                  ethereum2v1alpha1.LodestarClient,
+					Network:                 "mainnet",
+					ExecutionEngineEndpoint: "https://localhost:8551",
+					JWTSecretName:           "jwt-secret",
+					Builder: &ethereum2v1alpha1.BeaconNodeBuilder{
+						Endpoint: "http://mev-boost.default.svc:18550",
+					},
+				},
+			},
+			result: []string{
+				LodestarBuilder,
+				LodestarBuilderURLs,
+				"http://mev-boost.default.svc:18550",
+			},
+		},
+		{
+			title: "beacon node syncing custom network",
+			node: &ethereum2v1alpha1.BeaconNode{
+				Spec: ethereum2v1alpha1.BeaconNodeSpec{
+					Client:                  ethereum2v1alpha1.LodestarClient,
+					Network:                 "devnet",
+					ExecutionEngineEndpoint: "https://localhost:8551",
+					JWTSecretName:           "jwt-secret",
+					Genesis: &ethereum2v1alpha1.BeaconGenesis{
+						ConfigMapName: "devnet-genesis",
+						Bootnodes: []string{
+							"enr:-Iq4QJk4WqRkjsX5c2CXtOra6HnxN-BMXnWhmhEQO9Bn9iABTJGdjUOurM7Btj1ouKaFkvTRoju5vz2GPmVON2dffQKGAX53x8JigmlkgnY0gmlwhLKAlv6Jc2VjcDI1NmsxoQK6S-Cii_KmfFdUJL2TANL3ksaKUnNXvTCv1tLwXs0QgIN1ZHCCIyk",
+							"enr:-Ly4QFPk-cTMxZ3jWTafiNblEZkQIXGF2aVzCIGW0uHp6KaEAvBMoctE8S7YU0qZtuS7By0AA4YMfKoN9ls_GJRccVpFh2F0dG5ldHOI__________-EZXRoMpCC9KcrAgAQIIS2AQAAAAAAgmlkgnY0gmlwhKh3joWJc2VjcDI1NmsxoQKrxz8M1IHwJqRIpDqdVW_U1PeixMW5SfnBD-8idYIQrIhzeW5jbmV0cw-DdGNwgiMog3VkcIIjKA",
+						},
+					},
+				},
+			},
+			result: []string{
+				LodestarParamsFile,
+				fmt.Sprintf("%s/%s", shared.PathConfig(client.HomeDir()), GenesisConfigFile),
+				LodestarGenesisStateFile,
+				fmt.Sprintf("%s/%s", shared.PathConfig(client.HomeDir()), GenesisStateFile),
+				LodestarBootnodes,
+				"enr:-Iq4QJk4WqRkjsX5c2CXtOra6HnxN-BMXnWhmhEQO9Bn9iABTJGdjUOurM7Btj1ouKaFkvTRoju5vz2GPmVON2dffQKGAX53x8JigmlkgnY0gmlwhLKAlv6Jc2VjcDI1NmsxoQK6S-Cii_KmfFdUJL2TANL3ksaKUnNXvTCv1tLwXs0QgIN1ZHCCIyk,enr:-Ly4QFPk-cTMxZ3jWTafiNblEZkQIXGF2aVzCIGW0uHp6KaEAvBMoctE8S7YU0qZtuS7By0AA4YMfKoN9ls_GJRccVpFh2F0dG5ldHOI__________-EZXRoMpCC9KcrAgAQIIS2AQAAAAAAgmlkgnY0gmlwhKh3joWJc2VjcDI1NmsxoQKrxz8M1IHwJqRIpDqdVW_U1PeixMW5SfnBD-8idYIQrIhzeW5jbmV0cw-DdGNwgiMog3VkcIIjKA",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		func() {
+			cc := c
+			It(fmt.Sprintf("Should create correct client arguments for %s", cc.title), func() {
+				cc.node.Default()
+				client, _ := NewClient(cc.node)
+				args := client.Args()
+				Expect(args).To(ContainElements(cc.result))
+			})
+		}()
+	}
+
+})
@@ -0,0 +1,118 @@
+package ethereum2
+
+import (
+	"fmt"
+	"strings"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	"github.com/kotalco/kotal/controllers/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// LodestarValidatorClient is ChainSafe Ethereum 2.0 validator client
+// https://github.com/ChainSafe/lodestar
+type LodestarValidatorClient struct {
+	validator *ethereum2v1alpha1.Validator
+}
+
+// HomeDir returns container home directory
+func (l *LodestarValidatorClient) HomeDir() string {
+	return LodestarHomeDir
+}
+
+// Env returns environment variables for running the client
+func (l *LodestarValidatorClient) Env() []corev1.EnvVar {
+	return nil
+}
+
+// Args returns command line arguments required for client
+// keystores are imported into data directory keystores and secrets directories by init containers
+// or signed for by remote signer
+func (l *LodestarValidatorClient) Args() (args []string) {
+
+	validator := l.validator
+
+	args = append(args, LodestarDataDir, shared.PathData(l.HomeDir()))
+
+	args = append(args, LodestarLogLevel, string(validator.Spec.Logging))
+
+	// custom network is loaded from genesis chain config file
+	if validator.Spec.Genesis != nil {
+		args = append(args, LodestarParamsFile, fmt.Sprintf("%s/%s", GenesisDir(l.HomeDir()), GenesisConfigFile))
+	} else {
+		args = append(args, LodestarNetwork, validator.Spec.Network)
+	}
+
+	args = append(args, LodestarFeeRecipient, string(validator.Spec.FeeRecipient))
+
+	if len(validator.Spec.BeaconEndpoints) != 0 {
+		args = append(args, LodestarBeaconNodes, strings.Join(validator.Spec.BeaconEndpoints, ","))
+	}
+
+	if validator.Spec.Graffiti != "" {
+		args = append(args, LodestarGraffiti, validator.Spec.Graffiti)
+	}
+
+	if validator.Spec.Builder != nil {
+		args = append(args, LodestarBuilder)
+		args = append(args, LodestarDefaultGasLimit, fmt.Sprintf("%d", validator.Spec.Builder.GasLimit))
+	}
+
+	if validator.Spec.DoppelgangerProtection {
+		args = append(args, LodestarDoppelgangerProtection)
+	}
+
+	if ProposerConfigured(validator) {
+		args = append(args, LodestarProposerSettingsFile, fmt.Sprintf("%s/%s", shared.PathConfig(l.HomeDir()), ProposerConfigFile))
+	}
+
+	// validator keys are signed for by remote signer
+	if validator.Spec.RemoteSigner != "" {
+		args = append(args, LodestarExternalSignerURL, validator.Status.RemoteSignerURL)
+		args = append(args, LodestarExternalSignerPublicKeys, strings.Join(validator.Status.PublicKeys, ","))
+	}
+
+	return
+}
+
+// ImportSlashingProtection returns command importing slashing protection interchange file
+func (l *LodestarValidatorClient) ImportSlashingProtection(file string) []string {
+	return []string{
+		"node",
+		LodestarCLI,
+		"validator",
+		LodestarSlashingProtection,
+		"import",
+		argWithVal(LodestarSlashingProtectionFile, file),
+		argWithVal(LodestarDataDir, shared.PathData(l.HomeDir())),
+		l.networkArg(),
+	}
+}
+
+// ExportSlashingProtection returns command exporting slashing protection interchange into dir
+func (l *LodestarValidatorClient) ExportSlashingProtection(dir string) []string {
+	return []string{
+		"node",
+		LodestarCLI,
+		"validator",
+		LodestarSlashingProtection,
+		"export",
+		argWithVal(LodestarSlashingProtectionFile, fmt.Sprintf("%s/%s", dir, SlashingProtectionFile)),
+		argWithVal(LodestarDataDir, shared.PathData(l.HomeDir())),
+		l.networkArg(),
+	}
+}
+
+// networkArg returns network argument, or genesis chain config file argument for custom networks
+func (l *LodestarValidatorClient) networkArg() string {
+	if l.validator.Spec.Genesis != nil {
+		return argWithVal(LodestarParamsFile, fmt.Sprintf("%s/%s", GenesisDir(l.HomeDir()), GenesisConfigFile))
+	}
+	return argWithVal(LodestarNetwork, l.validator.Spec.Network)
+}
+
+// Command returns command for running the client
+func (l *LodestarValidatorClient) Command() (command []string) {
+	command = []string{"node", LodestarCLI, "validator"}
+	return
+}
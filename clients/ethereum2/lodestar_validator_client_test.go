@@ -0,0 +1,190 @@
+package ethereum2
+
+import (
+	"fmt"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	"github.com/kotalco/kotal/controllers/shared"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lodestar validator client", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:  ethereum2v1alpha1.LodestarClient,
+			Network: "mainnet",
+			BeaconEndpoints: []string{
+				"http://localhost:8899",
+				"http://localhost:9988",
+			},
+			Graffiti:               "Validated by Kotal",
+			Logging:                sharedAPI.WarnLogs,
+			FeeRecipient:           "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			DoppelgangerProtection: true,
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should get correct command", func() {
+		Expect(client.Command()).To(ConsistOf("node", LodestarCLI, "validator"))
+	})
+
+	It("Should get correct env", func() {
+		Expect(client.Env()).To(BeNil())
+	})
+
+	It("Should get correct home dir", func() {
+		Expect(client.HomeDir()).To(Equal(LodestarHomeDir))
+	})
+
+	It("Should generate correct client arguments", func() {
+		args := client.Args()
+
+		Expect(args).To(ContainElements([]string{
+			LodestarDataDir,
+			shared.PathData(client.HomeDir()),
+			LodestarNetwork,
+			"mainnet",
+			LodestarBeaconNodes,
+			"http://localhost:8899,http://localhost:9988",
+			LodestarGraffiti,
+			"Validated by Kotal",
+			LodestarLogLevel,
+			string(sharedAPI.WarnLogs),
+			LodestarFeeRecipient,
+			"0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			LodestarDoppelgangerProtection,
+		}))
+	})
+
+})
+
+var _ = Describe("Lodestar validator client with remote signer", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.LodestarClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:8899"},
+			RemoteSigner:    "web3signer",
+		},
+		Status: ethereum2v1alpha1.ValidatorStatus{
+			RemoteSignerURL: "http://web3signer.default.svc:9000",
+			PublicKeys: []string{
+				"0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			LodestarExternalSignerURL,
+			"http://web3signer.default.svc:9000",
+			LodestarExternalSignerPublicKeys,
+			"0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+		}))
+	})
+
+})
+
+var _ = Describe("Lodestar validator client with block builder", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.LodestarClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:9988"},
+			Builder: &ethereum2v1alpha1.ValidatorBuilder{
+				GasLimit: 36000000,
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			LodestarBuilder,
+			LodestarDefaultGasLimit,
+			"36000000",
+		}))
+	})
+
+})
+
+var _ = Describe("Lodestar validator client with proposer config", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.LodestarClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:9988"},
+			FeeRecipient:    "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{
+					SecretName:   "my-validator",
+					FeeRecipient: "0x71c7656ec7ab88b098defb751b7401b5f6d8976f",
+				},
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			LodestarProposerSettingsFile,
+			shared.PathConfig(client.HomeDir()) + "/" + ProposerConfigFile,
+		}))
+	})
+
+})
+
+var _ = Describe("Lodestar validator client on custom network", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:  ethereum2v1alpha1.LodestarClient,
+			Network: "devnet",
+			Genesis: &ethereum2v1alpha1.ValidatorGenesis{
+				ConfigMapName: "devnet-genesis",
+			},
+			BeaconEndpoints: []string{"http://localhost:9988"},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		args := client.Args()
+
+		Expect(args).To(ContainElements([]string{
+			LodestarParamsFile,
+			fmt.Sprintf("%s/%s", GenesisDir(client.HomeDir()), GenesisConfigFile),
+		}))
+		Expect(args).NotTo(ContainElement(LodestarNetwork))
+	})
+
+	It("Should get correct slashing protection commands", func() {
+		protector := client.(SlashingProtector)
+		Expect(protector.ImportSlashingProtection("/tmp/interchange.json")).To(ContainElement(
+			fmt.Sprintf("%s=%s/%s", LodestarParamsFile, GenesisDir(client.HomeDir()), GenesisConfigFile),
+		))
+		Expect(protector.ExportSlashingProtection("/tmp")).To(ContainElement(
+			fmt.Sprintf("%s=%s/%s", LodestarParamsFile, GenesisDir(client.HomeDir()), GenesisConfigFile),
+		))
+	})
+
+})
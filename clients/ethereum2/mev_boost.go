@@ -0,0 +1,55 @@
+package ethereum2
+
+import (
+	"fmt"
+	"strings"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	"github.com/kotalco/kotal/controllers/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MEVBoost is Flashbots block builders relays multiplexer
+// https://github.com/flashbots/mev-boost
+type MEVBoost struct {
+	boost *ethereum2v1alpha1.MEVBoost
+}
+
+// HomeDir returns container home directory
+func (m *MEVBoost) HomeDir() string {
+	return MEVBoostHomeDir
+}
+
+// Env returns environment variables for running the client
+func (m *MEVBoost) Env() []corev1.EnvVar {
+	return nil
+}
+
+// Args returns command line arguments required for client
+func (m *MEVBoost) Args() (args []string) {
+
+	boost := m.boost
+
+	args = append(args, fmt.Sprintf("-%s", boost.Spec.Network))
+
+	args = append(args, MEVBoostLogLevel, string(boost.Spec.Logging))
+
+	args = append(args, MEVBoostAddr, fmt.Sprintf("%s:%d", shared.Host(true), boost.Spec.Port))
+
+	args = append(args, MEVBoostRelays, strings.Join(boost.Spec.Relays, ","))
+
+	if boost.Spec.MinBid != "" {
+		args = append(args, MEVBoostMinBid, boost.Spec.MinBid)
+	}
+
+	if boost.Spec.RelayCheck {
+		args = append(args, MEVBoostRelayCheck)
+	}
+
+	return
+}
+
+// Command returns command for running the client
+func (m *MEVBoost) Command() (command []string) {
+	return
+}
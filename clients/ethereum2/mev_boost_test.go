@@ -0,0 +1,57 @@
+package ethereum2
+
+import (
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MEV-Boost", func() {
+
+	boost := &ethereum2v1alpha1.MEVBoost{
+		Spec: ethereum2v1alpha1.MEVBoostSpec{
+			Network: "sepolia",
+			Port:    18551,
+			Relays: []string{
+				"https://0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae@boost-relay-sepolia.flashbots.net",
+				"https://0x845bd072b7cd566f02faeb0a4033ce9399e42839ced64e8b2adcfc859ed1e8e1a5a293336a49feac6d9a5edb779be53a@boost-relay-sepolia.example.com",
+			},
+			MinBid:     "0.05",
+			RelayCheck: true,
+			Logging:    sharedAPI.DebugLogs,
+		},
+	}
+
+	boost.Default()
+	client, _ := NewClient(boost)
+
+	It("Should get correct command", func() {
+		Expect(client.Command()).To(BeNil())
+	})
+
+	It("Should get correct env", func() {
+		Expect(client.Env()).To(BeNil())
+	})
+
+	It("Should get correct home dir", func() {
+		Expect(client.HomeDir()).To(Equal(MEVBoostHomeDir))
+	})
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			"-sepolia",
+			MEVBoostLogLevel,
+			string(sharedAPI.DebugLogs),
+			MEVBoostAddr,
+			"0.0.0.0:18551",
+			MEVBoostRelays,
+			"https://0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae@boost-relay-sepolia.flashbots.net,https://0x845bd072b7cd566f02faeb0a4033ce9399e42839ced64e8b2adcfc859ed1e8e1a5a293336a49feac6d9a5edb779be53a@boost-relay-sepolia.example.com",
+			MEVBoostMinBid,
+			"0.05",
+			MEVBoostRelayCheck,
+		}))
+	})
+
+})
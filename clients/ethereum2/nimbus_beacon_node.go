@@ -36,7 +36,15 @@ func (t *NimbusBeaconNode) Args() (args []string) {
 
 	args = append(args, argWithVal(NimbusLogging, string(t.node.Spec.Logging)))
 
-	args = append(args, argWithVal(NimbusNetwork, node.Spec.Network))
+	// custom network is loaded from genesis directory
+	if node.Spec.Genesis != nil {
+		args = append(args, argWithVal(NimbusNetwork, shared.PathConfig(t.HomeDir())))
+		for _, bootnode := range node.Spec.Genesis.Bootnodes {
+			args = append(args, argWithVal(NimbusBootstrapNode, bootnode))
+		}
+	} else {
+		args = append(args, argWithVal(NimbusNetwork, node.Spec.Network))
+	}
 
 	args = append(args, argWithVal(NimbusExecutionEngineEndpoint, node.Spec.ExecutionEngineEndpoint))
 
@@ -47,11 +55,17 @@ func (t *NimbusBeaconNode) Args() (args []string) {
 
 	if node.Spec.REST {
 		args = append(args, NimbusREST)
-		args = append(args, argWithVal(NimbusRESTAddress, shared.Host(node.Spec.REST)))
-		args = append(args, argWithVal(NimbusRESTPort, fmt.Sprintf("%d", node.Spec.RESTPort)))
+		restHost, restPort := restServer(node)
+		args = append(args, argWithVal(NimbusRESTAddress, restHost))
+		args = append(args, argWithVal(NimbusRESTPort, fmt.Sprintf("%d", restPort)))
 		args = append(args, argWithVal(NimbusRESTAllowOrigin, strings.Join(node.Spec.CORSDomains, ",")))
 	}
 
+	if node.Spec.Builder != nil {
+		args = append(args, argWithVal(NimbusPayloadBuilder, "true"))
+		args = append(args, argWithVal(NimbusPayloadBuilderURL, node.Spec.Builder.Endpoint))
+	}
+
 	args = append(args, argWithVal(NimbusTCPPort, fmt.Sprintf("%d", node.Spec.P2PPort)))
 	args = append(args, argWithVal(NimbusUDPPort, fmt.Sprintf("%d", node.Spec.P2PPort)))
 
@@ -36,11 +36,20 @@ func (t *NimbusValidatorClient) Args() (args []string) {
 
 	args = append(args, argWithVal(NimbusDataDir, shared.PathData(t.HomeDir())))
 
-	args = append(args, argWithVal(NimbusFeeRecipient, string(validator.Spec.FeeRecipient)))
+	// custom network is loaded from genesis directory, public networks are fetched from beacon node
+	if validator.Spec.Genesis != nil {
+		args = append(args, argWithVal(NimbusNetwork, GenesisDir(t.HomeDir())))
+	}
 
-	args = append(args, argWithVal(NimbusValidatorsDir, fmt.Sprintf("%s/kotal-validators/validator-keys", shared.PathData(t.HomeDir()))))
+	args = append(args, argWithVal(NimbusFeeRecipient, string(validator.Spec.FeeRecipient)))
 
-	args = append(args, argWithVal(NimbusSecretsDir, fmt.Sprintf("%s/kotal-validators/validator-secrets", shared.PathData(t.HomeDir()))))
+	// validator keys are either signed for by remote signer or copied into data directory
+	if validator.Spec.RemoteSigner != "" {
+		args = append(args, argWithVal(NimbusWeb3SignerURL, validator.Status.RemoteSignerURL))
+	} else {
+		args = append(args, argWithVal(NimbusValidatorsDir, fmt.Sprintf("%s/kotal-validators/validator-keys", shared.PathData(t.HomeDir()))))
+		args = append(args, argWithVal(NimbusSecretsDir, fmt.Sprintf("%s/kotal-validators/validator-secrets", shared.PathData(t.HomeDir()))))
+	}
 
 	args = append(args, argWithVal(NimbusBeaconNodes, strings.Join(validator.Spec.BeaconEndpoints, ",")))
 
@@ -48,9 +57,43 @@ func (t *NimbusValidatorClient) Args() (args []string) {
 		args = append(args, argWithVal(NimbusGraffiti, validator.Spec.Graffiti))
 	}
 
+	if validator.Spec.Builder != nil {
+		args = append(args, argWithVal(NimbusPayloadBuilder, "true"))
+		args = append(args, argWithVal(NimbusSuggestedGasLimit, fmt.Sprintf("%d", validator.Spec.Builder.GasLimit)))
+	}
+
+	if validator.Spec.DoppelgangerProtection {
+		args = append(args, argWithVal(NimbusDoppelgangerDetection, "true"))
+	}
+
 	return
 }
 
+// ImportSlashingProtection returns command importing slashing protection interchange file
+// slashing protection database is stored in validators directory
+func (t *NimbusValidatorClient) ImportSlashingProtection(file string) []string {
+	return []string{
+		"nimbus_beacon_node",
+		NimbusSlashingDB,
+		"import",
+		file,
+		argWithVal(NimbusDataDir, shared.PathData(t.HomeDir())),
+		argWithVal(NimbusValidatorsDir, fmt.Sprintf("%s/kotal-validators/validator-keys", shared.PathData(t.HomeDir()))),
+	}
+}
+
+// ExportSlashingProtection returns command exporting slashing protection interchange into dir
+func (t *NimbusValidatorClient) ExportSlashingProtection(dir string) []string {
+	return []string{
+		"nimbus_beacon_node",
+		NimbusSlashingDB,
+		"export",
+		fmt.Sprintf("%s/%s", dir, SlashingProtectionFile),
+		argWithVal(NimbusDataDir, shared.PathData(t.HomeDir())),
+		argWithVal(NimbusValidatorsDir, fmt.Sprintf("%s/kotal-validators/validator-keys", shared.PathData(t.HomeDir()))),
+	}
+}
+
 // Command returns command for running the client
 func (t *NimbusValidatorClient) Command() (command []string) {
 	command = []string{"nimbus_validator_client"}
@@ -22,8 +22,9 @@ var _ = Describe("Nimbus validator client", func() {
 					SecretName: "my-validator",
 				},
 			},
-			FeeRecipient: "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
-			Logging:      sharedAPI.FatalLogs,
+			FeeRecipient:           "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			DoppelgangerProtection: true,
+			Logging:                sharedAPI.FatalLogs,
 		},
 	}
 
@@ -55,8 +56,58 @@ var _ = Describe("Nimbus validator client", func() {
 			argWithVal(NimbusValidatorsDir, fmt.Sprintf("%s/kotal-validators/validator-keys", shared.PathData(client.HomeDir()))),
 			argWithVal(NimbusSecretsDir, fmt.Sprintf("%s/kotal-validators/validator-secrets", shared.PathData(client.HomeDir()))),
 			argWithVal(NimbusFeeRecipient, "0xd8da6bf26964af9d7eed9e03e53415d37aa96045"),
+			argWithVal(NimbusDoppelgangerDetection, "true"),
 		}))
 
 	})
 
 })
+
+var _ = Describe("Nimbus validator client with block builder", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.NimbusClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:9988"},
+			Builder: &ethereum2v1alpha1.ValidatorBuilder{
+				GasLimit: 36000000,
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			argWithVal(NimbusPayloadBuilder, "true"),
+			argWithVal(NimbusSuggestedGasLimit, "36000000"),
+		}))
+	})
+
+})
+
+var _ = Describe("Nimbus validator client on custom network", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:  ethereum2v1alpha1.NimbusClient,
+			Network: "devnet",
+			Genesis: &ethereum2v1alpha1.ValidatorGenesis{
+				ConfigMapName: "devnet-genesis",
+			},
+			BeaconEndpoints: []string{"http://localhost:9988"},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElement(
+			fmt.Sprintf("%s=%s", NimbusNetwork, GenesisDir(client.HomeDir())),
+		))
+	})
+
+})
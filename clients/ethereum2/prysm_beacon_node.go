@@ -43,13 +43,28 @@ func (t *PrysmBeaconNode) Args() (args []string) {
 	jwtSecretPath := fmt.Sprintf("%s/jwt.secret", shared.PathSecrets(t.HomeDir()))
 	args = append(args, PrysmJwtSecretFile, jwtSecretPath)
 
-	args = append(args, fmt.Sprintf("--%s", node.Spec.Network))
+	// custom network is loaded from genesis chain config and state files
+	if node.Spec.Genesis != nil {
+		args = append(args, PrysmChainConfigFile, fmt.Sprintf("%s/%s", shared.PathConfig(t.HomeDir()), GenesisConfigFile))
+		if node.Spec.CheckpointSyncURL == "" {
+			args = append(args, PrysmGenesisState, fmt.Sprintf("%s/%s", shared.PathConfig(t.HomeDir()), GenesisStateFile))
+		}
+		for _, bootnode := range node.Spec.Genesis.Bootnodes {
+			args = append(args, PrysmBootstrapNode, bootnode)
+		}
+	} else {
+		args = append(args, fmt.Sprintf("--%s", node.Spec.Network))
+	}
 
 	if node.Spec.CheckpointSyncURL != "" {
 		args = append(args, PrysmCheckpointSyncUrl, node.Spec.CheckpointSyncURL)
 		args = append(args, PrysmGenesisBeaconApiUrl, node.Spec.CheckpointSyncURL)
 	}
 
+	if node.Spec.Builder != nil {
+		args = append(args, PrysmHTTPMEVRelay, node.Spec.Builder.Endpoint)
+	}
+
 	if node.Spec.RPCPort != 0 {
 		args = append(args, PrysmRPCPort, fmt.Sprintf("%d", node.Spec.RPCPort))
 	}
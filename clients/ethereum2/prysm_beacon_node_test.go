@@ -55,6 +55,24 @@ var _ = Describe("Prysm beacon node", func() {
 				string(sharedAPI.WarnLogs),
 			},
 		},
+		{
+			title: "beacon node syncing hoodi",
+			node: &ethereum2v1alpha1.BeaconNode{
+				Spec: ethereum2v1alpha1.BeaconNodeSpec{
+					Client:  ethereum2v1alpha1.PrysmClient,
+					Network: "hoodi",
+					RPC:     true,
+					Logging: sharedAPI.InfoLogs,
+				},
+			},
+			result: []string{
+				PrysmAcceptTermsOfUse,
+				PrysmDataDir,
+				"--hoodi",
+				PrysmLogging,
+				string(sharedAPI.InfoLogs),
+			},
+		},
 		{
 			title: "beacon node syncing mainnet with checkpoint sync",
 			node: &ethereum2v1alpha1.BeaconNode{
@@ -247,6 +265,53 @@ var _ = Describe("Prysm beacon node", func() {
 				"*",
 			},
 		},
+		{
+			title: "beacon node syncing mainnet with external block builder",
+			node: &ethereum2v1alpha1.BeaconNode{
+				Spec: ethereum2v1alpha1.BeaconNodeSpec{
+					Client:                  ethereum2v1alpha1.PrysmClient,
+					Network:                 "mainnet",
+					ExecutionEngineEndpoint: "https://localhost:8551",
+					JWTSecretName:           "jwt-secret",
+					Builder: &ethereum2v1alpha1.BeaconNodeBuilder{
+						Endpoint: "http://mev-boost.default.svc:18550",
+					},
+				},
+			},
+			result: []string{
+				PrysmHTTPMEVRelay,
+				"http://mev-boost.default.svc:18550",
+			},
+		},
+		{
+			title: "beacon node syncing custom network",
+			node: &ethereum2v1alpha1.BeaconNode{
+				Spec: ethereum2v1alpha1.BeaconNodeSpec{
+					Client:                  ethereum2v1alpha1.PrysmClient,
+					Network:                 "devnet",
+					ExecutionEngineEndpoint: "https://localhost:8551",
+					JWTSecretName:           "jwt-secret",
+					RPC:                     true,
+					Genesis: &ethereum2v1alpha1.BeaconGenesis{
+						ConfigMapName: "devnet-genesis",
+						Bootnodes: []string{
+							"enr:-Iq4QJk4WqRkjsX5c2CXtOra6HnxN-BMXnWhmhEQO9Bn9iABTJGdjUOurM7Btj1ouKaFkvTRoju5vz2GPmVON2dffQKGAX53x8JigmlkgnY0gmlwhLKAlv6Jc2VjcDI1NmsxoQK6S-Cii_KmfFdUJL2TANL3ksaKUnNXvTCv1tLwXs0QgIN1ZHCCIyk",
+							"enr:-Ly4QFPk-cTMxZ3jWTafiNblEZkQIXGF2aVzCIGW0uHp6KaEAvBMoctE8S7YU0qZtuS7By0AA4YMfKoN9ls_GJRccVpFh2F0dG5ldHOI__________-EZXRoMpCC9KcrAgAQIIS2AQAAAAAAgmlkgnY0gmlwhKh3joWJc2VjcDI1NmsxoQKrxz8M1IHwJqRIpDqdVW_U1PeixMW5SfnBD-8idYIQrIhzeW5jbmV0cw-DdGNwgiMog3VkcIIjKA",
+						},
+					},
+				},
+			},
+			result: []string{
+				PrysmChainConfigFile,
+				fmt.Sprintf("%s/%s", shared.PathConfig(client.HomeDir()), GenesisConfigFile),
+				PrysmGenesisState,
+				fmt.Sprintf("%s/%s", shared.PathConfig(client.HomeDir()), GenesisStateFile),
+				PrysmBootstrapNode,
+				"enr:-Iq4QJk4WqRkjsX5c2CXtOra6HnxN-BMXnWhmhEQO9Bn9iABTJGdjUOurM7Btj1ouKaFkvTRoju5vz2GPmVON2dffQKGAX53x8JigmlkgnY0gmlwhLKAlv6Jc2VjcDI1NmsxoQK6S-Cii_KmfFdUJL2TANL3ksaKUnNXvTCv1tLwXs0QgIN1ZHCCIyk",
+				PrysmBootstrapNode,
+				"enr:-Ly4QFPk-cTMxZ3jWTafiNblEZkQIXGF2aVzCIGW0uHp6KaEAvBMoctE8S7YU0qZtuS7By0AA4YMfKoN9ls_GJRccVpFh2F0dG5ldHOI__________-EZXRoMpCC9KcrAgAQIIS2AQAAAAAAgmlkgnY0gmlwhKh3joWJc2VjcDI1NmsxoQKrxz8M1IHwJqRIpDqdVW_U1PeixMW5SfnBD-8idYIQrIhzeW5jbmV0cw-DdGNwgiMog3VkcIIjKA",
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -2,6 +2,7 @@ package ethereum2
 
 import (
 	"fmt"
+	"strings"
 
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
 	"github.com/kotalco/kotal/controllers/shared"
@@ -35,13 +36,29 @@ func (t *PrysmValidatorClient) Args() (args []string) {
 
 	args = append(args, PrysmLogging, string(t.validator.Spec.Logging))
 
-	args = append(args, PrysmWalletDir, fmt.Sprintf("%s/prysm-wallet", shared.PathData(t.HomeDir())))
-
-	args = append(args, PrysmWalletPasswordFile, fmt.Sprintf("%s/prysm-wallet/prysm-wallet-password.txt", shared.PathSecrets(t.HomeDir())))
+	// validator keys are either signed for by remote signer or imported into wallet
+	if validator.Spec.RemoteSigner != "" {
+		args = append(args, PrysmExternalSignerURL, validator.Status.RemoteSignerURL)
+		args = append(args, PrysmExternalSignerPublicKeys, strings.Join(validator.Status.PublicKeys, ","))
+	} else {
+		args = append(args, PrysmWalletDir, fmt.Sprintf("%s/prysm-wallet", shared.PathData(t.HomeDir())))
+		args = append(args, PrysmWalletPasswordFile, fmt.Sprintf("%s/prysm-wallet/prysm-wallet-password.txt", shared.PathSecrets(t.HomeDir())))
+	}
 
-	args = append(args, PrysmFeeRecipient, string(t.validator.Spec.FeeRecipient))
+	// prysm doesn't accept suggested fee recipient with proposer settings file
+	// validator fee recipient is the proposer settings default fee recipient
+	if ProposerConfigured(validator) {
+		args = append(args, PrysmProposerSettingsFile, fmt.Sprintf("%s/%s", shared.PathConfig(t.HomeDir()), ProposerConfigFile))
+	} else {
+		args = append(args, PrysmFeeRecipient, string(t.validator.Spec.FeeRecipient))
+	}
 
-	args = append(args, fmt.Sprintf("--%s", validator.Spec.Network))
+	// custom network is loaded from genesis chain config file
+	if validator.Spec.Genesis != nil {
+		args = append(args, PrysmChainConfigFile, fmt.Sprintf("%s/%s", GenesisDir(t.HomeDir()), GenesisConfigFile))
+	} else {
+		args = append(args, fmt.Sprintf("--%s", validator.Spec.Network))
+	}
 
 	if len(validator.Spec.BeaconEndpoints) != 0 {
 		args = append(args, PrysmBeaconRPCProvider, validator.Spec.BeaconEndpoints[0])
@@ -51,6 +68,15 @@ func (t *PrysmValidatorClient) Args() (args []string) {
 		args = append(args, PrysmGraffiti, validator.Spec.Graffiti)
 	}
 
+	if validator.Spec.Builder != nil {
+		args = append(args, PrysmEnableBuilder)
+		args = append(args, PrysmSuggestedGasLimit, fmt.Sprintf("%d", validator.Spec.Builder.GasLimit))
+	}
+
+	if validator.Spec.DoppelgangerProtection {
+		args = append(args, PrysmEnableDoppelganger)
+	}
+
 	if validator.Spec.CertSecretName != "" {
 		args = append(args, PrysmTLSCert, fmt.Sprintf("%s/cert/tls.crt", shared.PathSecrets(t.HomeDir())))
 	}
@@ -58,6 +84,30 @@ func (t *PrysmValidatorClient) Args() (args []string) {
 	return args
 }
 
+// ImportSlashingProtection returns command importing slashing protection interchange file
+func (t *PrysmValidatorClient) ImportSlashingProtection(file string) []string {
+	return []string{
+		"validator",
+		PrysmSlashingProtectionHistory,
+		"import",
+		PrysmAcceptTermsOfUse,
+		argWithVal(PrysmDataDir, shared.PathData(t.HomeDir())),
+		argWithVal(PrysmSlashingProtectionJSONFile, file),
+	}
+}
+
+// ExportSlashingProtection returns command exporting slashing protection interchange into dir
+func (t *PrysmValidatorClient) ExportSlashingProtection(dir string) []string {
+	return []string{
+		"validator",
+		PrysmSlashingProtectionHistory,
+		"export",
+		PrysmAcceptTermsOfUse,
+		argWithVal(PrysmDataDir, shared.PathData(t.HomeDir())),
+		argWithVal(PrysmSlashingProtectionExportDir, dir),
+	}
+}
+
 // Command returns command for running the client
 func (t *PrysmValidatorClient) Command() (command []string) {
 	command = []string{"validator"}
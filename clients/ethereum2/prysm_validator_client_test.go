@@ -23,10 +23,11 @@ var _ = Describe("Prysm validator client", func() {
 					SecretName: "my-validator",
 				},
 			},
-			WalletPasswordSecret: "wallet-password",
-			FeeRecipient:         "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
-			CertSecretName:       "my-cert",
-			Logging:              sharedAPI.ErrorLogs,
+			WalletPasswordSecret:   "wallet-password",
+			FeeRecipient:           "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			DoppelgangerProtection: true,
+			CertSecretName:         "my-cert",
+			Logging:                sharedAPI.ErrorLogs,
 		},
 	}
 
@@ -41,6 +42,20 @@ var _ = Describe("Prysm validator client", func() {
 		Expect(client.Env()).To(BeNil())
 	})
 
+	It("Should get correct slashing protection commands", func() {
+		protector := client.(SlashingProtector)
+		Expect(protector.ImportSlashingProtection("/tmp/interchange.json")).To(ContainElements(
+			PrysmSlashingProtectionHistory,
+			"import",
+			fmt.Sprintf("%s=/tmp/interchange.json", PrysmSlashingProtectionJSONFile),
+		))
+		Expect(protector.ExportSlashingProtection("/tmp")).To(ContainElements(
+			PrysmSlashingProtectionHistory,
+			"export",
+			fmt.Sprintf("%s=/tmp", PrysmSlashingProtectionExportDir),
+		))
+	})
+
 	It("Should get correct home dir", func() {
 		Expect(client.HomeDir()).To(Equal(PrysmHomeDir))
 	})
@@ -67,8 +82,94 @@ var _ = Describe("Prysm validator client", func() {
 			fmt.Sprintf("%s/cert/tls.crt", shared.PathSecrets(client.HomeDir())),
 			PrysmFeeRecipient,
 			"0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			PrysmEnableDoppelganger,
+		}))
+
+	})
+
+})
+
+var _ = Describe("Prysm validator client with block builder", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.PrysmClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:9988"},
+			Builder: &ethereum2v1alpha1.ValidatorBuilder{
+				GasLimit: 36000000,
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			PrysmEnableBuilder,
+			PrysmSuggestedGasLimit,
+			"36000000",
+		}))
+	})
+
+})
+
+var _ = Describe("Prysm validator client with proposer config", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:               ethereum2v1alpha1.PrysmClient,
+			Network:              "mainnet",
+			BeaconEndpoints:      []string{"http://localhost:9988"},
+			FeeRecipient:         "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			WalletPasswordSecret: "my-wallet-password",
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{
+					SecretName:   "my-validator",
+					FeeRecipient: "0x71c7656ec7ab88b098defb751b7401b5f6d8976f",
+				},
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			PrysmProposerSettingsFile,
+			shared.PathConfig(client.HomeDir()) + "/" + ProposerConfigFile,
 		}))
+		Expect(client.Args()).NotTo(ContainElement(PrysmFeeRecipient))
+	})
+
+})
 
+var _ = Describe("Prysm validator client on custom network", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:  ethereum2v1alpha1.PrysmClient,
+			Network: "devnet",
+			Genesis: &ethereum2v1alpha1.ValidatorGenesis{
+				ConfigMapName: "devnet-genesis",
+			},
+			BeaconEndpoints: []string{"http://localhost:9988"},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		args := client.Args()
+
+		Expect(args).To(ContainElements([]string{
+			PrysmChainConfigFile,
+			fmt.Sprintf("%s/%s", GenesisDir(client.HomeDir()), GenesisConfigFile),
+		}))
+		Expect(args).NotTo(ContainElement("--devnet"))
 	})
 
 })
@@ -0,0 +1,101 @@
+package ethereum2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RemoteSignerAPIClient is Web3Signer eth2 signing REST API client used to sign voluntary exits
+type RemoteSignerAPIClient interface {
+	// SignVoluntaryExit returns signature of voluntary exit signed by validator key with the given public key
+	SignVoluntaryExit(ctx context.Context, publicKey string, exit *VoluntaryExit, fork *ForkInfo, signingRoot string) (string, error)
+}
+
+// remoteSignerAPIClient calls Web3Signer eth2 signing REST API
+type remoteSignerAPIClient struct {
+	url string
+}
+
+// NewRemoteSignerAPIClient returns Web3Signer REST API client
+// url is the remote signer server url
+func NewRemoteSignerAPIClient(url string) RemoteSignerAPIClient {
+	return &remoteSignerAPIClient{url: strings.TrimSuffix(url, "/")}
+}
+
+// signingFork is signing request fork
+type signingFork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// signingForkInfo is signing request fork info
+type signingForkInfo struct {
+	Fork                  signingFork `json:"fork"`
+	GenesisValidatorsRoot string      `json:"genesis_validators_root"`
+}
+
+// voluntaryExitSigningRequest is Web3Signer voluntary exit signing request
+type voluntaryExitSigningRequest struct {
+	Type          string          `json:"type"`
+	ForkInfo      signingForkInfo `json:"fork_info"`
+	SigningRoot   string          `json:"signingRoot,omitempty"`
+	VoluntaryExit *VoluntaryExit  `json:"voluntary_exit"`
+}
+
+// SignVoluntaryExit returns signature of voluntary exit signed by validator key with the given public key
+// voluntary exits are signed using capella fork as per EIP-7044
+func (c *remoteSignerAPIClient) SignVoluntaryExit(ctx context.Context, publicKey string, exit *VoluntaryExit, fork *ForkInfo, signingRoot string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, beaconAPITimeout)
+	defer cancel()
+
+	request := voluntaryExitSigningRequest{
+		Type: "VOLUNTARY_EXIT",
+		ForkInfo: signingForkInfo{
+			Fork: signingFork{
+				PreviousVersion: fork.CapellaForkVersion,
+				CurrentVersion:  fork.CapellaForkVersion,
+				Epoch:           "0",
+			},
+			GenesisValidatorsRoot: fork.GenesisValidatorsRoot,
+		},
+		SigningRoot:   signingRoot,
+		VoluntaryExit: exit,
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	path := fmt.Sprintf("/api/v1/eth2/sign/%s", publicKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("POST %s failed with status %s", path, resp.Status)
+	}
+
+	var response struct {
+		Signature string `json:"signature"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return "", err
+	}
+
+	return response.Signature, nil
+}
@@ -0,0 +1,56 @@
+package ethereum2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Remote signer API client", func() {
+
+	publicKey := "0x83dee0bd09cfb0d8e5c73b3bb66bc5a6d0d7cc69d5fbfbd0b1bc7ad5a3b3a5b1eb9c8f5f7b3d8b4bfc1b0f0bf3b2a1c0d"
+	fork := &ForkInfo{
+		GenesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+		CapellaForkVersion:    "0x03000000",
+	}
+	exit := &VoluntaryExit{Epoch: "194048", ValidatorIndex: "123"}
+
+	It("should sign voluntary exit", func() {
+		var path string
+		var request voluntaryExitSigningRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			json.NewDecoder(r.Body).Decode(&request)
+			json.NewEncoder(w).Encode(map[string]string{"signature": "0xb3ba"})
+		}))
+		defer server.Close()
+
+		client := NewRemoteSignerAPIClient(server.URL)
+		signature, err := client.SignVoluntaryExit(context.Background(), publicKey, exit, fork, "0x1234")
+		Expect(err).To(BeNil())
+		Expect(signature).To(Equal("0xb3ba"))
+		Expect(path).To(Equal("/api/v1/eth2/sign/" + publicKey))
+		Expect(request.Type).To(Equal("VOLUNTARY_EXIT"))
+		Expect(request.SigningRoot).To(Equal("0x1234"))
+		Expect(request.VoluntaryExit).To(Equal(exit))
+		Expect(request.ForkInfo.GenesisValidatorsRoot).To(Equal(fork.GenesisValidatorsRoot))
+		Expect(request.ForkInfo.Fork.PreviousVersion).To(Equal("0x03000000"))
+		Expect(request.ForkInfo.Fork.CurrentVersion).To(Equal("0x03000000"))
+	})
+
+	It("should report remote signer errors", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewRemoteSignerAPIClient(server.URL)
+		_, err := client.SignVoluntaryExit(context.Background(), publicKey, exit, fork, "")
+		Expect(err).To(MatchError("POST /api/v1/eth2/sign/" + publicKey + " failed with status 404 Not Found"))
+	})
+
+})
@@ -27,7 +27,18 @@ func (t *TekuBeaconNode) Args() (args []string) {
 
 	args = append(args, TekuDataPath, shared.PathData(t.HomeDir()))
 
-	args = append(args, TekuNetwork, node.Spec.Network)
+	// custom network is loaded from genesis chain config and state files
+	if node.Spec.Genesis != nil {
+		args = append(args, TekuNetwork, fmt.Sprintf("%s/%s", shared.PathConfig(t.HomeDir()), GenesisConfigFile))
+		if node.Spec.CheckpointSyncURL == "" {
+			args = append(args, TekuInitialState, fmt.Sprintf("%s/%s", shared.PathConfig(t.HomeDir()), GenesisStateFile))
+		}
+		if len(node.Spec.Genesis.Bootnodes) != 0 {
+			args = append(args, TekuP2PDiscoveryBootnodes, strings.Join(node.Spec.Genesis.Bootnodes, ","))
+		}
+	} else {
+		args = append(args, TekuNetwork, node.Spec.Network)
+	}
 
 	args = append(args, TekuLogging, strings.ToUpper(string(node.Spec.Logging)))
 
@@ -42,14 +53,19 @@ func (t *TekuBeaconNode) Args() (args []string) {
 		args = append(args, TekuRestEnabled)
 		args = append(args, TekuRESTAPICorsOrigins, strings.Join(node.Spec.CORSDomains, ","))
 		args = append(args, TekuRESTAPIHostAllowlist, strings.Join(node.Spec.Hosts, ","))
-		args = append(args, TekuRestPort, fmt.Sprintf("%d", node.Spec.RESTPort))
-		args = append(args, TekuRestHost, shared.Host(node.Spec.REST))
+		restHost, restPort := restServer(node)
+		args = append(args, TekuRestPort, fmt.Sprintf("%d", restPort))
+		args = append(args, TekuRestHost, restHost)
 	}
 
 	if node.Spec.CheckpointSyncURL != "" {
 		args = append(args, TekuInitialState, node.Spec.CheckpointSyncURL)
 	}
 
+	if node.Spec.Builder != nil {
+		args = append(args, TekuBuilderEndpoint, node.Spec.Builder.Endpoint)
+	}
+
 	args = append(args, TekuP2PPort, fmt.Sprintf("%d", node.Spec.P2PPort))
 
 	return
@@ -34,7 +34,12 @@ func (t *TekuValidatorClient) Args() (args []string) {
 
 	args = append(args, TekuDataPath, shared.PathData(t.HomeDir()))
 
-	args = append(args, TekuNetwork, "auto")
+	// custom network is loaded from genesis chain config file, public networks are fetched from beacon node
+	if validator.Spec.Genesis != nil {
+		args = append(args, TekuNetwork, fmt.Sprintf("%s/%s", GenesisDir(t.HomeDir()), GenesisConfigFile))
+	} else {
+		args = append(args, TekuNetwork, "auto")
+	}
 
 	args = append(args, TekuValidatorsKeystoreLockingEnabled, "false")
 
@@ -48,6 +53,26 @@ func (t *TekuValidatorClient) Args() (args []string) {
 		args = append(args, TekuGraffiti, validator.Spec.Graffiti)
 	}
 
+	if validator.Spec.Builder != nil {
+		args = append(args, TekuBuilderRegistrationEnabled, "true")
+		args = append(args, TekuBuilderRegistrationGasLimit, fmt.Sprintf("%d", validator.Spec.Builder.GasLimit))
+	}
+
+	if validator.Spec.DoppelgangerProtection {
+		args = append(args, TekuDoppelgangerDetectionEnabled, "true")
+	}
+
+	if ProposerConfigured(validator) {
+		args = append(args, TekuProposerConfig, fmt.Sprintf("%s/%s", shared.PathConfig(t.HomeDir()), ProposerConfigFile))
+	}
+
+	// validator keys are signed for by remote signer
+	if validator.Spec.RemoteSigner != "" {
+		args = append(args, TekuExternalSignerURL, validator.Status.RemoteSignerURL)
+		args = append(args, TekuExternalSignerPublicKeys, strings.Join(validator.Status.PublicKeys, ","))
+		return args
+	}
+
 	keyPass := []string{}
 	for i, keystore := range validator.Spec.Keystores {
 		path := fmt.Sprintf("%s/validator-keys/%s", shared.PathSecrets(t.HomeDir()), keystore.SecretName)
@@ -59,6 +84,28 @@ func (t *TekuValidatorClient) Args() (args []string) {
 	return args
 }
 
+// ImportSlashingProtection returns command importing slashing protection interchange file
+func (t *TekuValidatorClient) ImportSlashingProtection(file string) []string {
+	return []string{
+		fmt.Sprintf("%s/bin/teku", t.HomeDir()),
+		TekuSlashingProtection,
+		"import",
+		argWithVal(TekuDataPath, shared.PathData(t.HomeDir())),
+		argWithVal(TekuSlashingProtectionFrom, file),
+	}
+}
+
+// ExportSlashingProtection returns command exporting slashing protection interchange into dir
+func (t *TekuValidatorClient) ExportSlashingProtection(dir string) []string {
+	return []string{
+		fmt.Sprintf("%s/bin/teku", t.HomeDir()),
+		TekuSlashingProtection,
+		"export",
+		argWithVal(TekuDataPath, shared.PathData(t.HomeDir())),
+		argWithVal(TekuSlashingProtectionTo, fmt.Sprintf("%s/%s", dir, SlashingProtectionFile)),
+	}
+}
+
 // Command returns command for running the client
 func (t *TekuValidatorClient) Command() (command []string) {
 	return
@@ -13,11 +13,12 @@ var _ = Describe("Teku Ethereum 2.0 validator client arguments", func() {
 
 	validator := &ethereum2v1alpha1.Validator{
 		Spec: ethereum2v1alpha1.ValidatorSpec{
-			Client:          ethereum2v1alpha1.TekuClient,
-			Network:         "mainnet",
-			BeaconEndpoints: []string{"http://localhost:9988"},
-			Graffiti:        "Validated by Kotal",
-			FeeRecipient:    "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			Client:                 ethereum2v1alpha1.TekuClient,
+			Network:                "mainnet",
+			BeaconEndpoints:        []string{"http://localhost:9988"},
+			Graffiti:               "Validated by Kotal",
+			FeeRecipient:           "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			DoppelgangerProtection: true,
 			Keystores: []ethereum2v1alpha1.Keystore{
 				{
 					SecretName: "my-validator",
@@ -37,6 +38,20 @@ var _ = Describe("Teku Ethereum 2.0 validator client arguments", func() {
 		Expect(client.Env()).To(BeNil())
 	})
 
+	It("Should get correct slashing protection commands", func() {
+		protector := client.(SlashingProtector)
+		Expect(protector.ImportSlashingProtection("/tmp/interchange.json")).To(Equal([]string{
+			"/opt/teku/bin/teku",
+			TekuSlashingProtection,
+			"import",
+			fmt.Sprintf("%s=%s", TekuDataPath, shared.PathData(client.HomeDir())),
+			fmt.Sprintf("%s=/tmp/interchange.json", TekuSlashingProtectionFrom),
+		}))
+		Expect(protector.ExportSlashingProtection("/tmp")).To(ContainElement(
+			fmt.Sprintf("%s=/tmp/%s", TekuSlashingProtectionTo, SlashingProtectionFile),
+		))
+	})
+
 	It("Should get correct home dir", func() {
 		Expect(client.HomeDir()).To(Equal(TekuHomeDir))
 	})
@@ -62,8 +77,126 @@ var _ = Describe("Teku Ethereum 2.0 validator client arguments", func() {
 			),
 			TekuFeeRecipient,
 			"0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			TekuDoppelgangerDetectionEnabled,
+			"true",
+		}))
+
+	})
+
+})
+
+var _ = Describe("Teku Ethereum 2.0 validator client with remote signer arguments", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.TekuClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:9988"},
+			RemoteSigner:    "web3signer",
+		},
+		Status: ethereum2v1alpha1.ValidatorStatus{
+			RemoteSignerURL: "http://web3signer.default.svc:9000",
+			PublicKeys: []string{
+				"0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+				"0x93bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		args := client.Args()
+
+		Expect(args).To(ContainElements([]string{
+			TekuExternalSignerURL,
+			"http://web3signer.default.svc:9000",
+			TekuExternalSignerPublicKeys,
+			"0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8,0x93bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+		}))
+		Expect(args).NotTo(ContainElement(TekuValidatorKeys))
+	})
+
+})
+
+var _ = Describe("Teku Ethereum 2.0 validator client with block builder arguments", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.TekuClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:9988"},
+			Builder: &ethereum2v1alpha1.ValidatorBuilder{
+				GasLimit: 36000000,
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			TekuBuilderRegistrationEnabled,
+			"true",
+			TekuBuilderRegistrationGasLimit,
+			"36000000",
 		}))
+	})
 
+})
+
+var _ = Describe("Teku Ethereum 2.0 validator client with proposer config arguments", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:          ethereum2v1alpha1.TekuClient,
+			Network:         "mainnet",
+			BeaconEndpoints: []string{"http://localhost:9988"},
+			FeeRecipient:    "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{
+					SecretName:   "my-validator",
+					FeeRecipient: "0x71c7656ec7ab88b098defb751b7401b5f6d8976f",
+				},
+			},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			TekuProposerConfig,
+			shared.PathConfig(client.HomeDir()) + "/" + ProposerConfigFile,
+		}))
+	})
+
+})
+
+var _ = Describe("Teku Ethereum 2.0 validator client on custom network arguments", func() {
+
+	validator := &ethereum2v1alpha1.Validator{
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Client:  ethereum2v1alpha1.TekuClient,
+			Network: "devnet",
+			Genesis: &ethereum2v1alpha1.ValidatorGenesis{
+				ConfigMapName: "devnet-genesis",
+			},
+			BeaconEndpoints: []string{"http://localhost:9988"},
+		},
+	}
+
+	validator.Default()
+	client, _ := NewClient(validator)
+
+	It("Should generate correct client arguments", func() {
+		Expect(client.Args()).To(ContainElements([]string{
+			TekuNetwork,
+			fmt.Sprintf("%s/%s", GenesisDir(client.HomeDir()), GenesisConfigFile),
+		}))
 	})
 
 })
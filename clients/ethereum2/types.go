@@ -10,6 +10,28 @@ const (
 	NimbusHomeDir = "/home/nimbus"
 	// LighthouseHomeDir is lighthouse home directory
 	LighthouseHomeDir = "/home/lighthouse"
+	// LodestarHomeDir is lodestar home directory
+	LodestarHomeDir = "/home/lodestar"
+	// Web3SignerHomeDir is web3signer home directory
+	Web3SignerHomeDir = "/opt/web3signer"
+	// MEVBoostHomeDir is mev-boost home directory
+	MEVBoostHomeDir = "/app"
+)
+
+// SlashingProtectionFile is exported slashing protection interchange file name
+const SlashingProtectionFile = "slashing_protection.json"
+
+// ProposerConfigFile is per validator key proposer config file name in config directory
+const ProposerConfigFile = "proposer_config.json"
+
+// Custom network genesis file names in config directory
+const (
+	// GenesisConfigFile is custom network chain config file name
+	GenesisConfigFile = "config.yaml"
+	// GenesisStateFile is custom network genesis state file name
+	GenesisStateFile = "genesis.ssz"
+	// GenesisDeployBlockFile is custom network deposit contract deployment block file name
+	GenesisDeployBlockFile = "deploy_block.txt"
 )
 
 // Teku client arguments
@@ -40,6 +62,8 @@ const (
 	TekuLogging = "--logging"
 	// TekuInitialState is the argument used for checkpoint sync
 	TekuInitialState = "--initial-state"
+	// TekuP2PDiscoveryBootnodes is the argument used to set bootnodes ENR records
+	TekuP2PDiscoveryBootnodes = "--p2p-discovery-bootnodes"
 	// TekuVC is the argument used to run validator client
 	TekuVC = "vc"
 	// TekuBeaconNodeEndpoint is the argument used for beacon node api endpoint
@@ -50,6 +74,26 @@ const (
 	TekuValidatorKeys = "--validator-keys"
 	// TekuValidatorsKeystoreLockingEnabled is the argument used to enable keystore locking files
 	TekuValidatorsKeystoreLockingEnabled = "--validators-keystore-locking-enabled"
+	// TekuExternalSignerURL is the argument used for remote signer url
+	TekuExternalSignerURL = "--validators-external-signer-url"
+	// TekuExternalSignerPublicKeys is the argument used for public keys signed by remote signer
+	TekuExternalSignerPublicKeys = "--validators-external-signer-public-keys"
+	// TekuSlashingProtection is the command used to import and export slashing protection interchange
+	TekuSlashingProtection = "slashing-protection"
+	// TekuSlashingProtectionFrom is the argument used to locate imported slashing protection interchange
+	TekuSlashingProtectionFrom = "--from"
+	// TekuSlashingProtectionTo is the argument used to locate exported slashing protection interchange
+	TekuSlashingProtectionTo = "--to"
+	// TekuBuilderEndpoint is the argument used for external block builder endpoint
+	TekuBuilderEndpoint = "--builder-endpoint"
+	// TekuBuilderRegistrationEnabled is the argument used to register validators with block builders
+	TekuBuilderRegistrationEnabled = "--validators-builder-registration-default-enabled"
+	// TekuBuilderRegistrationGasLimit is the argument used for gas limit registered with block builders
+	TekuBuilderRegistrationGasLimit = "--validators-builder-registration-default-gas-limit"
+	// TekuProposerConfig is the argument used to locate per validator key proposer config
+	TekuProposerConfig = "--validators-proposer-config"
+	// TekuDoppelgangerDetectionEnabled is the argument used to enable doppelganger detection
+	TekuDoppelgangerDetectionEnabled = "--doppelganger-detection-enabled"
 )
 
 // Prysm client arguments
@@ -66,6 +110,12 @@ const (
 	PrysmCheckpointSyncUrl = "--checkpoint-sync-url"
 	// PrysmGenesisBeaconApiUrl is the argument used to set genesis beacon node api url
 	PrysmGenesisBeaconApiUrl = "--genesis-beacon-api-url"
+	// PrysmChainConfigFile is the argument used to locate custom network chain config file
+	PrysmChainConfigFile = "--chain-config-file"
+	// PrysmGenesisState is the argument used to locate custom network genesis state file
+	PrysmGenesisState = "--genesis-state"
+	// PrysmBootstrapNode is the argument used to set bootnode ENR record
+	PrysmBootstrapNode = "--bootstrap-node"
 	// PrysmAcceptTermsOfUse is the argument used for accepting terms of use
 	PrysmAcceptTermsOfUse = "--accept-terms-of-use"
 	// PrysmRPCPort is the argument used for RPC server port
@@ -104,6 +154,26 @@ const (
 	PrysmAccountPasswordFile = "--account-password-file"
 	// PrysmWalletPasswordFile is the argument used to locate wallet password file
 	PrysmWalletPasswordFile = "--wallet-password-file"
+	// PrysmExternalSignerURL is the argument used for remote signer url
+	PrysmExternalSignerURL = "--validators-external-signer-url"
+	// PrysmExternalSignerPublicKeys is the argument used for public keys signed by remote signer
+	PrysmExternalSignerPublicKeys = "--validators-external-signer-public-keys"
+	// PrysmSlashingProtectionHistory is the command used to import and export slashing protection interchange
+	PrysmSlashingProtectionHistory = "slashing-protection-history"
+	// PrysmSlashingProtectionJSONFile is the argument used to locate imported slashing protection interchange
+	PrysmSlashingProtectionJSONFile = "--slashing-protection-json-file"
+	// PrysmSlashingProtectionExportDir is the argument used to locate exported slashing protection interchange directory
+	PrysmSlashingProtectionExportDir = "--slashing-protection-export-dir"
+	// PrysmHTTPMEVRelay is the argument used for external block builder endpoint
+	PrysmHTTPMEVRelay = "--http-mev-relay"
+	// PrysmEnableBuilder is the argument used to enable external block builder proposals
+	PrysmEnableBuilder = "--enable-builder"
+	// PrysmSuggestedGasLimit is the argument used for gas limit registered with block builders
+	PrysmSuggestedGasLimit = "--suggested-gas-limit"
+	// PrysmProposerSettingsFile is the argument used to locate per validator key proposer settings
+	PrysmProposerSettingsFile = "--proposer-settings-file"
+	// PrysmEnableDoppelganger is the argument used to enable doppelganger protection
+	PrysmEnableDoppelganger = "--enable-doppelganger"
 )
 
 // Lighthouse client arguments
@@ -149,6 +219,20 @@ const (
 	LighthouseKeystore = "--keystore"
 	// LighthousePasswordFile is the argument used to locate password file
 	LighthousePasswordFile = "--password-file"
+	// LighthouseSlashingProtection is the command used to import and export slashing protection interchange
+	LighthouseSlashingProtection = "slashing-protection"
+	// LighthouseBuilder is the argument used for external block builder endpoint
+	LighthouseBuilder = "--builder"
+	// LighthouseBuilderProposals is the argument used to enable external block builder proposals
+	LighthouseBuilderProposals = "--builder-proposals"
+	// LighthouseGasLimit is the argument used for gas limit registered with block builders
+	LighthouseGasLimit = "--gas-limit"
+	// LighthouseTestnetDir is the argument used to locate custom network genesis directory
+	LighthouseTestnetDir = "--testnet-dir"
+	// LighthouseBootNodes is the argument used to set bootnodes ENR records
+	LighthouseBootNodes = "--boot-nodes"
+	// LighthouseEnableDoppelgangerProtection is the argument used to enable doppelganger protection
+	LighthouseEnableDoppelgangerProtection = "--enable-doppelganger-protection"
 )
 
 // Nimbus client arguments
@@ -187,4 +271,122 @@ const (
 	NimbusSecretsDir = "--secrets-dir"
 	// NimbusBeaconNodes is the argument used to set one or more beacon node HTTP REST APIs
 	NimbusBeaconNodes = "--beacon-node"
+	// NimbusWeb3SignerURL is the argument used for remote signer url
+	NimbusWeb3SignerURL = "--web3-signer-url"
+	// NimbusSlashingDB is the command used to import and export slashing protection interchange
+	NimbusSlashingDB = "slashingdb"
+	// NimbusPayloadBuilder is the argument used to enable external block builder
+	NimbusPayloadBuilder = "--payload-builder"
+	// NimbusPayloadBuilderURL is the argument used for external block builder endpoint
+	NimbusPayloadBuilderURL = "--payload-builder-url"
+	// NimbusSuggestedGasLimit is the argument used for gas limit registered with block builders
+	NimbusSuggestedGasLimit = "--suggested-gas-limit"
+	// NimbusBootstrapNode is the argument used to set bootnode ENR record
+	NimbusBootstrapNode = "--bootstrap-node"
+	// NimbusDoppelgangerDetection is the argument used to enable doppelganger detection
+	NimbusDoppelgangerDetection = "--doppelganger-detection"
+)
+
+// Lodestar client arguments
+const (
+	// LodestarCLI is lodestar command line interface script in lodestar image
+	LodestarCLI = "/usr/app/packages/cli/bin/lodestar"
+	// LodestarDataDir is the argument used for data directory
+	LodestarDataDir = "--dataDir"
+	// LodestarNetwork is the argument used for selecting network
+	LodestarNetwork = "--network"
+	// LodestarParamsFile is the argument used to locate custom network chain config file
+	LodestarParamsFile = "--paramsFile"
+	// LodestarGenesisStateFile is the argument used to locate custom network genesis state file
+	LodestarGenesisStateFile = "--genesisStateFile"
+	// LodestarBootnodes is the argument used to set bootnodes ENR records
+	LodestarBootnodes = "--bootnodes"
+	// LodestarLogLevel is the argument used to set logging verbosity level
+	LodestarLogLevel = "--logLevel"
+	// LodestarExecutionEngineEndpoint is the argument used for Execution engine endpoint
+	LodestarExecutionEngineEndpoint = "--execution.urls"
+	// LodestarJwtSecretFile is the argument used to locate jwt secret file
+	LodestarJwtSecretFile = "--jwt-secret"
+	// LodestarFeeRecipient is the argument used to set fee recipient
+	LodestarFeeRecipient = "--suggestedFeeRecipient"
+	// LodestarCheckpointSyncUrl is the argument used for checkpoint sync
+	LodestarCheckpointSyncUrl = "--checkpointSyncUrl"
+	// LodestarREST is the argument used to enable Beacon REST API
+	LodestarREST = "--rest"
+	// LodestarRESTAddress is the argument used for Beacon REST API server host
+	LodestarRESTAddress = "--rest.address"
+	// LodestarRESTPort is the argument used for Beacon REST API server port
+	LodestarRESTPort = "--rest.port"
+	// LodestarRESTCors is the argument used to whitelist domains for cross domain requests
+	LodestarRESTCors = "--rest.cors"
+	// LodestarPort is the argument used for p2p tcp port
+	LodestarPort = "--port"
+	// LodestarDiscoveryPort is the argument used for discovery udp port
+	LodestarDiscoveryPort = "--discoveryPort"
+	// LodestarBeaconNodes is the argument used for beacon node endpoints
+	LodestarBeaconNodes = "--beaconNodes"
+	// LodestarGraffiti is the argument used to include in proposed blocks
+	LodestarGraffiti = "--graffiti"
+	// LodestarExternalSignerURL is the argument used for remote signer url
+	LodestarExternalSignerURL = "--externalSigner.url"
+	// LodestarExternalSignerPublicKeys is the argument used for public keys signed by remote signer
+	LodestarExternalSignerPublicKeys = "--externalSigner.pubkeys"
+	// LodestarSlashingProtection is the command used to import and export slashing protection interchange
+	LodestarSlashingProtection = "slashing-protection"
+	// LodestarSlashingProtectionFile is the argument used to locate slashing protection interchange
+	LodestarSlashingProtectionFile = "--file"
+	// LodestarBuilder is the argument used to enable external block builder
+	LodestarBuilder = "--builder"
+	// LodestarBuilderURLs is the argument used for external block builder endpoint
+	LodestarBuilderURLs = "--builder.urls"
+	// LodestarDefaultGasLimit is the argument used for gas limit registered with block builders
+	LodestarDefaultGasLimit = "--defaultGasLimit"
+	// LodestarProposerSettingsFile is the argument used to locate per validator key proposer settings
+	LodestarProposerSettingsFile = "--proposerSettingsFile"
+	// LodestarDoppelgangerProtection is the argument used to enable doppelganger protection
+	LodestarDoppelgangerProtection = "--doppelgangerProtection"
+)
+
+// Web3Signer remote signer arguments
+const (
+	// Web3SignerLogging is the argument used to set logging verbosity level
+	Web3SignerLogging = "--logging"
+	// Web3SignerKeyStorePath is the argument used to locate signing keys configuration files directory
+	Web3SignerKeyStorePath = "--key-store-path"
+	// Web3SignerHTTPListenHost is the argument used for HTTP server host
+	Web3SignerHTTPListenHost = "--http-listen-host"
+	// Web3SignerHTTPListenPort is the argument used for HTTP server port
+	Web3SignerHTTPListenPort = "--http-listen-port"
+	// Web3SignerHTTPHostAllowlist is the argument used to whitelist hosts for API access
+	Web3SignerHTTPHostAllowlist = "--http-host-allowlist"
+	// Web3SignerHTTPCorsOrigins is the argument used to whitelist domains for cross domain requests
+	Web3SignerHTTPCorsOrigins = "--http-cors-origins"
+	// Web3SignerEth2 is the argument used to run eth2 signing mode
+	Web3SignerEth2 = "eth2"
+	// Web3SignerNetwork is the argument used for selecting network
+	Web3SignerNetwork = "--network"
+	// Web3SignerSlashingProtectionDBURL is the argument used to set slashing protection database jdbc url
+	Web3SignerSlashingProtectionDBURL = "--slashing-protection-db-url"
+)
+
+// Web3Signer remote signer environment variables
+const (
+	// Web3SignerSlashingProtectionDBUsername is environment variable used to set slashing protection database username
+	Web3SignerSlashingProtectionDBUsername = "WEB3SIGNER_ETH2_SLASHING_PROTECTION_DB_USERNAME"
+	// Web3SignerSlashingProtectionDBPassword is environment variable used to set slashing protection database password
+	Web3SignerSlashingProtectionDBPassword = "WEB3SIGNER_ETH2_SLASHING_PROTECTION_DB_PASSWORD"
+)
+
+// MEV-Boost arguments
+const (
+	// MEVBoostAddr is the argument used for builder API server listening address
+	MEVBoostAddr = "-addr"
+	// MEVBoostRelays is the argument used for relay urls
+	MEVBoostRelays = "-relays"
+	// MEVBoostMinBid is the argument used for minimum bid in ether accepted from relays
+	MEVBoostMinBid = "-min-bid"
+	// MEVBoostRelayCheck is the argument used to check relays status
+	MEVBoostRelayCheck = "-relay-check"
+	// MEVBoostLogLevel is the argument used to set logging verbosity level
+	MEVBoostLogLevel = "-loglevel"
 )
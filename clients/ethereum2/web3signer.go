@@ -0,0 +1,87 @@
+package ethereum2
+
+import (
+	"fmt"
+	"strings"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	"github.com/kotalco/kotal/controllers/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Web3Signer is ConsenSys remote signer
+// https://github.com/Consensys/web3signer
+type Web3Signer struct {
+	signer *ethereum2v1alpha1.RemoteSigner
+}
+
+// HomeDir returns container home directory
+func (t *Web3Signer) HomeDir() string {
+	return Web3SignerHomeDir
+}
+
+// Env returns environment variables for running the client
+// slashing protection database credentials are loaded from secret
+func (t *Web3Signer) Env() (env []corev1.EnvVar) {
+	secretName := t.signer.Spec.SlashingProtectionDatabaseSecretName
+
+	if secretName == "" {
+		return
+	}
+
+	credentials := []struct {
+		name string
+		key  string
+	}{
+		{Web3SignerSlashingProtectionDBUsername, "username"},
+		{Web3SignerSlashingProtectionDBPassword, "password"},
+	}
+
+	for _, credential := range credentials {
+		env = append(env, corev1.EnvVar{
+			Name: credential.name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Key: credential.key,
+				},
+			},
+		})
+	}
+
+	return
+}
+
+// Args returns command line arguments required for client
+// signing keys configuration files are generated by the controller in config directory
+func (t *Web3Signer) Args() (args []string) {
+
+	signer := t.signer
+
+	args = append(args, Web3SignerLogging, strings.ToUpper(string(signer.Spec.Logging)))
+
+	args = append(args, Web3SignerKeyStorePath, shared.PathConfig(t.HomeDir()))
+
+	args = append(args, Web3SignerHTTPListenHost, shared.Host(true))
+
+	args = append(args, Web3SignerHTTPListenPort, fmt.Sprintf("%d", signer.Spec.Port))
+
+	args = append(args, Web3SignerHTTPHostAllowlist, strings.Join(signer.Spec.Hosts, ","))
+
+	args = append(args, Web3SignerHTTPCorsOrigins, strings.Join(signer.Spec.CORSDomains, ","))
+
+	args = append(args, Web3SignerEth2)
+
+	args = append(args, Web3SignerNetwork, signer.Spec.Network)
+
+	args = append(args, Web3SignerSlashingProtectionDBURL, signer.Spec.SlashingProtectionDatabaseURL)
+
+	return
+}
+
+// Command returns command for running the client
+func (t *Web3Signer) Command() (command []string) {
+	return
+}
@@ -0,0 +1,91 @@
+package ethereum2
+
+import (
+	"strings"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	"github.com/kotalco/kotal/controllers/shared"
+	corev1 "k8s.io/api/core/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Web3Signer remote signer", func() {
+
+	signer := &ethereum2v1alpha1.RemoteSigner{
+		Spec: ethereum2v1alpha1.RemoteSignerSpec{
+			Network:                              "mainnet",
+			Port:                                 9999,
+			Hosts:                                []string{"web3signer.example.com"},
+			CORSDomains:                          []string{"kotal.io"},
+			Logging:                              sharedAPI.DebugLogs,
+			SlashingProtectionDatabaseURL:        "jdbc:postgresql://postgres:5432/web3signer",
+			SlashingProtectionDatabaseSecretName: "postgres-credentials",
+		},
+	}
+
+	signer.Default()
+	client, _ := NewClient(signer)
+
+	It("Should get correct command", func() {
+		Expect(client.Command()).To(BeNil())
+	})
+
+	It("Should get correct env", func() {
+		Expect(client.Env()).To(ContainElements(
+			corev1.EnvVar{
+				Name: Web3SignerSlashingProtectionDBUsername,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "postgres-credentials",
+						},
+						Key: "username",
+					},
+				},
+			},
+			corev1.EnvVar{
+				Name: Web3SignerSlashingProtectionDBPassword,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "postgres-credentials",
+						},
+						Key: "password",
+					},
+				},
+			},
+		))
+	})
+
+	It("Should get correct home dir", func() {
+		Expect(client.HomeDir()).To(Equal(Web3SignerHomeDir))
+	})
+
+	It("Should generate correct client arguments", func() {
+		args := client.Args()
+
+		Expect(args).To(ContainElements([]string{
+			Web3SignerLogging,
+			strings.ToUpper(string(sharedAPI.DebugLogs)),
+			Web3SignerKeyStorePath,
+			shared.PathConfig(client.HomeDir()),
+			Web3SignerHTTPListenHost,
+			"0.0.0.0",
+			Web3SignerHTTPListenPort,
+			"9999",
+			Web3SignerHTTPHostAllowlist,
+			"web3signer.example.com",
+			Web3SignerHTTPCorsOrigins,
+			"kotal.io",
+			Web3SignerEth2,
+			Web3SignerNetwork,
+			"mainnet",
+			Web3SignerSlashingProtectionDBURL,
+			"jdbc:postgresql://postgres:5432/web3signer",
+		}))
+	})
+
+})
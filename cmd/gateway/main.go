@@ -0,0 +1,100 @@
+// gateway is authenticated JSON-RPC and REST gateway running as a sidecar in front of nodes
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kotalco/kotal/gateway"
+)
+
+// routes is repeatable listen=upstream flag
+type routes map[string]string
+
+// String returns routes flag value
+func (r routes) String() string {
+	pairs := []string{}
+	for listen, upstream := range r {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", listen, upstream))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// Set parses listen=upstream route
+func (r routes) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("route %s must be in listen=upstream format", value)
+	}
+	r[parts[0]] = parts[1]
+	return nil
+}
+
+// list splits comma separated list
+func list(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+func main() {
+	var protocol, allow, deny, apiKeysDir, jwtSecret string
+	var rateLimit, rateLimitBurst uint
+	var logRequests bool
+	r := routes{}
+
+	flag.Var(r, "route", "listen address and upstream url in listen=upstream format, can be repeated")
+	flag.StringVar(&protocol, "protocol", string(gateway.JSONRPCProtocol), "upstream API protocol: jsonrpc or rest")
+	flag.StringVar(&allow, "allow", "", "comma separated allowed methods or paths, * suffix matches prefix")
+	flag.StringVar(&deny, "deny", "", "comma separated denied methods or paths, * suffix matches prefix")
+	flag.StringVar(&apiKeysDir, "api-keys-dir", "", "directory of API keys, file name is key owner and content is the key")
+	flag.StringVar(&jwtSecret, "jwt-secret", "", "file holding HS256 JWT tokens signing key")
+	flag.UintVar(&rateLimit, "rate-limit", 0, "requests per second allowed for every caller, 0 is unlimited")
+	flag.UintVar(&rateLimitBurst, "rate-limit-burst", 0, "maximum requests burst for every caller")
+	flag.BoolVar(&logRequests, "log-requests", false, "log every request with caller and methods")
+	flag.Parse()
+
+	if len(r) == 0 {
+		log.Fatal("at least one route is required")
+	}
+
+	auth, err := gateway.NewAuthenticator(apiKeysDir, jwtSecret)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go auth.Watch(30*time.Second, make(chan struct{}))
+
+	g := &gateway.Gateway{
+		Policy: gateway.Policy{
+			Allowed: list(allow),
+			Denied:  list(deny),
+		},
+		Auth:    auth,
+		Limiter: gateway.NewRateLimiter(rateLimit, rateLimitBurst),
+		Logging: logRequests,
+	}
+
+	errs := make(chan error)
+	for listen, upstream := range r {
+		upstreamURL, err := url.Parse(upstream)
+		if err != nil {
+			log.Fatal(err)
+		}
+		handler, err := g.Handler(gateway.Protocol(protocol), upstreamURL)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("proxying %s to %s", listen, upstream)
+		go func(listen string) {
+			errs <- http.ListenAndServe(listen, handler)
+		}(listen)
+	}
+
+	log.Fatal(<-errs)
+}
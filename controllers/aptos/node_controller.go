@@ -2,18 +2,30 @@ package controllers
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
 
 	aptosv1alpha1 "github.com/kotalco/kotal/apis/aptos/v1alpha1"
 	aptosClients "github.com/kotalco/kotal/clients/aptos"
 	"github.com/kotalco/kotal/controllers/shared"
+	"golang.org/x/crypto/curve25519"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // NodeReconciler reconciles a Node object
@@ -26,6 +38,7 @@ type NodeReconciler struct {
 // +kubebuilder:rbac:groups=aptos.kotal.io,resources=nodes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=watch;get;list;create;update;delete
 // +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=watch;get;create;update;list;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=watch;get;list
 
 func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	var node aptosv1alpha1.Node
@@ -41,6 +54,7 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 	}
 
 	shared.UpdateLabels(&node, "aptos-core")
+	r.updateSeedPeers(ctx, &node)
 
 	if err = r.reconcileConfigmap(ctx, &node); err != nil {
 		return
@@ -58,9 +72,116 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 		return
 	}
 
+	if err = r.updateStatus(ctx, &node); err != nil {
+		return
+	}
+
 	return
 }
 
+// getPeerAddress fetch peer address from Aptos node reference in the format of name or name.namespace
+func (r *NodeReconciler) getPeerAddress(ctx context.Context, ref, ns string) (string, error) {
+	node := &aptosv1alpha1.Node{}
+
+	if err := r.Client.Get(ctx, shared.ParseReference(ref, ns), node); err != nil {
+		return "", err
+	}
+
+	return node.Status.PeerAddress, nil
+}
+
+// updateSeedPeers replaces Aptos node references in seed peers addresses with their peer address
+// references that can't be resolved are removed and reported in status
+func (r *NodeReconciler) updateSeedPeers(ctx context.Context, node *aptosv1alpha1.Node) {
+	log := log.FromContext(ctx)
+
+	seedPeers := []aptosv1alpha1.Peer{}
+	node.Status.UnresolvedReferences = nil
+
+	for _, peer := range node.Spec.SeedPeers {
+		addresses := []string{}
+
+		for _, address := range peer.Addresses {
+			if strings.HasPrefix(address, "/") {
+				addresses = append(addresses, address)
+				continue
+			}
+
+			peerAddress, err := r.getPeerAddress(ctx, address, node.Namespace)
+			if err != nil {
+				// don't return the error, node maybe not created yet
+				// node will be reconciled again once referenced node is created
+				log.Error(err, "failed to get peer address", "reference", address)
+				node.Status.UnresolvedReferences = append(node.Status.UnresolvedReferences, address)
+				continue
+			}
+
+			// referenced node is not up and running yet, or has no node private key
+			if peerAddress == "" {
+				node.Status.UnresolvedReferences = append(node.Status.UnresolvedReferences, address)
+				continue
+			}
+
+			addresses = append(addresses, peerAddress)
+		}
+
+		// seed peer without addresses can't be dialed
+		if len(addresses) == 0 {
+			continue
+		}
+
+		peer.Addresses = addresses
+		seedPeers = append(seedPeers, peer)
+	}
+
+	node.Spec.SeedPeers = seedPeers
+}
+
+// getPeerAddressFromKey returns node peer address from node private key
+func (r *NodeReconciler) getPeerAddressFromKey(ctx context.Context, node *aptosv1alpha1.Node) (string, error) {
+	key := types.NamespacedName{
+		Name:      node.Spec.NodePrivateKeySecretName,
+		Namespace: node.Namespace,
+	}
+
+	nodePrivateKey, err := shared.GetSecret(ctx, r.Client, key, "key")
+	if err != nil {
+		return "", err
+	}
+
+	privateKey, err := hex.DecodeString(nodePrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	publicKey, err := curve25519.X25519(privateKey, curve25519.Basepoint)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("/dns4/%s.%s.svc/tcp/%d/ln-noise-ik/%s/ln-handshake/0", node.Name, node.Namespace, node.Spec.P2PPort, hex.EncodeToString(publicKey)), nil
+}
+
+// updateStatus updates Aptos node status
+func (r *NodeReconciler) updateStatus(ctx context.Context, node *aptosv1alpha1.Node) error {
+	// peer address requires known node public key
+	if node.Spec.NodePrivateKeySecretName != "" {
+		address, err := r.getPeerAddressFromKey(ctx, node)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "unable to get node peer address")
+			return err
+		}
+		node.Status.PeerAddress = address
+	}
+
+	if err := r.Status().Update(ctx, node); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update node status")
+		return err
+	}
+
+	return nil
+}
+
 // specConfigmap updates node configmap
 func (n *NodeReconciler) specConfigmap(node *aptosv1alpha1.Node, configmap *corev1.ConfigMap) {
 	configmap.ObjectMeta.Labels = node.Labels
@@ -315,10 +436,66 @@ func (r *NodeReconciler) specStatefulSet(node *aptosv1alpha1.Node, sts *appsv1.S
 	return nil
 }
 
+// nodeReferences returns namespaced names of nodes referenced by seed peers addresses
+func nodeReferences(obj client.Object) []string {
+	node := obj.(*aptosv1alpha1.Node)
+	references := []string{}
+
+	for _, peer := range node.Spec.SeedPeers {
+		for _, address := range peer.Addresses {
+			if strings.HasPrefix(address, "/") {
+				continue
+			}
+			references = append(references, shared.ParseReference(address, node.Namespace).String())
+		}
+	}
+
+	return references
+}
+
+// referencingNodes returns reconcile requests for nodes referencing the given node
+func (r *NodeReconciler) referencingNodes(obj client.Object) []reconcile.Request {
+	var nodes aptosv1alpha1.NodeList
+
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}.String()
+
+	if err := r.Client.List(context.Background(), &nodes, client.MatchingFields{shared.ReferencesIndexKey: key}); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, node := range nodes.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      node.Name,
+				Namespace: node.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
 func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &aptosv1alpha1.Node{}, shared.ReferencesIndexKey, nodeReferences); err != nil {
+		return err
+	}
+
+	// referenced nodes peer address is reported in status
+	peerAddressPred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.(*aptosv1alpha1.Node).Status.PeerAddress != e.ObjectNew.(*aptosv1alpha1.Node).Status.PeerAddress
+		},
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&aptosv1alpha1.Node{}).
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		Watches(
+			&source.Kind{Type: &aptosv1alpha1.Node{}},
+			handler.EnqueueRequestsFromMapFunc(r.referencingNodes),
+			builder.WithPredicates(peerAddressPred),
+		).
 		Complete(r)
 }
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	aptosv1alpha1 "github.com/kotalco/kotal/apis/aptos/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Aptos node seed peers references", func() {
+
+	const (
+		interval = 2 * time.Second
+		timeout  = 2 * time.Minute
+	)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "aptos-references",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "aptos-node",
+		Namespace: ns.Name,
+	}
+
+	referencedKey := types.NamespacedName{
+		Name:      "aptos-seed",
+		Namespace: ns.Name,
+	}
+
+	toCreate := &aptosv1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: aptosv1alpha1.NodeSpec{
+			Network:              aptosv1alpha1.Devnet,
+			Waypoint:             "0:683185844ef67e5c8eeaa158e635de2a4c574ce7bbb7f41f787d38db2d623ae2",
+			GenesisConfigmapName: "genesis",
+			SeedPeers: []aptosv1alpha1.Peer{
+				{
+					ID:        "b6c7b0e1a1e5e4f5f4f3e2d1c0b9a8978675645342312e1f0e9d8c7b6a5f4e3d",
+					Addresses: []string{referencedKey.Name},
+				},
+			},
+		},
+	}
+
+	referenced := &aptosv1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      referencedKey.Name,
+			Namespace: referencedKey.Namespace,
+		},
+		Spec: aptosv1alpha1.NodeSpec{
+			Network:                  aptosv1alpha1.Devnet,
+			Waypoint:                 "0:683185844ef67e5c8eeaa158e635de2a4c574ce7bbb7f41f787d38db2d623ae2",
+			GenesisConfigmapName:     "genesis",
+			NodePrivateKeySecretName: "seed-key",
+			PeerId:                   "b6c7b0e1a1e5e4f5f4f3e2d1c0b9a8978675645342312e1f0e9d8c7b6a5f4e3d",
+		},
+	}
+
+	// nodeConfig returns node config.yaml from node config map
+	nodeConfig := func() string {
+		configmap := &corev1.ConfigMap{}
+		if err := k8sClient.Get(context.Background(), key, configmap); err != nil {
+			return err.Error()
+		}
+		return configmap.Data["config.yaml"]
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+	})
+
+	It("Should create seed node private key secret", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "seed-key",
+				Namespace: ns.Name,
+			},
+			StringData: map[string]string{
+				"key": "a8c9f5d8c1b7e3f2a4d6b8c0e2f4a6b8c0d2e4f6a8b0c2d4e6f8a0b2c4d6e8f0",
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+	})
+
+	It("Should create node referencing a node that doesn't exist yet", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			toCreate.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+		time.Sleep(5 * time.Second)
+	})
+
+	It("Should report unresolved seed peer address reference in status", func() {
+		fetched := &aptosv1alpha1.Node{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.UnresolvedReferences).To(ConsistOf(referencedKey.Name))
+		Expect(nodeConfig()).NotTo(ContainSubstring("ln-noise-ik"))
+	})
+
+	It("Should create the referenced node", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			referenced.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), referenced)).Should(Succeed())
+	})
+
+	It("Should resolve seed peer address reference once referenced node peer address is reported", func() {
+		Eventually(func() []string {
+			fetched := &aptosv1alpha1.Node{}
+			if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+				return []string{err.Error()}
+			}
+			return fetched.Status.UnresolvedReferences
+		}, timeout, interval).Should(BeEmpty())
+
+		fetched := &aptosv1alpha1.Node{}
+		Expect(k8sClient.Get(context.Background(), referencedKey, fetched)).To(Succeed())
+		Expect(fetched.Status.PeerAddress).To(HavePrefix(fmt.Sprintf("/dns4/%s.%s.svc/tcp/%d/ln-noise-ik/", referencedKey.Name, referencedKey.Namespace, fetched.Spec.P2PPort)))
+
+		Eventually(nodeConfig, timeout, interval).Should(ContainSubstring(fetched.Status.PeerAddress))
+	})
+
+	It("Should re-reconcile node when referenced node peer address changes", func() {
+		fetched := &aptosv1alpha1.Node{}
+		Expect(k8sClient.Get(context.Background(), referencedKey, fetched)).To(Succeed())
+		fetched.Spec.P2PPort = 7182
+		Expect(k8sClient.Update(context.Background(), fetched)).To(Succeed())
+
+		Eventually(nodeConfig, timeout, interval).Should(ContainSubstring(fmt.Sprintf("/dns4/%s.%s.svc/tcp/7182/ln-noise-ik/", referencedKey.Name, referencedKey.Namespace)))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
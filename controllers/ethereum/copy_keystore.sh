@@ -0,0 +1,18 @@
+#!/bin/sh
+
+set -e
+
+mkdir -p $DATA_PATH/keystore
+
+for keyfile in $SECRETS_PATH/keystore/*
+do
+	address=$(basename $keyfile)
+	address=${address#key-}
+	if ls $DATA_PATH/keystore | grep -qi "$address"
+	then
+		echo "account $address has been imported before!"
+	else
+		echo "importing account $address"
+		cp $keyfile $DATA_PATH/keystore/key-$address
+	fi
+done
@@ -1,11 +1,19 @@
 package controllers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
 )
 
 // KeyStoreFromPrivateKey generates key store from private key (hex without 0x)
@@ -31,3 +39,31 @@ func KeyStoreFromPrivateKey(key, password string) (content []byte, err error) {
 
 	return
 }
+
+// KeyStoreAddress returns checksummed account address of V3 keystore
+func KeyStoreAddress(content []byte) (string, error) {
+	ks := struct {
+		Address string `json:"address"`
+	}{}
+
+	if err := json.Unmarshal(content, &ks); err != nil {
+		return "", fmt.Errorf("invalid keystore: %w", err)
+	}
+
+	if !common.IsHexAddress(ks.Address) {
+		return "", errors.New("invalid keystore: missing account address")
+	}
+
+	return common.HexToAddress(ks.Address).Hex(), nil
+}
+
+// keystoreSecretKey returns node secret key holding keystore of the given account
+func keystoreSecretKey(address sharedAPI.EthereumAddress) string {
+	return fmt.Sprintf("key-%s", strings.ToLower(strings.TrimPrefix(string(address), "0x")))
+}
+
+// keystoreChecksum returns checksum of private key and password used to encrypt keystore
+func keystoreChecksum(key, password string) string {
+	sum := sha256.Sum256([]byte(key + ":" + password))
+	return hex.EncodeToString(sum[:])
+}
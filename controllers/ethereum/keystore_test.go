@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ethereum account keystore", func() {
+
+	const (
+		// WARNING: DON'T use the following private key in production
+		key      = "5df5eff7ef9e4e82739b68a34c6b23608d79ee8daf3b598a01ffb0dd7aa3a2fd"
+		address  = sharedAPI.EthereumAddress("0x2b3430337f12Ce89EaBC7b0d865F4253c7744c0d")
+		password = "secret"
+	)
+
+	It("should generate keystore of the account private key", func() {
+		keystore, err := KeyStoreFromPrivateKey(key, password)
+		Expect(err).To(BeNil())
+		Expect(KeyStoreAddress(keystore)).To(Equal(string(address)))
+	})
+
+	It("should reject keystore without account address", func() {
+		_, err := KeyStoreAddress([]byte(`{"version": 3}`))
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should name keystore secret key after account address", func() {
+		Expect(keystoreSecretKey(address)).To(Equal("key-2b3430337f12ce89eabc7b0d865f4253c7744c0d"))
+	})
+
+	It("should change keystore checksum if password changes", func() {
+		Expect(keystoreChecksum(key, password)).To(Equal(keystoreChecksum(key, password)))
+		Expect(keystoreChecksum(key, password)).NotTo(Equal(keystoreChecksum(key, "another secret")))
+	})
+
+})
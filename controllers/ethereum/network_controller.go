@@ -0,0 +1,511 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	"github.com/kotalco/kotal/controllers/shared"
+	"github.com/kotalco/kotal/helpers"
+)
+
+// NetworkReconciler reconciles a Network object
+type NetworkReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=networks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=networks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=nodes;validatorproposals,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=watch;get;create;update;list;delete
+
+// Reconcile reconciles private networks
+func (r *NetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+
+	var network ethereumv1alpha1.Network
+
+	if err = r.Client.Get(ctx, req.NamespacedName, &network); err != nil {
+		err = client.IgnoreNotFound(err)
+		return
+	}
+
+	// default the network if webhooks are disabled
+	if !shared.IsWebhookEnabled() {
+		network.Default()
+	}
+
+	shared.UpdateLabels(&network, "kotal")
+
+	// genesis signers or validators are fixed once the network is created
+	// it's persisted before creating nodes and proposals, so genesis validators aren't proposed on failures
+	if network.Status.GenesisValidators == 0 {
+		network.Status.GenesisValidators = network.Spec.Validators
+		if err = r.Client.Status().Update(ctx, &network); err != nil {
+			return
+		}
+	}
+
+	// keys of validators at genesis are kept even if network is scaled down
+	keys := network.Spec.Validators
+	if network.Status.GenesisValidators > keys {
+		keys = network.Status.GenesisValidators
+	}
+
+	addresses := []sharedAPI.EthereumAddress{}
+	for i := 0; i < keys; i++ {
+		var address sharedAPI.EthereumAddress
+		if address, err = r.reconcileKeySecret(ctx, &network, i); err != nil {
+			return
+		}
+		addresses = append(addresses, address)
+	}
+
+	genesis := network.NodeGenesis(addresses[:network.Status.GenesisValidators])
+
+	for i := 0; i < network.Spec.Validators; i++ {
+		if err = r.reconcileNode(ctx, &network, i, genesis, addresses[i]); err != nil {
+			return
+		}
+	}
+
+	if err = r.removeNodes(ctx, &network); err != nil {
+		return
+	}
+
+	for i := 0; i < network.Spec.Validators; i++ {
+		if err = r.reconcileAddProposal(ctx, &network, i, addresses[i]); err != nil {
+			return
+		}
+	}
+
+	if err = r.updateStatus(ctx, &network, addresses[:network.Spec.Validators]); err != nil {
+		return
+	}
+
+	return
+}
+
+// nodeName returns network node name at the given index
+func nodeName(network *ethereumv1alpha1.Network, i int) string {
+	return fmt.Sprintf("%s-%d", network.Name, i)
+}
+
+// keySecretName returns name of the secret holding network node key at the given index
+func keySecretName(network *ethereumv1alpha1.Network, i int) string {
+	return fmt.Sprintf("%s-%d-key", network.Name, i)
+}
+
+// proposalName returns name of validator proposal to add or remove network node at the given index
+func proposalName(network *ethereumv1alpha1.Network, i int, action ethereumv1alpha1.ProposalAction) string {
+	return fmt.Sprintf("%s-%s-%d", network.Name, action, i)
+}
+
+// nodeNames returns names of current network nodes
+func nodeNames(network *ethereumv1alpha1.Network) []string {
+	names := []string{}
+	for i := 0; i < network.Spec.Validators; i++ {
+		names = append(names, nodeName(network, i))
+	}
+	return names
+}
+
+// voterNames returns names of network nodes voting on validator proposals
+// only besu nodes enable rpc, geth and nethermind can't enable rpc while importing signer account
+func voterNames(network *ethereumv1alpha1.Network) []string {
+	names := []string{}
+	for i := 0; i < network.Spec.Validators; i++ {
+		if network.Spec.Clients[i%len(network.Spec.Clients)] == ethereumv1alpha1.BesuClient {
+			names = append(names, nodeName(network, i))
+		}
+	}
+	return names
+}
+
+// updateStatus updates network status
+func (r *NetworkReconciler) updateStatus(ctx context.Context, network *ethereumv1alpha1.Network, addresses []sharedAPI.EthereumAddress) error {
+	network.Status.Consensus = string(network.Spec.Consensus)
+	network.Status.Nodes = network.Spec.Validators
+
+	network.Status.Validators = []string{}
+	for _, address := range addresses {
+		network.Status.Validators = append(network.Status.Validators, string(address))
+	}
+
+	ready := 0
+	for _, name := range nodeNames(network) {
+		sts := &appsv1.StatefulSet{}
+		key := types.NamespacedName{Name: name, Namespace: network.Namespace}
+		if err := r.Client.Get(ctx, key, sts); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if sts.Status.ReadyReplicas > 0 {
+			ready++
+		}
+	}
+	network.Status.ReadyNodes = ready
+
+	if err := r.Status().Update(ctx, network); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update network status")
+		return err
+	}
+
+	return nil
+}
+
+// specKeySecret generates node private key and account password if they haven't been generated yet
+func (r *NetworkReconciler) specKeySecret(network *ethereumv1alpha1.Network, secret *corev1.Secret) error {
+	secret.ObjectMeta.Labels = network.GetLabels()
+
+	// don't regenerate keys, they're the network validators identities
+	if len(secret.Data["key"]) != 0 {
+		return nil
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	password := make([]byte, 16)
+	if _, err := rand.Read(password); err != nil {
+		return err
+	}
+
+	secret.Data = map[string][]byte{
+		"key":      []byte(hex.EncodeToString(crypto.FromECDSA(privateKey))),
+		"password": []byte(hex.EncodeToString(password)),
+	}
+
+	return nil
+}
+
+// reconcileKeySecret creates network node key secret and returns the node address
+// the key is used as node private key and signer account private key
+func (r *NetworkReconciler) reconcileKeySecret(ctx context.Context, network *ethereumv1alpha1.Network, i int) (address sharedAPI.EthereumAddress, err error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      keySecretName(network, i),
+			Namespace: network.Namespace,
+		},
+	}
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := ctrl.SetControllerReference(network, secret, r.Scheme); err != nil {
+			return err
+		}
+		return r.specKeySecret(network, secret)
+	})
+
+	if err != nil {
+		return
+	}
+
+	addressHex, err := helpers.DeriveAddress(string(secret.Data["key"]))
+	if err != nil {
+		return
+	}
+
+	address = sharedAPI.EthereumAddress(addressHex)
+
+	return
+}
+
+// specNode updates network node spec
+func (r *NetworkReconciler) specNode(network *ethereumv1alpha1.Network, node *ethereumv1alpha1.Node, i int, genesis *ethereumv1alpha1.Genesis, address sharedAPI.EthereumAddress) {
+	node.ObjectMeta.Labels = network.GetLabels()
+
+	client := network.Spec.Clients[i%len(network.Spec.Clients)]
+	keySecret := keySecretName(network, i)
+
+	node.Spec.Client = client
+	node.Spec.Genesis = genesis.DeepCopy()
+	node.Spec.NodePrivateKeySecretName = keySecret
+	node.Spec.Resources = network.Spec.Resources
+
+	// peers are resolved by name into enode urls
+	node.Spec.StaticNodes = []ethereumv1alpha1.Enode{}
+	for _, name := range nodeNames(network) {
+		if name != node.Name {
+			node.Spec.StaticNodes = append(node.Spec.StaticNodes, ethereumv1alpha1.Enode(name))
+		}
+	}
+
+	switch client {
+	// besu signs blocks using node private key
+	// rpc is enabled to vote on validators added or removed after genesis
+	case ethereumv1alpha1.BesuClient:
+		api := ethereumv1alpha1.CliqueAPI
+		if network.Spec.Consensus == ethereumv1alpha1.IBFT2Consensus {
+			api = ethereumv1alpha1.IBFTAPI
+		}
+		node.Spec.RPC = true
+		node.Spec.RPCAPI = []ethereumv1alpha1.API{
+			ethereumv1alpha1.Web3API,
+			ethereumv1alpha1.ETHAPI,
+			ethereumv1alpha1.NetworkAPI,
+			api,
+		}
+	// other clients sign blocks using imported coinbase account
+	default:
+		node.Spec.Miner = true
+		node.Spec.Coinbase = address
+		node.Spec.Import = &ethereumv1alpha1.ImportedAccount{
+			PrivateKeySecretName: keySecret,
+			PasswordSecretName:   keySecret,
+		}
+	}
+}
+
+// reconcileNode creates network node if it doesn't exist, update it if it exists
+func (r *NetworkReconciler) reconcileNode(ctx context.Context, network *ethereumv1alpha1.Network, i int, genesis *ethereumv1alpha1.Genesis, address sharedAPI.EthereumAddress) error {
+	node := &ethereumv1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodeName(network, i),
+			Namespace: network.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, node, func() error {
+		if err := ctrl.SetControllerReference(network, node, r.Scheme); err != nil {
+			return err
+		}
+		r.specNode(network, node, i, genesis, address)
+		return nil
+	})
+
+	return err
+}
+
+// removeNodes deletes network nodes that have been scaled down, after voting on removing them
+// nodes are removed one at a time starting from the last node, and each node keeps running until
+// the proposal removing it from signers or validators is applied, so remaining validators keep quorum
+func (r *NetworkReconciler) removeNodes(ctx context.Context, network *ethereumv1alpha1.Network) error {
+	var nodes ethereumv1alpha1.NodeList
+
+	if err := r.Client.List(ctx, &nodes, client.InNamespace(network.Namespace)); err != nil {
+		return err
+	}
+
+	removed := map[int]*ethereumv1alpha1.Node{}
+	indices := []int{}
+
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+
+		if !metav1.IsControlledBy(node, network) {
+			continue
+		}
+
+		index, err := strconv.Atoi(strings.TrimPrefix(node.Name, network.Name+"-"))
+		if err != nil || index < network.Spec.Validators {
+			continue
+		}
+
+		removed[index] = node
+		indices = append(indices, index)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(indices)))
+
+	for _, index := range indices {
+		node := removed[index]
+
+		address, err := r.reconcileKeySecret(ctx, network, index)
+		if err != nil {
+			return err
+		}
+
+		if err := r.reconcileProposal(ctx, network, index, ethereumv1alpha1.RemoveValidatorAction, address); err != nil {
+			return err
+		}
+
+		if err := r.deleteProposal(ctx, network, index, ethereumv1alpha1.AddValidatorAction); err != nil {
+			return err
+		}
+
+		// removal can't be voted on without besu nodes
+		if len(voterNames(network)) != 0 {
+			applied, err := r.proposalApplied(ctx, network, index, ethereumv1alpha1.RemoveValidatorAction)
+			if err != nil {
+				return err
+			}
+			// network is reconciled again once proposal phase changes
+			if !applied {
+				log.FromContext(ctx).Info("waiting for validator removal to be applied before deleting node", "node", node.Name)
+				return nil
+			}
+		}
+
+		if err := r.Client.Delete(ctx, node); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// proposalApplied returns true if validator proposal for network node at the given index has been applied on chain
+func (r *NetworkReconciler) proposalApplied(ctx context.Context, network *ethereumv1alpha1.Network, i int, action ethereumv1alpha1.ProposalAction) (bool, error) {
+	proposal := &ethereumv1alpha1.ValidatorProposal{}
+	key := types.NamespacedName{
+		Name:      proposalName(network, i, action),
+		Namespace: network.Namespace,
+	}
+
+	if err := r.Client.Get(ctx, key, proposal); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return proposal.Status.Phase == ethereumv1alpha1.ProposalApplied, nil
+}
+
+// reconcileAddProposal votes on adding validators that are not part of genesis block
+// or validators that have been removed before
+func (r *NetworkReconciler) reconcileAddProposal(ctx context.Context, network *ethereumv1alpha1.Network, i int, address sharedAPI.EthereumAddress) error {
+	removeProposal := &ethereumv1alpha1.ValidatorProposal{}
+	key := types.NamespacedName{
+		Name:      proposalName(network, i, ethereumv1alpha1.RemoveValidatorAction),
+		Namespace: network.Namespace,
+	}
+
+	removed := true
+	if err := r.Client.Get(ctx, key, removeProposal); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		removed = false
+	}
+
+	if i < network.Status.GenesisValidators && !removed {
+		return nil
+	}
+
+	if err := r.reconcileProposal(ctx, network, i, ethereumv1alpha1.AddValidatorAction, address); err != nil {
+		return err
+	}
+
+	return r.deleteProposal(ctx, network, i, ethereumv1alpha1.RemoveValidatorAction)
+}
+
+// reconcileProposal creates validator proposal voted on by current network besu nodes
+func (r *NetworkReconciler) reconcileProposal(ctx context.Context, network *ethereumv1alpha1.Network, i int, action ethereumv1alpha1.ProposalAction, address sharedAPI.EthereumAddress) error {
+	voters := voterNames(network)
+	if len(voters) == 0 {
+		log.FromContext(ctx).Info("no network node can vote on validator proposal", "action", action, "address", address)
+		return nil
+	}
+
+	proposal := &ethereumv1alpha1.ValidatorProposal{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      proposalName(network, i, action),
+			Namespace: network.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, proposal, func() error {
+		if err := ctrl.SetControllerReference(network, proposal, r.Scheme); err != nil {
+			return err
+		}
+		proposal.ObjectMeta.Labels = network.GetLabels()
+		proposal.Spec.Nodes = voters
+		proposal.Spec.Address = address
+		proposal.Spec.Action = action
+		return nil
+	})
+
+	return err
+}
+
+// deleteProposal deletes validator proposal if it exists
+func (r *NetworkReconciler) deleteProposal(ctx context.Context, network *ethereumv1alpha1.Network, i int, action ethereumv1alpha1.ProposalAction) error {
+	proposal := &ethereumv1alpha1.ValidatorProposal{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      proposalName(network, i, action),
+			Namespace: network.Namespace,
+		},
+	}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx, proposal))
+}
+
+// owningNetwork returns reconcile request for the network owning the node of the given statefulset
+func (r *NetworkReconciler) owningNetwork(obj client.Object) []reconcile.Request {
+	node := &ethereumv1alpha1.Node{}
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}
+
+	// node statefulset has the same name as the node
+	if err := r.Client.Get(context.Background(), key, node); err != nil {
+		return nil
+	}
+
+	owner := metav1.GetControllerOf(node)
+	if owner == nil || owner.Kind != "Network" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name:      owner.Name,
+				Namespace: node.Namespace,
+			},
+		},
+	}
+}
+
+// SetupWithManager adds reconciler to the manager
+func (r *NetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	pred := predicate.GenerationChangedPredicate{}
+	// node pods readiness is reported in statefulset status, which doesn't change generation
+	readyPred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.(*appsv1.StatefulSet).Status.ReadyReplicas != e.ObjectNew.(*appsv1.StatefulSet).Status.ReadyReplicas
+		},
+	}
+	// validator proposals phase is reported in status, scaled down nodes are deleted once their removal is applied
+	phasePred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.(*ethereumv1alpha1.ValidatorProposal).Status.Phase != e.ObjectNew.(*ethereumv1alpha1.ValidatorProposal).Status.Phase
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ethereumv1alpha1.Network{}, builder.WithPredicates(pred)).
+		Owns(&ethereumv1alpha1.Node{}, builder.WithPredicates(pred)).
+		Owns(&ethereumv1alpha1.ValidatorProposal{}, builder.WithPredicates(predicate.Or(pred, phasePred))).
+		Owns(&corev1.Secret{}, builder.WithPredicates(pred)).
+		Watches(
+			&source.Kind{Type: &appsv1.StatefulSet{}},
+			handler.EnqueueRequestsFromMapFunc(r.owningNetwork),
+			builder.WithPredicates(readyPred),
+		).
+		Complete(r)
+}
@@ -0,0 +1,267 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	"github.com/kotalco/kotal/helpers"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Ethereum network controller", func() {
+
+	const (
+		interval = 2 * time.Second
+		timeout  = 2 * time.Minute
+	)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "network",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "clique",
+		Namespace: ns.Name,
+	}
+
+	toCreate := &ethereumv1alpha1.Network{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: ethereumv1alpha1.NetworkSpec{
+			Consensus:  ethereumv1alpha1.CliqueConsensus,
+			Validators: 2,
+			Clients: []ethereumv1alpha1.EthereumClient{
+				ethereumv1alpha1.BesuClient,
+				ethereumv1alpha1.GethClient,
+			},
+			Genesis: ethereumv1alpha1.Genesis{
+				ChainID:   7777,
+				NetworkID: 7777,
+			},
+		},
+	}
+
+	t := true
+
+	networkOwnerReference := metav1.OwnerReference{
+		APIVersion:         "ethereum.kotal.io/v1alpha1",
+		Kind:               "Network",
+		Name:               toCreate.Name,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+
+	addresses := []sharedAPI.EthereumAddress{}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+	})
+
+	It("should create network", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			toCreate.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+	})
+
+	It("should get network", func() {
+		fetched := &ethereumv1alpha1.Network{}
+		Expect(k8sClient.Get(context.TODO(), key, fetched)).To(Succeed())
+		Expect(fetched.Spec).To(Equal(toCreate.Spec))
+		networkOwnerReference.UID = fetched.UID
+		time.Sleep(5 * time.Second)
+	})
+
+	It("should generate validators keys secrets", func() {
+		for i := 0; i < 2; i++ {
+			fetched := &corev1.Secret{}
+			secretKey := types.NamespacedName{
+				Name:      fmt.Sprintf("%s-%d-key", key.Name, i),
+				Namespace: ns.Name,
+			}
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), secretKey, fetched)
+			}, timeout, interval).Should(Succeed())
+			Expect(fetched.OwnerReferences).To(ContainElements(networkOwnerReference))
+			Expect(fetched.Data).To(HaveKey("password"))
+			address, err := helpers.DeriveAddress(string(fetched.Data["key"]))
+			Expect(err).ToNot(HaveOccurred())
+			addresses = append(addresses, sharedAPI.EthereumAddress(address))
+		}
+	})
+
+	It("should create network nodes with the same genesis", func() {
+		for i, client := range toCreate.Spec.Clients {
+			fetched := &ethereumv1alpha1.Node{}
+			nodeKey := types.NamespacedName{
+				Name:      fmt.Sprintf("%s-%d", key.Name, i),
+				Namespace: ns.Name,
+			}
+			Expect(k8sClient.Get(context.Background(), nodeKey, fetched)).To(Succeed())
+			Expect(fetched.OwnerReferences).To(ContainElements(networkOwnerReference))
+			Expect(fetched.Spec.Client).To(Equal(client))
+			Expect(fetched.Spec.Genesis.ChainID).To(Equal(toCreate.Spec.Genesis.ChainID))
+			Expect(fetched.Spec.Genesis.Clique.Signers).To(Equal(addresses))
+			Expect(fetched.Spec.NodePrivateKeySecretName).To(Equal(fmt.Sprintf("%s-%d-key", key.Name, i)))
+		}
+	})
+
+	It("should sign blocks using imported account on geth node", func() {
+		fetched := &ethereumv1alpha1.Node{}
+		nodeKey := types.NamespacedName{
+			Name:      fmt.Sprintf("%s-1", key.Name),
+			Namespace: ns.Name,
+		}
+		Expect(k8sClient.Get(context.Background(), nodeKey, fetched)).To(Succeed())
+		Expect(fetched.Spec.Miner).To(BeTrue())
+		Expect(fetched.Spec.Coinbase).To(Equal(addresses[1]))
+		Expect(fetched.Spec.Import.PrivateKeySecretName).To(Equal(fmt.Sprintf("%s-1-key", key.Name)))
+	})
+
+	It("should report network validators in status", func() {
+		fetched := &ethereumv1alpha1.Network{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.Consensus).To(Equal(string(ethereumv1alpha1.CliqueConsensus)))
+		Expect(fetched.Status.GenesisValidators).To(Equal(2))
+		Expect(fetched.Status.Nodes).To(Equal(2))
+		Expect(fetched.Status.Validators).To(ConsistOf(string(addresses[0]), string(addresses[1])))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
+
+var _ = Describe("Ethereum network scaled down", func() {
+
+	const (
+		interval = 2 * time.Second
+		timeout  = 2 * time.Minute
+	)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "network-scale-down",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "ibft2",
+		Namespace: ns.Name,
+	}
+
+	toCreate := &ethereumv1alpha1.Network{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: ethereumv1alpha1.NetworkSpec{
+			Consensus:  ethereumv1alpha1.IBFT2Consensus,
+			Validators: 3,
+			Clients: []ethereumv1alpha1.EthereumClient{
+				ethereumv1alpha1.BesuClient,
+			},
+			Genesis: ethereumv1alpha1.Genesis{
+				ChainID:   7777,
+				NetworkID: 7777,
+			},
+		},
+	}
+
+	nodeKey := func(i int) types.NamespacedName {
+		return types.NamespacedName{Name: fmt.Sprintf("%s-%d", key.Name, i), Namespace: ns.Name}
+	}
+
+	proposalKey := func(i int) types.NamespacedName {
+		return types.NamespacedName{Name: fmt.Sprintf("%s-%s-%d", key.Name, ethereumv1alpha1.RemoveValidatorAction, i), Namespace: ns.Name}
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+	})
+
+	It("should create network", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			toCreate.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+		time.Sleep(5 * time.Second)
+	})
+
+	It("should scale down network validators", func() {
+		fetched := &ethereumv1alpha1.Network{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		fetched.Spec.Validators = 1
+		Expect(k8sClient.Update(context.Background(), fetched)).To(Succeed())
+	})
+
+	It("should propose removing the last node first", func() {
+		Eventually(func() error {
+			return k8sClient.Get(context.Background(), proposalKey(2), &ethereumv1alpha1.ValidatorProposal{})
+		}, timeout, interval).Should(Succeed())
+
+		proposal := &ethereumv1alpha1.ValidatorProposal{}
+		Expect(k8sClient.Get(context.Background(), proposalKey(2), proposal)).To(Succeed())
+		Expect(proposal.Spec.Action).To(Equal(ethereumv1alpha1.RemoveValidatorAction))
+		Expect(proposal.Spec.Nodes).To(ConsistOf(nodeKey(0).Name))
+	})
+
+	It("should keep nodes running while removal proposal is pending", func() {
+		Consistently(func() error {
+			if err := k8sClient.Get(context.Background(), nodeKey(2), &ethereumv1alpha1.Node{}); err != nil {
+				return err
+			}
+			return k8sClient.Get(context.Background(), nodeKey(1), &ethereumv1alpha1.Node{})
+		}, 10*time.Second, interval).Should(Succeed())
+	})
+
+	It("should remove validators one at a time", func() {
+		err := k8sClient.Get(context.Background(), proposalKey(1), &ethereumv1alpha1.ValidatorProposal{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should delete node once its removal proposal is applied", func() {
+		Eventually(func() error {
+			proposal := &ethereumv1alpha1.ValidatorProposal{}
+			if err := k8sClient.Get(context.Background(), proposalKey(2), proposal); err != nil {
+				return err
+			}
+			proposal.Status.Phase = ethereumv1alpha1.ProposalApplied
+			return k8sClient.Status().Update(context.Background(), proposal)
+		}, timeout, interval).Should(Succeed())
+
+		Eventually(func() bool {
+			err := k8sClient.Get(context.Background(), nodeKey(2), &ethereumv1alpha1.Node{})
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	It("should propose removing the next node after previous removal is applied", func() {
+		Eventually(func() error {
+			return k8sClient.Get(context.Background(), proposalKey(1), &ethereumv1alpha1.ValidatorProposal{})
+		}, timeout, interval).Should(Succeed())
+
+		Expect(k8sClient.Get(context.Background(), nodeKey(1), &ethereumv1alpha1.Node{})).To(Succeed())
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
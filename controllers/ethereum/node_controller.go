@@ -2,28 +2,43 @@ package controllers
 
 import (
 	"context"
+	"crypto/rand"
 	_ "embed"
+	"encoding/hex"
 	"fmt"
+	"net"
 	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
 	ethereumClients "github.com/kotalco/kotal/clients/ethereum"
 	"github.com/kotalco/kotal/controllers/shared"
 	"github.com/kotalco/kotal/helpers"
 )
 
+// loadBalancerRequeueInterval is the interval p2p load balancer is checked until its address is assigned
+const loadBalancerRequeueInterval = 30 * time.Second
+
 // NodeReconciler reconciles a Node object
 type NodeReconciler struct {
 	client.Client
@@ -33,18 +48,17 @@ type NodeReconciler struct {
 var (
 	//go:embed geth_init_genesis.sh
 	GethInitGenesisScript string
-	//go:embed geth_import_account.sh
-	gethImportAccountScript string
+	//go:embed copy_keystore.sh
+	copyKeystoreScript string
 	//go:embed nethermind_convert_enode_privatekey.sh
 	nethermindConvertEnodePrivateKeyScript string
-	//go:embed nethermind_copy_keystore.sh
-	nethermindConvertCopyKeystoreScript string
 )
 
 // +kubebuilder:rbac:groups=ethereum.kotal.io,resources=nodes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ethereum.kotal.io,resources=nodes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=watch;get;list;create;update;delete
 // +kubebuilder:rbac:groups=core,resources=secrets;services;configmaps;persistentvolumeclaims,verbs=watch;get;create;update;list;delete
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=beaconnodes,verbs=get;list;watch
 
 // Reconcile reconciles ethereum networks
 func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
@@ -62,6 +76,16 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 	}
 
 	shared.UpdateLabels(&node, string(node.Spec.Client))
+
+	// development chain genesis and coinbase account are generated before rendering node config
+	if err = r.reconcileDevChain(ctx, &node); err != nil {
+		return
+	}
+
+	// peers references are replaced by their enodeURL while resolving static nodes and bootnodes
+	peers := nodeReferences(&node)
+	// unresolved references are collected while resolving static nodes and bootnodes
+	node.Status.UnresolvedReferences = nil
 	r.updateStaticNodes(ctx, &node)
 	r.updateBootnodes(ctx, &node)
 
@@ -73,11 +97,32 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 		return
 	}
 
+	if err = r.reconcileGenesisConfigmap(ctx, &node); err != nil {
+		return
+	}
+
+	if err = r.updateGenesisMismatch(ctx, &node, peers); err != nil {
+		return
+	}
+
+	if err = r.reconcileJWTSecret(ctx, &node); err != nil {
+		return
+	}
+
 	ip, err := r.reconcileService(ctx, &node)
 	if err != nil {
 		return
 	}
 
+	lbAddress, err := r.reconcileP2PService(ctx, &node)
+	if err != nil {
+		return
+	}
+	if lbAddress != "" {
+		ip = lbAddress
+		advertiseLoadBalancerAddress(ctx, &node, lbAddress)
+	}
+
 	if err = r.reconcileStatefulSet(ctx, &node); err != nil {
 		return
 	}
@@ -87,90 +132,125 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 		return
 	}
 
+	// provided external address takes precedence over load balancer and cluster addresses
+	if p2p := node.Spec.P2P; p2p != nil && p2p.ExternalIP != "" {
+		ip = p2p.ExternalIP
+	} else if p2p != nil && p2p.ExternalHostname != "" {
+		ip = p2p.ExternalHostname
+	}
+
 	enodeURL := fmt.Sprintf("enode://%s@%s:%d", publicKey, ip, node.Spec.P2PPort)
 
 	if err = r.updateStatus(ctx, &node, enodeURL); err != nil {
 		return
 	}
 
-	return ctrl.Result{}, nil
+	// enode URL is updated once load balancer address is assigned
+	if p2p := node.Spec.P2P; p2p != nil && p2p.ServiceType == corev1.ServiceTypeLoadBalancer && p2p.ExternalIP == "" && p2p.ExternalHostname == "" && lbAddress == "" {
+		result.RequeueAfter = loadBalancerRequeueInterval
+	}
+
+	return
 }
 
-// getEnodeURL fetch enodeURL from enode that has the format of node.namespace
-// name is the node name, and namespace is the node namespace
-func (r *NodeReconciler) getEnodeURL(ctx context.Context, enode, ns string) (string, error) {
-	node := &ethereumv1alpha1.Node{}
-	var name, namespace string
+// advertiseLoadBalancerAddress sets node external address to p2p load balancer address
+// so the client advertises the address peers can reach, unless external address is provided
+func advertiseLoadBalancerAddress(ctx context.Context, node *ethereumv1alpha1.Node, address string) {
+	p2p := node.Spec.P2P
+	if p2p.ExternalIP != "" || p2p.ExternalHostname != "" {
+		return
+	}
 
-	if parts := strings.Split(enode, "."); len(parts) > 1 {
-		name = parts[0]
-		namespace = parts[1]
+	p2p = p2p.DeepCopy()
+
+	if net.ParseIP(address) != nil {
+		p2p.ExternalIP = address
+	} else if node.Spec.Client.SupportsExternalHostname() {
+		p2p.ExternalHostname = address
 	} else {
-		// nodes without . refered to nodes in the current node namespace
-		name = enode
-		namespace = ns
+		log.FromContext(ctx).Info("load balancer hostname can't be advertised by client", "client", node.Spec.Client, "hostname", address)
+		return
 	}
 
-	namespacedName := types.NamespacedName{
-		Name:      name,
-		Namespace: namespace,
-	}
+	node.Spec.P2P = p2p
+}
+
+// getEnodeURL fetch enodeURL from enode that has the format of node.namespace
+// name is the node name, and namespace is the node namespace
+func (r *NodeReconciler) getEnodeURL(ctx context.Context, enode, ns string) (string, error) {
+	node := &ethereumv1alpha1.Node{}
 
-	if err := r.Client.Get(ctx, namespacedName, node); err != nil {
+	if err := r.Client.Get(ctx, shared.ParseReference(enode, ns), node); err != nil {
 		return "", err
 	}
 
 	return node.Status.EnodeURL, nil
 }
 
-// updateStaticNodes replaces Ethereum node references with their enodeURL
-func (r *NodeReconciler) updateStaticNodes(ctx context.Context, node *ethereumv1alpha1.Node) {
+// resolveEnodes replaces Ethereum node references with their enodeURL
+// references that can't be resolved are removed and returned as unresolved
+func (r *NodeReconciler) resolveEnodes(ctx context.Context, node *ethereumv1alpha1.Node, enodes []ethereumv1alpha1.Enode) (resolved []ethereumv1alpha1.Enode, unresolved []string) {
 	log := log.FromContext(ctx)
-	for i, enode := range node.Spec.StaticNodes {
-		if !strings.HasPrefix(string(enode), "enode://") {
-			enodeURL, err := r.getEnodeURL(ctx, string(enode), node.Namespace)
-			if err != nil {
-				// remove static node reference, so it won't be included into static nodes file
-				// don't return the error, node maybe not up and running yet
-				node.Spec.StaticNodes = append(node.Spec.StaticNodes[:i], node.Spec.StaticNodes[i+1:]...)
-				log.Error(err, "failed to get static node")
-				continue
-			}
-			log.Info("static node enodeURL", string(enode), enodeURL)
-			// replace reference with actual enode url
-			if strings.HasPrefix(enodeURL, "enode://") {
-				node.Spec.StaticNodes[i] = ethereumv1alpha1.Enode(enodeURL)
-			} else {
-				// remove static node reference, so it won't be included into static nodes file
-				node.Spec.StaticNodes = append(node.Spec.StaticNodes[:i], node.Spec.StaticNodes[i+1:]...)
-			}
+
+	for _, enode := range enodes {
+		if strings.HasPrefix(string(enode), "enode://") {
+			resolved = append(resolved, enode)
+			continue
 		}
+
+		enodeURL, err := r.getEnodeURL(ctx, string(enode), node.Namespace)
+		if err != nil {
+			// don't return the error, node maybe not created yet
+			// node will be reconciled again once referenced node is created
+			log.Error(err, "failed to get node enodeURL", "reference", enode)
+			unresolved = append(unresolved, string(enode))
+			continue
+		}
+
+		// referenced node is not up and running yet, or has no node private key
+		if !strings.HasPrefix(enodeURL, "enode://") {
+			unresolved = append(unresolved, string(enode))
+			continue
+		}
+
+		log.Info("node enodeURL", string(enode), enodeURL)
+		// replace reference with actual enode url
+		resolved = append(resolved, ethereumv1alpha1.Enode(enodeURL))
 	}
+
+	return
+}
+
+// updateStaticNodes replaces Ethereum node references with their enodeURL
+func (r *NodeReconciler) updateStaticNodes(ctx context.Context, node *ethereumv1alpha1.Node) {
+	var unresolved []string
+	node.Spec.StaticNodes, unresolved = r.resolveEnodes(ctx, node, node.Spec.StaticNodes)
+	node.Status.UnresolvedReferences = append(node.Status.UnresolvedReferences, unresolved...)
 }
 
 // updateBootnodes replaces Ethereum node references with their enodeURL
 func (r *NodeReconciler) updateBootnodes(ctx context.Context, node *ethereumv1alpha1.Node) {
-	log := log.FromContext(ctx)
-	for i, enode := range node.Spec.Bootnodes {
-		if !strings.HasPrefix(string(enode), "enode://") {
-			enodeURL, err := r.getEnodeURL(ctx, string(enode), node.Namespace)
-			if err != nil {
-				// remove bootnode reference, so it won't be included into bootnodes
-				// don't return the error, node maybe not up and running yet
-				node.Spec.Bootnodes = append(node.Spec.Bootnodes[:i], node.Spec.Bootnodes[i+1:]...)
-				log.Error(err, "failed to get bootnode")
-				continue
-			}
-			log.Info("bootnode enodeURL", string(enode), enodeURL)
-			// replace reference with actual enode url
-			if strings.HasPrefix(enodeURL, "enode://") {
-				node.Spec.Bootnodes[i] = ethereumv1alpha1.Enode(enodeURL)
-			} else {
-				// remove bootnode reference, so it won't be included into bootnodes
-				node.Spec.Bootnodes = append(node.Spec.Bootnodes[:i], node.Spec.Bootnodes[i+1:]...)
-			}
+	var unresolved []string
+	node.Spec.Bootnodes, unresolved = r.resolveEnodes(ctx, node, node.Spec.Bootnodes)
+	node.Status.UnresolvedReferences = append(node.Status.UnresolvedReferences, unresolved...)
+}
+
+// getBeaconNodes returns beacon nodes using this node as execution engine
+func (r *NodeReconciler) getBeaconNodes(ctx context.Context, node *ethereumv1alpha1.Node) (beaconNodes []string, err error) {
+	var nodes ethereum2v1alpha1.BeaconNodeList
+
+	// beacon nodes reference execution engine nodes in the same namespace only
+	if err = r.Client.List(ctx, &nodes, client.InNamespace(node.Namespace)); err != nil {
+		return
+	}
+
+	for _, beaconNode := range nodes.Items {
+		if beaconNode.Spec.ExecutionEngineRef == node.Name {
+			beaconNodes = append(beaconNodes, types.NamespacedName{Name: beaconNode.Name, Namespace: beaconNode.Namespace}.String())
 		}
 	}
+
+	return
 }
 
 // updateStatus updates network status
@@ -184,10 +264,24 @@ func (r *NodeReconciler) updateStatus(ctx context.Context, node *ethereumv1alpha
 		case ethereumv1alpha1.MainNetwork,
 			ethereumv1alpha1.RopstenNetwork,
 			ethereumv1alpha1.XDaiNetwork,
-			ethereumv1alpha1.GoerliNetwork:
+			ethereumv1alpha1.GoerliNetwork,
+			ethereumv1alpha1.SepoliaNetwork,
+			ethereumv1alpha1.HoleskyNetwork,
+			ethereumv1alpha1.HoodiNetwork,
+			ethereumv1alpha1.ChiadoNetwork:
 			consensus = "pos"
-		case ethereumv1alpha1.RinkebyNetwork:
+		case ethereumv1alpha1.RinkebyNetwork,
+			ethereumv1alpha1.KottiNetwork,
+			ethereumv1alpha1.DevNetwork:
 			consensus = "poa"
+		case ethereumv1alpha1.ClassicNetwork,
+			ethereumv1alpha1.MordorNetwork:
+			consensus = "pow"
+		}
+
+		// webhooks may be disabled, report joining retired networks
+		if ethereumv1alpha1.DeprecatedNetworks[node.Spec.Network] {
+			log.Info(ethereumv1alpha1.DeprecatedNetworkWarning(node.Spec.Network))
 		}
 	} else {
 		if node.Spec.Genesis.Ethash != nil {
@@ -219,6 +313,14 @@ func (r *NodeReconciler) updateStatus(ctx context.Context, node *ethereumv1alpha
 	}
 
 	node.Status.EnodeURL = enodeURL
+	node.Status.JWTSecretName = node.Spec.JWTSecretName
+
+	beaconNodes, err := r.getBeaconNodes(ctx, node)
+	if err != nil {
+		log.Error(err, "unable to get paired beacon nodes")
+		return err
+	}
+	node.Status.BeaconNodes = beaconNodes
 
 	if err := r.Status().Update(ctx, node); err != nil {
 		log.Error(err, "unable to update node status")
@@ -234,12 +336,11 @@ func (r *NodeReconciler) specConfigmap(node *ethereumv1alpha1.Node, configmap *c
 		configmap.Data = map[string]string{}
 	}
 
-	var key, importAccountScript string
+	var key string
 
 	switch node.Spec.Client {
 	case ethereumv1alpha1.GethClient:
 		key = "config.toml"
-		importAccountScript = gethImportAccountScript
 	case ethereumv1alpha1.BesuClient:
 		key = "static-nodes.json"
 	case ethereumv1alpha1.NethermindClient:
@@ -253,13 +354,12 @@ func (r *NodeReconciler) specConfigmap(node *ethereumv1alpha1.Node, configmap *c
 		}
 	}
 
-	if node.Spec.Import != nil {
-		configmap.Data["import-account.sh"] = importAccountScript
+	if len(node.Spec.ImportedAccounts()) != 0 {
+		configmap.Data["copy_keystore.sh"] = copyKeystoreScript
 	}
 
 	if node.Spec.Client == ethereumv1alpha1.NethermindClient {
 		configmap.Data["nethermind_convert_enode_privatekey.sh"] = nethermindConvertEnodePrivateKeyScript
-		configmap.Data["nethermind_copy_keystore.sh"] = nethermindConvertCopyKeystoreScript
 	}
 
 	currentStaticNodes := configmap.Data[key]
@@ -320,6 +420,100 @@ func (r *NodeReconciler) reconcileConfigmap(ctx context.Context, node *ethereumv
 	return err
 }
 
+// specGenesisConfigmap updates published genesis configmap spec
+func (r *NodeReconciler) specGenesisConfigmap(node *ethereumv1alpha1.Node, configmap *corev1.ConfigMap, genesis, hash string) {
+	configmap.ObjectMeta.Labels = node.GetLabels()
+	configmap.Data = map[string]string{
+		"genesis.json": genesis,
+		"hash":         hash,
+	}
+}
+
+// reconcileGenesisConfigmap publishes rendered genesis and genesis block hash of private networks
+// the configmap can be consumed by other tools like block explorers and beacon chain genesis generators
+func (r *NodeReconciler) reconcileGenesisConfigmap(ctx context.Context, node *ethereumv1alpha1.Node) error {
+	node.Status.GenesisHash = ""
+	node.Status.GenesisConfigMapName = ""
+
+	if node.Spec.Genesis == nil {
+		return nil
+	}
+
+	client, err := ethereumClients.NewClient(node)
+	if err != nil {
+		return err
+	}
+
+	genesis, err := client.Genesis()
+	if err != nil {
+		return err
+	}
+
+	hash, err := ethereumClients.GenesisBlockHash(node.Spec.Genesis)
+	if err != nil {
+		return err
+	}
+
+	configmap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-genesis", node.Name),
+			Namespace: node.Namespace,
+		},
+	}
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, configmap, func() error {
+		if err := ctrl.SetControllerReference(node, configmap, r.Scheme); err != nil {
+			log.FromContext(ctx).Error(err, "Unable to set controller reference on published genesis configmap")
+			return err
+		}
+
+		r.specGenesisConfigmap(node, configmap, genesis, hash)
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	node.Status.GenesisHash = hash
+	node.Status.GenesisConfigMapName = configmap.Name
+
+	return nil
+}
+
+// updateGenesisMismatch reports referenced peers with genesis hash different from node genesis hash
+// peers that haven't reported genesis hash yet, or joining public networks are skipped
+func (r *NodeReconciler) updateGenesisMismatch(ctx context.Context, node *ethereumv1alpha1.Node, peers []string) error {
+	node.Status.GenesisMismatch = nil
+
+	if node.Status.GenesisHash == "" {
+		return nil
+	}
+
+	for _, peer := range peers {
+		name := strings.SplitN(peer, "/", 2)
+		peerNode := &ethereumv1alpha1.Node{}
+		key := types.NamespacedName{Namespace: name[0], Name: name[1]}
+
+		if err := r.Client.Get(ctx, key, peerNode); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if peerNode.Status.GenesisHash == "" || peerNode.Status.GenesisHash == node.Status.GenesisHash {
+			continue
+		}
+
+		log.FromContext(ctx).Info("peer genesis hash mismatch", "peer", peer, "hash", peerNode.Status.GenesisHash)
+		node.Status.GenesisMismatch = append(node.Status.GenesisMismatch, peer)
+	}
+
+	return nil
+}
+
 // specPVC update node data pvc spec
 func (r *NodeReconciler) specPVC(node *ethereumv1alpha1.Node, pvc *corev1.PersistentVolumeClaim) {
 	request := corev1.ResourceList{
@@ -407,50 +601,76 @@ func (r *NodeReconciler) createNodeVolumes(node *ethereumv1alpha1.Node) []corev1
 		projections = append(projections, nodekeyProjection)
 	}
 
-	// importing ethereum account
-	if node.Spec.Import != nil {
-		// account private key projection
-		privateKeyProjection := corev1.VolumeProjection{
-			Secret: &corev1.SecretProjection{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: node.Spec.Import.PrivateKeySecretName,
+	// importing ethereum accounts
+	// keystores and passwords are written to node secret, so private keys are encrypted once
+	if accounts := node.Spec.ImportedAccounts(); len(accounts) != 0 {
+		items := []corev1.KeyToPath{
+			{
+				Key:  "account.password",
+				Path: "account.password",
+			},
+		}
+
+		for i, account := range accounts {
+			keystoreKey := keystoreSecretKey(account.Address)
+			passwordKey := fmt.Sprintf("account-%d.password", i)
+			items = append(items,
+				corev1.KeyToPath{
+					Key:  keystoreKey,
+					Path: fmt.Sprintf("keystore/%s", keystoreKey),
 				},
-				Items: []corev1.KeyToPath{
-					{
-						Key:  "key",
-						Path: "account.key",
-					},
+				corev1.KeyToPath{
+					Key:  passwordKey,
+					Path: passwordKey,
 				},
-			},
+			)
 		}
-		projections = append(projections, privateKeyProjection)
 
-		// account password projection
-		passwordProjection := corev1.VolumeProjection{
+		accountsProjection := corev1.VolumeProjection{
 			Secret: &corev1.SecretProjection{
 				LocalObjectReference: corev1.LocalObjectReference{
-					Name: node.Spec.Import.PasswordSecretName,
-				},
-				Items: []corev1.KeyToPath{
-					{
-						Key:  "password",
-						Path: "account.password",
-					},
+					Name: node.Name,
 				},
+				Items: items,
 			},
 		}
-		projections = append(projections, passwordProjection)
+		projections = append(projections, accountsProjection)
+	}
 
-		// nethermind : account keystore
-		if node.Spec.Client == ethereumv1alpha1.NethermindClient {
-			accountKeystoreProjection := corev1.VolumeProjection{
+	// private transactions
+	if node.Spec.Privacy != nil {
+		if node.Spec.Privacy.MultiTenancy {
+			// tenants JWT tokens public key
+			jwtPublicKeyProjection := corev1.VolumeProjection{
 				Secret: &corev1.SecretProjection{
 					LocalObjectReference: corev1.LocalObjectReference{
-						Name: node.Name,
+						Name: node.Spec.Privacy.JWTPublicKeySecretName,
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  "key",
+							Path: "privacy-jwt.pub",
+						},
+					},
+				},
+			}
+			projections = append(projections, jwtPublicKeyProjection)
+		} else {
+			// transaction manager public key, created by transaction manager controller
+			privacyPublicKeyProjection := corev1.VolumeProjection{
+				Secret: &corev1.SecretProjection{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: node.Spec.Privacy.TransactionManager,
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  "tm.pub",
+							Path: "privacy.pub",
+						},
 					},
 				},
 			}
-			projections = append(projections, accountKeystoreProjection)
+			projections = append(projections, privacyPublicKeyProjection)
 		}
 	}
 
@@ -488,6 +708,10 @@ func (r *NodeReconciler) createNodeVolumes(node *ethereumv1alpha1.Node) []corev1
 	}
 	volumes = append(volumes, dataVolume)
 
+	if node.Spec.Gateway != nil {
+		volumes = append(volumes, shared.GatewayVolumes(node.Spec.Gateway)...)
+	}
+
 	return volumes
 }
 
@@ -496,7 +720,7 @@ func (r *NodeReconciler) createNodeVolumeMounts(node *ethereumv1alpha1.Node, hom
 
 	volumeMounts := []corev1.VolumeMount{}
 
-	if node.Spec.NodePrivateKeySecretName != "" || node.Spec.Import != nil || node.Spec.JWTSecretName != "" {
+	if node.Spec.NodePrivateKeySecretName != "" || len(node.Spec.ImportedAccounts()) != 0 || node.Spec.JWTSecretName != "" || node.Spec.Privacy != nil {
 		secretsMount := corev1.VolumeMount{
 			Name:      "secrets",
 			MountPath: shared.PathSecrets(homedir),
@@ -565,27 +789,6 @@ func (r *NodeReconciler) specStatefulset(node *ethereumv1alpha1.Node, sts *appsv
 			}
 			initContainers = append(initContainers, initGenesis)
 		}
-		if node.Spec.Import != nil {
-			importAccount := corev1.Container{
-				Name:  "import-account",
-				Image: node.Spec.Image,
-				Env: []corev1.EnvVar{
-					{
-						Name:  EnvDataPath,
-						Value: shared.PathData(homedir),
-					},
-					{
-						Name:  EnvSecretsPath,
-						Value: shared.PathSecrets(homedir),
-					},
-				},
-				Command:      []string{"/bin/sh"},
-				Args:         []string{fmt.Sprintf("%s/import-account.sh", shared.PathConfig(homedir))},
-				VolumeMounts: volumeMounts,
-			}
-			initContainers = append(initContainers, importAccount)
-		}
-
 	} else if node.Spec.Client == ethereumv1alpha1.NethermindClient {
 		if node.Spec.NodePrivateKeySecretName != "" {
 			convertEnodePrivateKey := corev1.Container{
@@ -607,31 +810,50 @@ func (r *NodeReconciler) specStatefulset(node *ethereumv1alpha1.Node, sts *appsv
 			}
 			initContainers = append(initContainers, convertEnodePrivateKey)
 		}
+	}
 
-		if node.Spec.Import != nil {
-			copyKeystore := corev1.Container{
-				Name:  "copy-keystore",
-				Image: shared.BusyboxImage,
-				Env: []corev1.EnvVar{
-					{
-						Name:  EnvDataPath,
-						Value: shared.PathData(homedir),
-					},
-					{
-						Name:  EnvSecretsPath,
-						Value: shared.PathSecrets(homedir),
-					},
-					{
-						Name:  "COINBASE",
-						Value: strings.ToLower(string(node.Spec.Coinbase))[2:],
-					},
+	// copy imported accounts keystores generated by the operator
+	if len(node.Spec.ImportedAccounts()) != 0 {
+		copyKeystore := corev1.Container{
+			Name:  "copy-keystore",
+			Image: shared.BusyboxImage,
+			Env: []corev1.EnvVar{
+				{
+					Name:  EnvDataPath,
+					Value: shared.PathData(homedir),
 				},
-				Command:      []string{"/bin/sh"},
-				Args:         []string{fmt.Sprintf("%s/nethermind_copy_keystore.sh", shared.PathConfig(homedir))},
-				VolumeMounts: volumeMounts,
-			}
-			initContainers = append(initContainers, copyKeystore)
+				{
+					Name:  EnvSecretsPath,
+					Value: shared.PathSecrets(homedir),
+				},
+			},
+			Command:      []string{"/bin/sh"},
+			Args:         []string{fmt.Sprintf("%s/copy_keystore.sh", shared.PathConfig(homedir))},
+			VolumeMounts: volumeMounts,
+		}
+		initContainers = append(initContainers, copyKeystore)
+	}
+
+	containers := []corev1.Container{nodeContainer}
+
+	// gateway listens on node rpc and ws ports, and proxies requests to localhost servers
+	if node.Spec.Gateway != nil {
+		routes := []shared.GatewayRoute{}
+		if node.Spec.RPC {
+			routes = append(routes, shared.GatewayRoute{
+				Name:     "json-rpc",
+				Port:     node.Spec.RPCPort,
+				Upstream: fmt.Sprintf("http://127.0.0.1:%d", shared.GatewayRPCUpstreamPort),
+			})
+		}
+		if node.Spec.WS {
+			routes = append(routes, shared.GatewayRoute{
+				Name:     "ws",
+				Port:     node.Spec.WSPort,
+				Upstream: fmt.Sprintf("ws://127.0.0.1:%d", shared.GatewayWSUpstreamPort),
+			})
 		}
+		containers = append(containers, shared.GatewayContainer(node.Spec.Gateway, shared.GatewayJSONRPCProtocol, routes))
 	}
 
 	sts.ObjectMeta.Labels = labels
@@ -645,7 +867,7 @@ func (r *NodeReconciler) specStatefulset(node *ethereumv1alpha1.Node, sts *appsv
 		SecurityContext: shared.SecurityContext(),
 		Volumes:         volumes,
 		InitContainers:  initContainers,
-		Containers:      []corev1.Container{nodeContainer},
+		Containers:      containers,
 	}
 }
 
@@ -679,40 +901,269 @@ func (r *NodeReconciler) reconcileStatefulSet(ctx context.Context, node *ethereu
 	return err
 }
 
-// specSecret creates keystore from account private key for nethermind client
+// specSecret writes imported accounts keystores and passwords
+// keystores encrypted from private keys are cached, and regenerated only if private key or password change
 func (r *NodeReconciler) specSecret(ctx context.Context, node *ethereumv1alpha1.Node, secret *corev1.Secret) error {
 	secret.ObjectMeta.Labels = node.GetLabels()
 
-	if node.Spec.Import != nil && node.Spec.Client == ethereumv1alpha1.NethermindClient {
+	accounts := node.Spec.ImportedAccounts()
+	if len(accounts) == 0 {
+		secret.Data = nil
+		return nil
+	}
+
+	data := map[string][]byte{}
+	passwords := []string{}
+
+	for i, account := range accounts {
 		key := types.NamespacedName{
-			Name:      node.Spec.Import.PrivateKeySecretName,
+			Name:      account.PasswordSecretName,
 			Namespace: node.Namespace,
 		}
 
-		privateKey, err := shared.GetSecret(ctx, r.Client, key, "key")
+		password, err := shared.GetSecret(ctx, r.Client, key, "password")
 		if err != nil {
 			return err
 		}
 
-		key = types.NamespacedName{
-			Name:      node.Spec.Import.PasswordSecretName,
-			Namespace: node.Namespace,
+		keystoreKey := keystoreSecretKey(account.Address)
+		checksumKey := fmt.Sprintf("%s.checksum", keystoreKey)
+
+		var keystore []byte
+
+		if account.KeystoreSecretName != "" {
+			key = types.NamespacedName{
+				Name:      account.KeystoreSecretName,
+				Namespace: node.Namespace,
+			}
+
+			content, err := shared.GetSecret(ctx, r.Client, key, "keystore")
+			if err != nil {
+				return err
+			}
+			keystore = []byte(content)
+		} else {
+			key = types.NamespacedName{
+				Name:      account.PrivateKeySecretName,
+				Namespace: node.Namespace,
+			}
+
+			privateKey, err := shared.GetSecret(ctx, r.Client, key, "key")
+			if err != nil {
+				return err
+			}
+
+			checksum := keystoreChecksum(privateKey, password)
+
+			// scrypt key derivation is slow, reuse keystore if private key and password didn't change
+			if string(secret.Data[checksumKey]) == checksum && len(secret.Data[keystoreKey]) != 0 {
+				keystore = secret.Data[keystoreKey]
+			} else if keystore, err = KeyStoreFromPrivateKey(privateKey, password); err != nil {
+				return err
+			}
+
+			data[checksumKey] = []byte(checksum)
 		}
 
-		password, err := shared.GetSecret(ctx, r.Client, key, "password")
+		address, err := KeyStoreAddress(keystore)
 		if err != nil {
 			return err
 		}
 
-		account, err := KeyStoreFromPrivateKey(privateKey, password)
+		if !strings.EqualFold(address, string(account.Address)) {
+			return fmt.Errorf("imported account address %s doesn't match account %d address %s", address, i, account.Address)
+		}
+
+		data[keystoreKey] = keystore
+		data[fmt.Sprintf("account-%d.password", i)] = []byte(password)
+		passwords = append(passwords, password)
+	}
+
+	// geth reads passwords of unlocked accounts line by line
+	data["account.password"] = []byte(strings.Join(passwords, "\n"))
+
+	secret.Data = data
+
+	return nil
+}
+
+// specJWTSecret generates engine API JWT secret if it hasn't been generated yet
+func (r *NodeReconciler) specJWTSecret(node *ethereumv1alpha1.Node, secret *corev1.Secret) error {
+	secret.ObjectMeta.Labels = node.GetLabels()
+
+	// don't regenerate JWT secret, it's shared with consensus clients
+	if len(secret.Data["secret"]) != 0 {
+		return nil
+	}
+
+	jwt := make([]byte, 32)
+	if _, err := rand.Read(jwt); err != nil {
+		return err
+	}
+
+	secret.Data = map[string][]byte{
+		"secret": []byte(hex.EncodeToString(jwt)),
+	}
+
+	return nil
+}
+
+// reconcileJWTSecret creates engine API JWT secret if engine is enabled and no JWT secret is provided
+func (r *NodeReconciler) reconcileJWTSecret(ctx context.Context, node *ethereumv1alpha1.Node) error {
+	if !node.Spec.Engine || node.Spec.JWTSecretName != "" {
+		return nil
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-jwt", node.Name),
+			Namespace: node.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		// don't adopt user secret that happens to have the generated secret name
+		if !secret.CreationTimestamp.IsZero() && !metav1.IsControlledBy(secret, node) {
+			return fmt.Errorf("secret %s already exists and isn't owned by node %s", secret.Name, node.Name)
+		}
+		if err := ctrl.SetControllerReference(node, secret, r.Scheme); err != nil {
+			return err
+		}
+		return r.specJWTSecret(node, secret)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// use generated JWT secret in node volumes and status
+	node.Spec.JWTSecretName = secret.Name
+
+	return nil
+}
+
+// devGenesis returns single node development chain genesis funding the given accounts
+// besu mines ethash blocks, while geth and nethermind seal clique blocks by the first account
+func devGenesis(node *ethereumv1alpha1.Node, addresses []string) *ethereumv1alpha1.Genesis {
+	dev := node.Spec.Dev
+
+	genesis := &ethereumv1alpha1.Genesis{
+		ChainID:   ethereumv1alpha1.DefaultDevChainID,
+		NetworkID: ethereumv1alpha1.DefaultDevChainID,
+	}
+
+	for _, address := range addresses {
+		genesis.Accounts = append(genesis.Accounts, ethereumv1alpha1.Account{
+			Address: sharedAPI.EthereumAddress(address),
+			Balance: dev.Balance,
+		})
+	}
+
+	if node.Spec.Client == ethereumv1alpha1.BesuClient {
+		difficulty := ethereumv1alpha1.DefaultEthashFixedDifficulty
+		genesis.Ethash = &ethereumv1alpha1.Ethash{FixedDifficulty: &difficulty}
+	} else {
+		genesis.Clique = &ethereumv1alpha1.Clique{
+			Signers: []sharedAPI.EthereumAddress{sharedAPI.EthereumAddress(addresses[0])},
+		}
+	}
+
+	genesis.Default()
+
+	// zero block period seals blocks once transactions are received
+	if genesis.Clique != nil {
+		genesis.Clique.BlockPeriod = dev.BlockPeriod
+	}
+
+	return genesis
+}
+
+// specDevSecret writes development chain coinbase private key and generates its keystore password once
+func (r *NodeReconciler) specDevSecret(node *ethereumv1alpha1.Node, secret *corev1.Secret, privateKey string) error {
+	secret.ObjectMeta.Labels = node.GetLabels()
+
+	password := secret.Data["password"]
+	if len(password) == 0 {
+		random := make([]byte, 16)
+		if _, err := rand.Read(random); err != nil {
+			return err
+		}
+		password = []byte(hex.EncodeToString(random))
+	}
+
+	secret.Data = map[string][]byte{
+		"key":      []byte(privateKey),
+		"password": password,
+	}
+
+	return nil
+}
+
+// reconcileDevChain derives development chain accounts from the mnemonic
+// genesis funding the accounts is generated, and the first account is used as coinbase
+func (r *NodeReconciler) reconcileDevChain(ctx context.Context, node *ethereumv1alpha1.Node) error {
+	dev := node.Spec.Dev
+	if node.Spec.Network != ethereumv1alpha1.DevNetwork || dev == nil {
+		node.Status.DevAccounts = nil
+		return nil
+	}
+
+	mnemonic := ethereumv1alpha1.DefaultDevMnemonic
+	if dev.MnemonicSecretName != "" {
+		key := types.NamespacedName{
+			Name:      dev.MnemonicSecretName,
+			Namespace: node.Namespace,
+		}
+
+		var err error
+		if mnemonic, err = shared.GetSecret(ctx, r.Client, key, "mnemonic"); err != nil {
+			return err
+		}
+	}
+
+	privateKeys, err := helpers.DeriveMnemonicKeys(mnemonic, dev.Accounts)
+	if err != nil {
+		return err
+	}
+
+	addresses := []string{}
+	for _, privateKey := range privateKeys {
+		address, err := helpers.DeriveAddress(privateKey)
 		if err != nil {
 			return err
 		}
+		addresses = append(addresses, address)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-dev", node.Name),
+			Namespace: node.Namespace,
+		},
+	}
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := ctrl.SetControllerReference(node, secret, r.Scheme); err != nil {
+			return err
+		}
+		return r.specDevSecret(node, secret, privateKeys[0])
+	})
 
-		secret.Data = map[string][]byte{
-			"account": account,
+	if err != nil {
+		return err
+	}
+
+	node.Spec.Genesis = devGenesis(node, addresses)
+	node.Spec.Miner = true
+	node.Spec.Coinbase = sharedAPI.EthereumAddress(addresses[0])
+	// besu mines ethash blocks, and doesn't need the coinbase private key
+	if node.Spec.Client != ethereumv1alpha1.BesuClient {
+		node.Spec.Import = &ethereumv1alpha1.ImportedAccount{
+			PrivateKeySecretName: secret.Name,
+			PasswordSecretName:   secret.Name,
 		}
 	}
+	node.Status.DevAccounts = addresses
 
 	return nil
 }
@@ -853,16 +1304,170 @@ func (r *NodeReconciler) reconcileService(ctx context.Context, node *ethereumv1a
 	return
 }
 
+// specP2PService updates node p2p service spec
+func (r *NodeReconciler) specP2PService(node *ethereumv1alpha1.Node, svc *corev1.Service) {
+	labels := node.GetLabels()
+	serviceType := node.Spec.P2P.ServiceType
+
+	ports := []corev1.ServicePort{
+		{
+			Name:       "discovery",
+			Port:       int32(node.Spec.P2PPort),
+			TargetPort: intstr.FromInt(int(node.Spec.P2PPort)),
+			Protocol:   corev1.ProtocolUDP,
+		},
+		{
+			Name:       "p2p",
+			Port:       int32(node.Spec.P2PPort),
+			TargetPort: intstr.FromInt(int(node.Spec.P2PPort)),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+
+	// node advertises its p2p port, so it must be the same port on cluster nodes
+	if serviceType == corev1.ServiceTypeNodePort {
+		for i := range ports {
+			ports[i].NodePort = int32(node.Spec.P2PPort)
+		}
+	}
+
+	svc.ObjectMeta.Labels = labels
+	svc.Spec.Type = serviceType
+	svc.Spec.Ports = ports
+	// keep client source address, and don't route peers through other cluster nodes
+	svc.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyTypeLocal
+	svc.Spec.Selector = labels
+}
+
+// reconcileP2PService reconciles node p2p service exposing p2p port outside the cluster
+// it returns load balancer ingress address if it has been assigned
+func (r *NodeReconciler) reconcileP2PService(ctx context.Context, node *ethereumv1alpha1.Node) (address string, err error) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-p2p", node.Name),
+			Namespace: node.Namespace,
+		},
+	}
+
+	if node.Spec.P2P == nil || node.Spec.P2P.ServiceType == "" {
+		err = client.IgnoreNotFound(r.Client.Delete(ctx, svc))
+		return
+	}
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, svc, func() error {
+		if err = ctrl.SetControllerReference(node, svc, r.Scheme); err != nil {
+			return err
+		}
+
+		r.specP2PService(node, svc)
+
+		return nil
+	})
+
+	if err != nil {
+		return
+	}
+
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			return ingress.IP, nil
+		}
+		if ingress.Hostname != "" {
+			return ingress.Hostname, nil
+		}
+	}
+
+	return
+}
+
+// nodeReferences returns namespaced names of nodes referenced by static nodes and bootnodes
+func nodeReferences(obj client.Object) []string {
+	node := obj.(*ethereumv1alpha1.Node)
+	references := []string{}
+
+	enodes := append([]ethereumv1alpha1.Enode{}, node.Spec.StaticNodes...)
+	enodes = append(enodes, node.Spec.Bootnodes...)
+
+	for _, enode := range enodes {
+		if strings.HasPrefix(string(enode), "enode://") {
+			continue
+		}
+		references = append(references, shared.ParseReference(string(enode), node.Namespace).String())
+	}
+
+	return references
+}
+
+// referencingNodes returns reconcile requests for nodes referencing the given node
+func (r *NodeReconciler) referencingNodes(obj client.Object) []reconcile.Request {
+	var nodes ethereumv1alpha1.NodeList
+
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}.String()
+
+	if err := r.Client.List(context.Background(), &nodes, client.MatchingFields{shared.ReferencesIndexKey: key}); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, node := range nodes.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      node.Name,
+				Namespace: node.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
+// pairedNode returns reconcile request for execution engine node referenced by the given beacon node
+func (r *NodeReconciler) pairedNode(obj client.Object) []reconcile.Request {
+	beaconNode := obj.(*ethereum2v1alpha1.BeaconNode)
+
+	if beaconNode.Spec.ExecutionEngineRef == "" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{Name: beaconNode.Spec.ExecutionEngineRef, Namespace: beaconNode.Namespace},
+		},
+	}
+}
+
 // SetupWithManager adds reconciler to the manager
 func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &ethereumv1alpha1.Node{}, shared.ReferencesIndexKey, nodeReferences); err != nil {
+		return err
+	}
+
 	pred := predicate.GenerationChangedPredicate{}
+	// referenced nodes enodeURL and genesis hash are reported in status, which doesn't change generation
+	enodeURLPred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldStatus := e.ObjectOld.(*ethereumv1alpha1.Node).Status
+			newStatus := e.ObjectNew.(*ethereumv1alpha1.Node).Status
+			return oldStatus.EnodeURL != newStatus.EnodeURL || oldStatus.GenesisHash != newStatus.GenesisHash
+		},
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&ethereumv1alpha1.Node{}).
-		WithEventFilter(pred).
-		Owns(&appsv1.StatefulSet{}).
-		Owns(&corev1.Service{}).
-		Owns(&corev1.Secret{}).
-		Owns(&corev1.PersistentVolumeClaim{}).
-		Owns(&corev1.ConfigMap{}).
+		For(&ethereumv1alpha1.Node{}, builder.WithPredicates(pred)).
+		Owns(&appsv1.StatefulSet{}, builder.WithPredicates(pred)).
+		Owns(&corev1.Service{}, builder.WithPredicates(pred)).
+		Owns(&corev1.Secret{}, builder.WithPredicates(pred)).
+		Owns(&corev1.PersistentVolumeClaim{}, builder.WithPredicates(pred)).
+		Owns(&corev1.ConfigMap{}, builder.WithPredicates(pred)).
+		Watches(
+			&source.Kind{Type: &ethereumv1alpha1.Node{}},
+			handler.EnqueueRequestsFromMapFunc(r.referencingNodes),
+			builder.WithPredicates(enodeURLPred),
+		).
+		Watches(
+			&source.Kind{Type: &ethereum2v1alpha1.BeaconNode{}},
+			handler.EnqueueRequestsFromMapFunc(r.pairedNode),
+			builder.WithPredicates(pred),
+		).
 		Complete(r)
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
 	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	ethereumClients "github.com/kotalco/kotal/clients/ethereum"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gstruct"
@@ -547,6 +549,22 @@ var _ = Describe("Ethereum network controller", func() {
 			Expect(genesisConfig.Data["genesis.json"]).To(ContainSubstring(expectedExtraData))
 		})
 
+		It("Should publish genesis block and its hash", func() {
+			fetched := &ethereumv1alpha1.Node{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			expectedHash, err := ethereumClients.GenesisBlockHash(fetched.Spec.Genesis)
+			Expect(err).To(BeNil())
+			Expect(fetched.Status.GenesisHash).To(Equal(expectedHash))
+			Expect(fetched.Status.GenesisConfigMapName).To(Equal(fmt.Sprintf("%s-genesis", key.Name)))
+
+			genesisConfig := &corev1.ConfigMap{}
+			genesisKey := types.NamespacedName{Name: fetched.Status.GenesisConfigMapName, Namespace: ns.Name}
+			Expect(k8sClient.Get(context.Background(), genesisKey, genesisConfig)).To(Succeed())
+			Expect(genesisConfig.GetOwnerReferences()).To(ContainElement(nodeOwnerReference))
+			Expect(genesisConfig.Data["hash"]).To(Equal(expectedHash))
+			Expect(genesisConfig.Data).To(HaveKey("genesis.json"))
+		})
+
 		It("Should allocate correct resources to node statefulset", func() {
 			nodeSts := &appsv1.StatefulSet{}
 			expectedResources := corev1.ResourceRequirements{
@@ -1018,4 +1036,423 @@ var _ = Describe("Ethereum network controller", func() {
 		})
 	})
 
+	Context("static nodes references", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "references",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "my-node",
+			Namespace: ns.Name,
+		}
+
+		referencedKey := types.NamespacedName{
+			Name:      "my-static-node",
+			Namespace: ns.Name,
+		}
+
+		toCreate := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.BesuClient,
+				Network: "mainnet",
+				StaticNodes: []ethereumv1alpha1.Enode{
+					ethereumv1alpha1.Enode(referencedKey.Name),
+				},
+			},
+		}
+
+		referenced := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      referencedKey.Name,
+				Namespace: referencedKey.Namespace,
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:                   ethereumv1alpha1.BesuClient,
+				Network:                  "mainnet",
+				NodePrivateKeySecretName: "nodekey",
+			},
+		}
+
+		It(fmt.Sprintf("should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.Background(), ns)).Should(Succeed())
+		})
+
+		It("Should create nodekey secret", func() {
+			secret := corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nodekey",
+					Namespace: ns.Name,
+				},
+				StringData: map[string]string{
+					"key": privatekey,
+				},
+			}
+			Expect(k8sClient.Create(context.Background(), &secret)).To(Succeed())
+		})
+
+		It("Should create the node referencing a node that doesn't exist yet", func() {
+			if !useExistingCluster {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(sleepTime)
+		})
+
+		It("Should report unresolved static node reference in status", func() {
+			fetched := &ethereumv1alpha1.Node{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			Expect(fetched.Status.UnresolvedReferences).To(ConsistOf(referencedKey.Name))
+		})
+
+		It("Should create the referenced node", func() {
+			if !useExistingCluster {
+				referenced.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), referenced)).Should(Succeed())
+		})
+
+		It("Should resolve static node reference once referenced node enodeURL is reported", func() {
+			Eventually(func() []string {
+				fetched := &ethereumv1alpha1.Node{}
+				if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+					return []string{err.Error()}
+				}
+				return fetched.Status.UnresolvedReferences
+			}, timeout, interval).Should(BeEmpty())
+
+			fetched := &ethereumv1alpha1.Node{}
+			Expect(k8sClient.Get(context.Background(), referencedKey, fetched)).To(Succeed())
+
+			configmap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(context.Background(), key, configmap)).To(Succeed())
+			Expect(configmap.Data["static-nodes.json"]).To(ContainSubstring(fetched.Status.EnodeURL))
+		})
+
+		It(fmt.Sprintf("should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).Should(Succeed())
+		})
+	})
+
+	Context("development chain", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "dev-chain",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "my-dev-node",
+			Namespace: ns.Name,
+		}
+
+		invalidKey := types.NamespacedName{
+			Name:      "my-invalid-dev-node",
+			Namespace: ns.Name,
+		}
+
+		toCreate := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.DevNetwork,
+				Dev: &ethereumv1alpha1.Dev{
+					Accounts: 3,
+				},
+			},
+		}
+
+		invalid := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      invalidKey.Name,
+				Namespace: invalidKey.Namespace,
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.DevNetwork,
+				Dev: &ethereumv1alpha1.Dev{
+					MnemonicSecretName: "invalid-mnemonic",
+				},
+			},
+		}
+
+		// hardhat and anvil development accounts derived from default mnemonic
+		accounts := []string{
+			"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+			"0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+		}
+
+		It(fmt.Sprintf("should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.Background(), ns)).Should(Succeed())
+		})
+
+		It("Should create invalid mnemonic secret", func() {
+			secret := corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-mnemonic",
+					Namespace: ns.Name,
+				},
+				StringData: map[string]string{
+					"mnemonic": "test test test test test test test test test test test test",
+				},
+			}
+			Expect(k8sClient.Create(context.Background(), &secret)).To(Succeed())
+		})
+
+		It("Should create the nodes", func() {
+			if !useExistingCluster {
+				toCreate.Default()
+				invalid.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			Expect(k8sClient.Create(context.Background(), invalid)).Should(Succeed())
+			time.Sleep(sleepTime)
+		})
+
+		It("Should report pre-funded accounts derived from mnemonic", func() {
+			fetched := &ethereumv1alpha1.Node{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			Expect(fetched.Status.DevAccounts).To(Equal(accounts))
+		})
+
+		It("Should create coinbase private key secret", func() {
+			secret := &corev1.Secret{}
+			secretKey := types.NamespacedName{Name: fmt.Sprintf("%s-dev", key.Name), Namespace: ns.Name}
+			Expect(k8sClient.Get(context.Background(), secretKey, secret)).To(Succeed())
+			Expect(string(secret.Data["key"])).To(Equal("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"))
+			Expect(secret.Data["password"]).NotTo(BeEmpty())
+		})
+
+		It("Should generate genesis funding pre-funded accounts", func() {
+			configmap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(context.Background(), key, configmap)).To(Succeed())
+			for _, account := range accounts {
+				Expect(strings.ToLower(configmap.Data["genesis.json"])).To(ContainSubstring(strings.ToLower(account[2:])))
+			}
+		})
+
+		It("Should not derive accounts from invalid mnemonic", func() {
+			fetched := &ethereumv1alpha1.Node{}
+			Expect(k8sClient.Get(context.Background(), invalidKey, fetched)).To(Succeed())
+			Expect(fetched.Status.DevAccounts).To(BeEmpty())
+
+			secret := &corev1.Secret{}
+			secretKey := types.NamespacedName{Name: fmt.Sprintf("%s-dev", invalidKey.Name), Namespace: ns.Name}
+			Expect(k8sClient.Get(context.Background(), secretKey, secret)).NotTo(Succeed())
+		})
+
+		It(fmt.Sprintf("should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).Should(Succeed())
+		})
+	})
+
+	Context("advertising p2p load balancer address", func() {
+		newNode := func(client ethereumv1alpha1.EthereumClient) *ethereumv1alpha1.Node {
+			return &ethereumv1alpha1.Node{
+				Spec: ethereumv1alpha1.NodeSpec{
+					Client:  client,
+					Network: "mainnet",
+					P2P: &ethereumv1alpha1.P2P{
+						ServiceType: corev1.ServiceTypeLoadBalancer,
+					},
+				},
+			}
+		}
+
+		It("Should advertise load balancer IP address", func() {
+			for _, client := range []ethereumv1alpha1.EthereumClient{ethereumv1alpha1.GethClient, ethereumv1alpha1.NethermindClient, ethereumv1alpha1.BesuClient} {
+				node := newNode(client)
+				advertiseLoadBalancerAddress(context.Background(), node, "203.0.113.10")
+				Expect(node.Spec.P2P.ExternalIP).To(Equal("203.0.113.10"))
+			}
+		})
+
+		It("Should advertise load balancer hostname by clients supporting hostnames only", func() {
+			besu := newNode(ethereumv1alpha1.BesuClient)
+			advertiseLoadBalancerAddress(context.Background(), besu, "lb.example.com")
+			Expect(besu.Spec.P2P.ExternalHostname).To(Equal("lb.example.com"))
+
+			geth := newNode(ethereumv1alpha1.GethClient)
+			advertiseLoadBalancerAddress(context.Background(), geth, "lb.example.com")
+			Expect(geth.Spec.P2P.ExternalHostname).To(BeEmpty())
+			Expect(geth.Spec.P2P.ExternalIP).To(BeEmpty())
+		})
+
+		It("Should not override provided external address", func() {
+			node := newNode(ethereumv1alpha1.GethClient)
+			node.Spec.P2P.ExternalIP = "198.51.100.7"
+			advertiseLoadBalancerAddress(context.Background(), node, "203.0.113.10")
+			Expect(node.Spec.P2P.ExternalIP).To(Equal("198.51.100.7"))
+		})
+	})
+
+	Context("peers genesis mismatch", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "genesis-mismatch",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "my-node",
+			Namespace: ns.Name,
+		}
+
+		genesis := func(chainID uint) *ethereumv1alpha1.Genesis {
+			return &ethereumv1alpha1.Genesis{
+				ChainID:   chainID,
+				NetworkID: networkID,
+				Ethash:    &ethereumv1alpha1.Ethash{},
+			}
+		}
+
+		newNode := func(name string, chainID uint) *ethereumv1alpha1.Node {
+			return &ethereumv1alpha1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: ns.Name,
+				},
+				Spec: ethereumv1alpha1.NodeSpec{
+					Client:  ethereumv1alpha1.BesuClient,
+					Genesis: genesis(chainID),
+				},
+			}
+		}
+
+		// matching static node, mismatching static node, mismatching bootnode and mismatching bootnode referred to by name.namespace
+		peers := []*ethereumv1alpha1.Node{
+			newNode("matching-static-node", 55555),
+			newNode("mismatching-static-node", 66666),
+			newNode("mismatching-bootnode", 77777),
+			newNode("mismatching-namespaced-bootnode", 88888),
+		}
+
+		toCreate := newNode(key.Name, 55555)
+		toCreate.Spec.StaticNodes = []ethereumv1alpha1.Enode{
+			"matching-static-node",
+			"mismatching-static-node",
+		}
+		toCreate.Spec.Bootnodes = []ethereumv1alpha1.Enode{
+			"mismatching-bootnode",
+			ethereumv1alpha1.Enode(fmt.Sprintf("mismatching-namespaced-bootnode.%s", ns.Name)),
+		}
+
+		It(fmt.Sprintf("should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.Background(), ns)).Should(Succeed())
+		})
+
+		It("Should create peer nodes", func() {
+			for _, peer := range peers {
+				if !useExistingCluster {
+					peer.Default()
+				}
+				Expect(k8sClient.Create(context.Background(), peer)).Should(Succeed())
+			}
+		})
+
+		It("Should create the node", func() {
+			if !useExistingCluster {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+		})
+
+		It("Should report static nodes and bootnodes with different genesis hash", func() {
+			Eventually(func() []string {
+				fetched := &ethereumv1alpha1.Node{}
+				if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+					return []string{err.Error()}
+				}
+				return fetched.Status.GenesisMismatch
+			}, timeout, interval).Should(ConsistOf(
+				fmt.Sprintf("%s/mismatching-static-node", ns.Name),
+				fmt.Sprintf("%s/mismatching-bootnode", ns.Name),
+				fmt.Sprintf("%s/mismatching-namespaced-bootnode", ns.Name),
+			))
+		})
+
+		It(fmt.Sprintf("should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).Should(Succeed())
+		})
+	})
+
+	Context("engine JWT secret name taken by user secret", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "jwt-secret-collision",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "my-engine",
+			Namespace: ns.Name,
+		}
+
+		toCreate := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: "mainnet",
+				Engine:  true,
+			},
+		}
+
+		secretKey := types.NamespacedName{
+			Name:      fmt.Sprintf("%s-jwt", key.Name),
+			Namespace: ns.Name,
+		}
+
+		It(fmt.Sprintf("should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.Background(), ns)).Should(Succeed())
+		})
+
+		It("Should create user secret with generated JWT secret name", func() {
+			secret := corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      secretKey.Name,
+					Namespace: secretKey.Namespace,
+				},
+				StringData: map[string]string{
+					"secret": "user-secret",
+				},
+			}
+			Expect(k8sClient.Create(context.Background(), &secret)).To(Succeed())
+		})
+
+		It("Should create the node", func() {
+			if !useExistingCluster {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(sleepTime)
+		})
+
+		It("Should not adopt user secret", func() {
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(context.Background(), secretKey, secret)).To(Succeed())
+			Expect(secret.GetOwnerReferences()).To(BeEmpty())
+			Expect(string(secret.Data["secret"])).To(Equal("user-secret"))
+
+			fetched := &ethereumv1alpha1.Node{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			Expect(fetched.Status.JWTSecretName).To(BeEmpty())
+		})
+
+		It(fmt.Sprintf("should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).Should(Succeed())
+		})
+	})
+
 })
@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereumClients "github.com/kotalco/kotal/clients/ethereum"
+	"github.com/kotalco/kotal/controllers/shared"
+)
+
+type TesseraJDBC struct {
+	Username         string `json:"username"`
+	Password         string `json:"password"`
+	URL              string `json:"url"`
+	AutoCreateTables bool   `json:"autoCreateTables"`
+}
+
+type TesseraSSLConfig struct {
+	TLS string `json:"tls"`
+}
+
+type TesseraServerConfig struct {
+	App               string            `json:"app"`
+	Enabled           bool              `json:"enabled"`
+	ServerAddress     string            `json:"serverAddress"`
+	BindingAddress    string            `json:"bindingAddress,omitempty"`
+	CommunicationType string            `json:"communicationType"`
+	SSLConfig         *TesseraSSLConfig `json:"sslConfig,omitempty"`
+}
+
+type TesseraPeer struct {
+	URL string `json:"url"`
+}
+
+type TesseraKeyData struct {
+	PrivateKeyPath string `json:"privateKeyPath"`
+	PublicKeyPath  string `json:"publicKeyPath"`
+}
+
+type TesseraKeys struct {
+	Passwords []string         `json:"passwords"`
+	KeyData   []TesseraKeyData `json:"keyData"`
+}
+
+type TesseraConfig struct {
+	Mode          string                `json:"mode"`
+	UseWhiteList  bool                  `json:"useWhiteList"`
+	JDBC          TesseraJDBC           `json:"jdbc"`
+	ServerConfigs []TesseraServerConfig `json:"serverConfigs"`
+	Peers         []TesseraPeer         `json:"peer"`
+	Keys          TesseraKeys           `json:"keys"`
+	AlwaysSendTo  []string              `json:"alwaysSendTo"`
+}
+
+// TesseraP2PURL returns transaction manager p2p url from transaction manager name and namespace
+func TesseraP2PURL(name, namespace string) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d", name, namespace, ethereumClients.TesseraP2PPort)
+}
+
+// TesseraConfigFromSpec generates tessera-config.json file from transaction manager spec
+func TesseraConfigFromSpec(tm *ethereumv1alpha1.TransactionManager, homeDir string) (config string, err error) {
+	peers := []TesseraPeer{}
+	for _, peer := range tm.Spec.Peers {
+		url := peer
+		// peer is a reference to kotal transaction manager in the format of name or name.namespace
+		if !strings.HasPrefix(peer, "http://") && !strings.HasPrefix(peer, "https://") {
+			ref := shared.ParseReference(peer, tm.Namespace)
+			url = TesseraP2PURL(ref.Name, ref.Namespace)
+		}
+		peers = append(peers, TesseraPeer{URL: url})
+	}
+
+	c := TesseraConfig{
+		// orion mode is required by hyperledger besu
+		Mode:         "orion",
+		UseWhiteList: false,
+		JDBC: TesseraJDBC{
+			Username:         "sa",
+			Password:         "",
+			URL:              fmt.Sprintf("jdbc:h2:%s/db;MODE=Oracle;TRACE_LEVEL_SYSTEM_OUT=0", shared.PathData(homeDir)),
+			AutoCreateTables: true,
+		},
+		ServerConfigs: []TesseraServerConfig{
+			{
+				App:               "ThirdParty",
+				Enabled:           true,
+				ServerAddress:     fmt.Sprintf("http://%s:%d", shared.Host(false), ethereumClients.TesseraThirdPartyPort),
+				CommunicationType: "REST",
+			},
+			{
+				App:               "Q2T",
+				Enabled:           true,
+				ServerAddress:     fmt.Sprintf("http://%s:%d", shared.Host(true), ethereumClients.TesseraQ2TPort),
+				CommunicationType: "REST",
+			},
+			{
+				App:               "P2P",
+				Enabled:           true,
+				ServerAddress:     TesseraP2PURL(tm.Name, tm.Namespace),
+				BindingAddress:    fmt.Sprintf("http://%s:%d", shared.Host(true), ethereumClients.TesseraP2PPort),
+				CommunicationType: "REST",
+				SSLConfig: &TesseraSSLConfig{
+					TLS: "OFF",
+				},
+			},
+		},
+		Peers: peers,
+		Keys: TesseraKeys{
+			Passwords: []string{},
+			KeyData: []TesseraKeyData{
+				{
+					PrivateKeyPath: fmt.Sprintf("%s/tm.key", shared.PathSecrets(homeDir)),
+					PublicKeyPath:  fmt.Sprintf("%s/tm.pub", shared.PathSecrets(homeDir)),
+				},
+			},
+		},
+		AlwaysSendTo: []string{},
+	}
+
+	data, err := json.Marshal(&c)
+	if err != nil {
+		return
+	}
+
+	config = string(data)
+	return
+}
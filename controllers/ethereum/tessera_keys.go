@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+type tesseraKeyData struct {
+	Bytes string `json:"bytes"`
+}
+
+type tesseraKey struct {
+	Type string         `json:"type"`
+	Data tesseraKeyData `json:"data"`
+}
+
+// TesseraKeysFromPrivateKey generates tessera unlocked private key file and base64 public key
+// from base64 encoded NaCl private key
+func TesseraKeysFromPrivateKey(key string) (privateKeyFile []byte, publicKey string, err error) {
+	privateKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return
+	}
+
+	if len(privateKey) != curve25519.ScalarSize {
+		err = fmt.Errorf("private key must be %d bytes, got %d", curve25519.ScalarSize, len(privateKey))
+		return
+	}
+
+	publicKeyBytes, err := curve25519.X25519(privateKey, curve25519.Basepoint)
+	if err != nil {
+		return
+	}
+	publicKey = base64.StdEncoding.EncodeToString(publicKeyBytes)
+
+	privateKeyFile, err = json.Marshal(tesseraKey{
+		Type: "unlocked",
+		Data: tesseraKeyData{
+			Bytes: key,
+		},
+	})
+
+	return
+}
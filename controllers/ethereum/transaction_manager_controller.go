@@ -0,0 +1,386 @@
+package controllers
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereumClients "github.com/kotalco/kotal/clients/ethereum"
+	"github.com/kotalco/kotal/controllers/shared"
+)
+
+// TransactionManagerReconciler reconciles a TransactionManager object
+type TransactionManagerReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=transactionmanagers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=transactionmanagers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=watch;get;list;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=secrets;services;configmaps;persistentvolumeclaims,verbs=watch;get;create;update;list;delete
+
+// Reconcile reconciles private transaction managers
+func (r *TransactionManagerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+
+	var tm ethereumv1alpha1.TransactionManager
+
+	if err = r.Client.Get(ctx, req.NamespacedName, &tm); err != nil {
+		err = client.IgnoreNotFound(err)
+		return
+	}
+
+	// default the transaction manager if webhooks are disabled
+	if !shared.IsWebhookEnabled() {
+		tm.Default()
+	}
+
+	shared.UpdateLabels(&tm, string(tm.Spec.Client))
+
+	var publicKey string
+	if publicKey, err = r.reconcileSecret(ctx, &tm); err != nil {
+		return
+	}
+
+	if err = r.reconcilePVC(ctx, &tm); err != nil {
+		return
+	}
+
+	if err = r.reconcileConfigmap(ctx, &tm); err != nil {
+		return
+	}
+
+	if err = r.reconcileService(ctx, &tm); err != nil {
+		return
+	}
+
+	if err = r.reconcileStatefulSet(ctx, &tm); err != nil {
+		return
+	}
+
+	if err = r.updateStatus(ctx, &tm, publicKey); err != nil {
+		return
+	}
+
+	return
+}
+
+// updateStatus updates transaction manager status
+func (r *TransactionManagerReconciler) updateStatus(ctx context.Context, tm *ethereumv1alpha1.TransactionManager, publicKey string) error {
+	tm.Status.Client = string(tm.Spec.Client)
+	tm.Status.PublicKey = publicKey
+
+	if err := r.Status().Update(ctx, tm); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update transaction manager status")
+		return err
+	}
+
+	return nil
+}
+
+// specSecret updates transaction manager keys secret
+func (r *TransactionManagerReconciler) specSecret(tm *ethereumv1alpha1.TransactionManager, secret *corev1.Secret, privateKeyFile []byte, publicKey string) {
+	secret.ObjectMeta.Labels = tm.GetLabels()
+	secret.Data = map[string][]byte{
+		"tm.key": privateKeyFile,
+		"tm.pub": []byte(publicKey),
+	}
+}
+
+// reconcileSecret creates transaction manager keys secret from private key secret
+// the public key is mounted by ethereum nodes using this transaction manager
+func (r *TransactionManagerReconciler) reconcileSecret(ctx context.Context, tm *ethereumv1alpha1.TransactionManager) (publicKey string, err error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tm.Name,
+			Namespace: tm.Namespace,
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      tm.Spec.PrivateKeySecretName,
+		Namespace: tm.Namespace,
+	}
+
+	privateKey, err := shared.GetSecret(ctx, r.Client, key, "key")
+	if err != nil {
+		return
+	}
+
+	privateKeyFile, publicKey, err := TesseraKeysFromPrivateKey(privateKey)
+	if err != nil {
+		return
+	}
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := ctrl.SetControllerReference(tm, secret, r.Scheme); err != nil {
+			return err
+		}
+		r.specSecret(tm, secret, privateKeyFile, publicKey)
+		return nil
+	})
+
+	return
+}
+
+// specConfigmap updates transaction manager configmap
+func (r *TransactionManagerReconciler) specConfigmap(tm *ethereumv1alpha1.TransactionManager, configmap *corev1.ConfigMap, config string) {
+	configmap.ObjectMeta.Labels = tm.GetLabels()
+
+	if configmap.Data == nil {
+		configmap.Data = map[string]string{}
+	}
+
+	configmap.Data["tessera-config.json"] = config
+}
+
+// reconcileConfigmap reconciles transaction manager configmap
+func (r *TransactionManagerReconciler) reconcileConfigmap(ctx context.Context, tm *ethereumv1alpha1.TransactionManager) error {
+	configmap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tm.Name,
+			Namespace: tm.Namespace,
+		},
+	}
+
+	client, err := ethereumClients.NewTransactionManagerClient(tm)
+	if err != nil {
+		return err
+	}
+
+	config, err := TesseraConfigFromSpec(tm, client.HomeDir())
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, configmap, func() error {
+		if err := ctrl.SetControllerReference(tm, configmap, r.Scheme); err != nil {
+			return err
+		}
+		r.specConfigmap(tm, configmap, config)
+		return nil
+	})
+
+	return err
+}
+
+// specPVC updates transaction manager data pvc spec
+func (r *TransactionManagerReconciler) specPVC(tm *ethereumv1alpha1.TransactionManager, pvc *corev1.PersistentVolumeClaim) {
+	request := corev1.ResourceList{
+		corev1.ResourceStorage: resource.MustParse(tm.Spec.Resources.Storage),
+	}
+
+	// spec is immutable after creation except resources.requests for bound claims
+	if !pvc.CreationTimestamp.IsZero() {
+		pvc.Spec.Resources.Requests = request
+		return
+	}
+
+	pvc.ObjectMeta.Labels = tm.GetLabels()
+	pvc.Spec = corev1.PersistentVolumeClaimSpec{
+		AccessModes: []corev1.PersistentVolumeAccessMode{
+			corev1.ReadWriteOnce,
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: request,
+		},
+		StorageClassName: tm.Spec.Resources.StorageClass,
+	}
+}
+
+// reconcilePVC reconciles transaction manager data pvc
+func (r *TransactionManagerReconciler) reconcilePVC(ctx context.Context, tm *ethereumv1alpha1.TransactionManager) error {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tm.Name,
+			Namespace: tm.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, pvc, func() error {
+		if err := ctrl.SetControllerReference(tm, pvc, r.Scheme); err != nil {
+			return err
+		}
+		r.specPVC(tm, pvc)
+		return nil
+	})
+
+	return err
+}
+
+// specService updates transaction manager service spec
+func (r *TransactionManagerReconciler) specService(tm *ethereumv1alpha1.TransactionManager, svc *corev1.Service) {
+	labels := tm.GetLabels()
+
+	svc.ObjectMeta.Labels = labels
+	svc.Spec.Ports = []corev1.ServicePort{
+		{
+			Name:       "p2p",
+			Port:       int32(ethereumClients.TesseraP2PPort),
+			TargetPort: intstr.FromInt(int(ethereumClients.TesseraP2PPort)),
+			Protocol:   corev1.ProtocolTCP,
+		},
+		{
+			Name:       "q2t",
+			Port:       int32(ethereumClients.TesseraQ2TPort),
+			TargetPort: intstr.FromInt(int(ethereumClients.TesseraQ2TPort)),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+
+	svc.Spec.Selector = labels
+}
+
+// reconcileService reconciles transaction manager service
+func (r *TransactionManagerReconciler) reconcileService(ctx context.Context, tm *ethereumv1alpha1.TransactionManager) error {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tm.Name,
+			Namespace: tm.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, svc, func() error {
+		if err := ctrl.SetControllerReference(tm, svc, r.Scheme); err != nil {
+			return err
+		}
+		r.specService(tm, svc)
+		return nil
+	})
+
+	return err
+}
+
+// specStatefulset updates transaction manager statefulset spec
+func (r *TransactionManagerReconciler) specStatefulset(tm *ethereumv1alpha1.TransactionManager, sts *appsv1.StatefulSet, homeDir string, env []corev1.EnvVar, command, args []string) {
+	labels := tm.GetLabels()
+
+	sts.ObjectMeta.Labels = labels
+	if sts.Spec.Selector == nil {
+		sts.Spec.Selector = &metav1.LabelSelector{}
+	}
+	sts.Spec.ServiceName = tm.Name
+	sts.Spec.Selector.MatchLabels = labels
+	sts.Spec.Template.ObjectMeta.Labels = labels
+	sts.Spec.Template.Spec = corev1.PodSpec{
+		SecurityContext: shared.SecurityContext(),
+		Containers: []corev1.Container{
+			{
+				Name:    "transaction-manager",
+				Image:   tm.Spec.Image,
+				Command: command,
+				Env:     env,
+				Args:    args,
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "data",
+						MountPath: shared.PathData(homeDir),
+					},
+					{
+						Name:      "config",
+						MountPath: shared.PathConfig(homeDir),
+						ReadOnly:  true,
+					},
+					{
+						Name:      "secrets",
+						MountPath: shared.PathSecrets(homeDir),
+						ReadOnly:  true,
+					},
+				},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(tm.Spec.CPU),
+						corev1.ResourceMemory: resource.MustParse(tm.Spec.Memory),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(tm.Spec.CPULimit),
+						corev1.ResourceMemory: resource.MustParse(tm.Spec.MemoryLimit),
+					},
+				},
+			},
+		},
+		Volumes: []corev1.Volume{
+			{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: tm.Name,
+					},
+				},
+			},
+			{
+				Name: "config",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: tm.Name,
+						},
+					},
+				},
+			},
+			{
+				Name: "secrets",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: tm.Name,
+					},
+				},
+			},
+		},
+	}
+}
+
+// reconcileStatefulSet reconciles transaction manager statefulset
+func (r *TransactionManagerReconciler) reconcileStatefulSet(ctx context.Context, tm *ethereumv1alpha1.TransactionManager) error {
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tm.Name,
+			Namespace: tm.Namespace,
+		},
+	}
+
+	client, err := ethereumClients.NewTransactionManagerClient(tm)
+	if err != nil {
+		return err
+	}
+
+	homeDir := client.HomeDir()
+	command := client.Command()
+	env := client.Env()
+	args := client.Args()
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, sts, func() error {
+		if err := ctrl.SetControllerReference(tm, sts, r.Scheme); err != nil {
+			return err
+		}
+		r.specStatefulset(tm, sts, homeDir, env, command, args)
+		return nil
+	})
+
+	return err
+}
+
+// SetupWithManager adds reconciler to the manager
+func (r *TransactionManagerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	pred := predicate.GenerationChangedPredicate{}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ethereumv1alpha1.TransactionManager{}).
+		WithEventFilter(pred).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&corev1.Service{}).
+		Owns(&corev1.Secret{}).
+		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&corev1.ConfigMap{}).
+		Complete(r)
+}
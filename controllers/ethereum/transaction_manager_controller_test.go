@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereumClients "github.com/kotalco/kotal/clients/ethereum"
+	"github.com/kotalco/kotal/controllers/shared"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("Ethereum transaction manager controller", func() {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tessera",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "my-tessera",
+		Namespace: ns.Name,
+	}
+
+	// WARNING: DON'T use the following private key in production
+	privateKey := "ZjlcbcNH2ljHpx9ZArECTMUbT5ewBJuKFjMtnyoGLPM="
+	publicKey := "HtGBy+fnl8wdO52QFZkLC8mAGfrugUAbJE6FGKH2wQY="
+
+	spec := ethereumv1alpha1.TransactionManagerSpec{
+		Client:               ethereumv1alpha1.TesseraTransactionManager,
+		PrivateKeySecretName: "tessera-privatekey",
+	}
+
+	toCreate := &ethereumv1alpha1.TransactionManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: spec,
+	}
+
+	t := true
+
+	tmOwnerReference := metav1.OwnerReference{
+		APIVersion:         "ethereum.kotal.io/v1alpha1",
+		Kind:               "TransactionManager",
+		Name:               toCreate.Name,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+	})
+
+	It("should create transaction manager privatekey secret", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tessera-privatekey",
+				Namespace: ns.Name,
+			},
+			StringData: map[string]string{
+				"key": privateKey,
+			},
+		}
+
+		Expect(k8sClient.Create(context.TODO(), secret)).To(Succeed())
+	})
+
+	It("should create transaction manager", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			toCreate.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+	})
+
+	It("should get transaction manager", func() {
+		fetched := &ethereumv1alpha1.TransactionManager{}
+		Expect(k8sClient.Get(context.TODO(), key, fetched)).To(Succeed())
+		Expect(fetched.Spec).To(Equal(toCreate.Spec))
+		tmOwnerReference.UID = fetched.UID
+		time.Sleep(5 * time.Second)
+	})
+
+	It("should report transaction manager public key in status", func() {
+		fetched := &ethereumv1alpha1.TransactionManager{}
+		Expect(k8sClient.Get(context.TODO(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.Client).To(Equal(string(ethereumv1alpha1.TesseraTransactionManager)))
+		Expect(fetched.Status.PublicKey).To(Equal(publicKey))
+	})
+
+	It("Should create transaction manager keys secret", func() {
+		fetched := &corev1.Secret{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.OwnerReferences).To(ContainElements(tmOwnerReference))
+		Expect(fetched.Data).To(HaveKey("tm.key"))
+		Expect(string(fetched.Data["tm.pub"])).To(Equal(publicKey))
+	})
+
+	It("Should create transaction manager statefulset with correct image and arguments", func() {
+		fetched := &appsv1.StatefulSet{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.OwnerReferences).To(ContainElements(tmOwnerReference))
+		container := fetched.Spec.Template.Spec.Containers[0]
+		Expect(container.Image).To(Equal(ethereumv1alpha1.DefaultTesseraImage))
+		Expect(container.Args).To(ContainElements(
+			ethereumClients.TesseraConfigFile,
+			fmt.Sprintf("%s/tessera-config.json", shared.PathConfig(ethereumClients.TesseraHomeDir)),
+		))
+	})
+
+	It("Should allocate correct resources to transaction manager statefulset", func() {
+		fetched := &appsv1.StatefulSet{}
+		expectedResources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(ethereumv1alpha1.DefaultTransactionManagerCPURequest),
+				corev1.ResourceMemory: resource.MustParse(ethereumv1alpha1.DefaultTransactionManagerMemoryRequest),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(ethereumv1alpha1.DefaultTransactionManagerCPULimit),
+				corev1.ResourceMemory: resource.MustParse(ethereumv1alpha1.DefaultTransactionManagerMemoryLimit),
+			},
+		}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Spec.Template.Spec.Containers[0].Resources).To(Equal(expectedResources))
+	})
+
+	It("Should create transaction manager configmap", func() {
+		fetched := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.OwnerReferences).To(ContainElements(tmOwnerReference))
+		Expect(fetched.Data).To(HaveKey("tessera-config.json"))
+	})
+
+	It("Should create transaction manager data persistent volume with correct resources", func() {
+		fetched := &corev1.PersistentVolumeClaim{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.OwnerReferences).To(ContainElements(tmOwnerReference))
+
+		expectedResources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse(ethereumv1alpha1.DefaultTransactionManagerStorageRequest),
+			},
+		}
+		Expect(fetched.Spec.Resources).To(Equal(expectedResources))
+	})
+
+	It("Should create transaction manager service", func() {
+		fetched := &corev1.Service{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.OwnerReferences).To(ContainElements(tmOwnerReference))
+		Expect(fetched.Spec.Ports).To(ContainElements(
+			[]corev1.ServicePort{
+				{
+					Name:       "p2p",
+					Port:       int32(ethereumClients.TesseraP2PPort),
+					TargetPort: intstr.FromInt(int(ethereumClients.TesseraP2PPort)),
+					Protocol:   corev1.ProtocolTCP,
+				},
+				{
+					Name:       "q2t",
+					Port:       int32(ethereumClients.TesseraQ2TPort),
+					TargetPort: intstr.FromInt(int(ethereumClients.TesseraQ2TPort)),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
@@ -0,0 +1,353 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereumClients "github.com/kotalco/kotal/clients/ethereum"
+	"github.com/kotalco/kotal/controllers/shared"
+	"github.com/kotalco/kotal/gateway"
+	"github.com/kotalco/kotal/helpers"
+)
+
+// ValidatorProposalReconciler reconciles a ValidatorProposal object
+type ValidatorProposalReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// proposalRequeueInterval is the interval votes are checked until the proposal is applied
+const proposalRequeueInterval = 15 * time.Second
+
+// gatewayTokenTTL is the lifetime of JWT tokens signed to call nodes through their gateway
+const gatewayTokenTTL = 5 * time.Minute
+
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=validatorproposals,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=validatorproposals/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile votes on signers or validators proposals until they're applied on chain
+func (r *ValidatorProposalReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+
+	var proposal ethereumv1alpha1.ValidatorProposal
+
+	if err = r.Client.Get(ctx, req.NamespacedName, &proposal); err != nil {
+		err = client.IgnoreNotFound(err)
+		return
+	}
+
+	// applied proposals are final
+	if proposal.Status.Phase == ethereumv1alpha1.ProposalApplied {
+		return
+	}
+
+	proposal.Status.UnresolvedReferences = nil
+	proposal.Status.Message = ""
+
+	nodes, err := r.getNodes(ctx, &proposal)
+	if err != nil {
+		return
+	}
+
+	if err := r.vote(ctx, &proposal, nodes); err != nil {
+		log.FromContext(ctx).Error(err, "unable to vote on proposal")
+		proposal.Status.Message = err.Error()
+	}
+
+	if err = r.updateStatus(ctx, &proposal); err != nil {
+		return
+	}
+
+	if proposal.Status.Phase != ethereumv1alpha1.ProposalApplied {
+		result.RequeueAfter = proposalRequeueInterval
+	}
+
+	return
+}
+
+// updateStatus updates validator proposal status
+func (r *ValidatorProposalReconciler) updateStatus(ctx context.Context, proposal *ethereumv1alpha1.ValidatorProposal) error {
+	if proposal.Status.Phase == "" {
+		proposal.Status.Phase = ethereumv1alpha1.ProposalPending
+	}
+
+	if err := r.Status().Update(ctx, proposal); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update validator proposal status")
+		return err
+	}
+
+	return nil
+}
+
+// getNodes returns proposal nodes, nodes that couldn't be found are reported in status
+func (r *ValidatorProposalReconciler) getNodes(ctx context.Context, proposal *ethereumv1alpha1.ValidatorProposal) (nodes []ethereumv1alpha1.Node, err error) {
+	for _, ref := range proposal.Spec.Nodes {
+		// nodes are resolved in proposal namespace only
+		key := types.NamespacedName{Name: ref, Namespace: proposal.Namespace}
+
+		node := ethereumv1alpha1.Node{}
+		if err = r.Client.Get(ctx, key, &node); err != nil {
+			if apierrors.IsNotFound(err) {
+				proposal.Status.UnresolvedReferences = append(proposal.Status.UnresolvedReferences, ref)
+				err = nil
+				continue
+			}
+			return
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return
+}
+
+// gatewayToken returns credential used to call node JSON-RPC server through its gateway
+// first API key is used if API keys are provided, otherwise short lived JWT token is signed
+func (r *ValidatorProposalReconciler) gatewayToken(ctx context.Context, node *ethereumv1alpha1.Node) (string, error) {
+	if node.Spec.Gateway == nil {
+		return "", nil
+	}
+
+	if name := node.Spec.Gateway.APIKeysSecretName; name != "" {
+		secret := &corev1.Secret{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: node.Namespace}, secret); err != nil {
+			return "", err
+		}
+
+		owners := []string{}
+		for owner := range secret.Data {
+			owners = append(owners, owner)
+		}
+		if len(owners) == 0 {
+			return "", fmt.Errorf("node %s gateway api keys secret %s is empty", node.Name, name)
+		}
+		sort.Strings(owners)
+
+		return strings.TrimSpace(string(secret.Data[owners[0]])), nil
+	}
+
+	key := types.NamespacedName{
+		Name:      node.Spec.Gateway.JWTSecretName,
+		Namespace: node.Namespace,
+	}
+
+	jwtSecret, err := shared.GetSecret(ctx, r.Client, key, "secret")
+	if err != nil {
+		return "", err
+	}
+
+	return gateway.SignToken([]byte(strings.TrimSpace(jwtSecret)), "kotal", gatewayTokenTTL)
+}
+
+// governanceClient returns governance client calling node JSON-RPC server
+func (r *ValidatorProposalReconciler) governanceClient(ctx context.Context, node *ethereumv1alpha1.Node) (ethereumClients.GovernanceClient, error) {
+	if node.Spec.Genesis == nil {
+		return nil, fmt.Errorf("node %s is not running private network", node.Name)
+	}
+
+	api := ethereumv1alpha1.CliqueAPI
+	if node.Spec.Genesis.IBFT2 != nil {
+		api = ethereumv1alpha1.IBFTAPI
+	}
+
+	if !node.Spec.RPC {
+		return nil, fmt.Errorf("node %s doesn't enable rpc", node.Name)
+	}
+
+	enabled := false
+	for _, rpcAPI := range node.Spec.RPCAPI {
+		if rpcAPI == api {
+			enabled = true
+		}
+	}
+
+	if !enabled {
+		return nil, fmt.Errorf("node %s doesn't enable %s rpc api", node.Name, api)
+	}
+
+	endpoint := fmt.Sprintf("http://%s.%s.svc:%d", node.Name, node.Namespace, node.Spec.RPCPort)
+
+	token, err := r.gatewayToken(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethereumClients.NewGovernanceClient(node, endpoint, token)
+}
+
+// signerAddress returns node signer or validator address
+// besu signs blocks using node private key, other clients sign blocks using imported coinbase account
+func (r *ValidatorProposalReconciler) signerAddress(ctx context.Context, node *ethereumv1alpha1.Node) (string, error) {
+	if node.Spec.Client == ethereumv1alpha1.BesuClient && node.Spec.NodePrivateKeySecretName != "" {
+		key := types.NamespacedName{
+			Name:      node.Spec.NodePrivateKeySecretName,
+			Namespace: node.Namespace,
+		}
+
+		nodekey, err := shared.GetSecret(ctx, r.Client, key, "key")
+		if err != nil {
+			return "", err
+		}
+
+		return helpers.DeriveAddress(nodekey)
+	}
+
+	return string(node.Spec.Coinbase), nil
+}
+
+// containsAddress returns true if address is in the addresses list
+func containsAddress(addresses []string, address string) bool {
+	for _, a := range addresses {
+		if strings.EqualFold(a, address) {
+			return true
+		}
+	}
+	return false
+}
+
+// vote calls proposal JSON-RPC method on nodes that are current signers or validators
+// votes are discarded once the proposal is applied, so nodes don't vote on the address again
+func (r *ValidatorProposalReconciler) vote(ctx context.Context, proposal *ethereumv1alpha1.ValidatorProposal, nodes []ethereumv1alpha1.Node) error {
+	if len(nodes) == 0 {
+		return errors.New("no nodes found to vote on the proposal")
+	}
+
+	governanceClients := []ethereumClients.GovernanceClient{}
+	for i := range nodes {
+		governance, err := r.governanceClient(ctx, &nodes[i])
+		if err != nil {
+			return err
+		}
+		governanceClients = append(governanceClients, governance)
+	}
+
+	proposal.Status.Consensus = "clique"
+	if nodes[0].Spec.Genesis.IBFT2 != nil {
+		proposal.Status.Consensus = "ibft2"
+	}
+
+	// any node can report current signers or validators
+	var validators []string
+	var err error
+	for _, governance := range governanceClients {
+		if validators, err = governance.Validators(ctx); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	proposal.Status.Validators = len(validators)
+
+	address := string(proposal.Spec.Address)
+	add := proposal.Spec.Action == ethereumv1alpha1.AddValidatorAction
+
+	if containsAddress(validators, address) == add {
+		for i, governance := range governanceClients {
+			if err := governance.Discard(ctx, address); err != nil {
+				log.FromContext(ctx).Error(err, "unable to discard vote", "node", nodes[i].Name)
+			}
+		}
+		proposal.Status.Phase = ethereumv1alpha1.ProposalApplied
+		return nil
+	}
+
+	voters := []string{}
+	for i, governance := range governanceClients {
+		node := nodes[i]
+		signer, err := r.signerAddress(ctx, &node)
+		if err != nil {
+			return err
+		}
+		if !containsAddress(validators, signer) {
+			continue
+		}
+		if err := governance.Propose(ctx, address, add); err != nil {
+			return err
+		}
+		voters = append(voters, types.NamespacedName{Name: node.Name, Namespace: node.Namespace}.String())
+	}
+
+	proposal.Status.Voters = voters
+
+	if len(voters) == 0 {
+		return errors.New("none of the nodes is current signer or validator")
+	}
+
+	proposal.Status.Phase = ethereumv1alpha1.ProposalVoting
+
+	return nil
+}
+
+// proposalNodes returns namespaced names of nodes voting on the proposal
+func proposalNodes(obj client.Object) []string {
+	proposal := obj.(*ethereumv1alpha1.ValidatorProposal)
+	references := []string{}
+
+	for _, ref := range proposal.Spec.Nodes {
+		references = append(references, types.NamespacedName{Name: ref, Namespace: proposal.Namespace}.String())
+	}
+
+	return references
+}
+
+// referencingProposals returns reconcile requests for proposals voted on by the given node
+func (r *ValidatorProposalReconciler) referencingProposals(obj client.Object) []reconcile.Request {
+	var proposals ethereumv1alpha1.ValidatorProposalList
+
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}.String()
+
+	if err := r.Client.List(context.Background(), &proposals, client.MatchingFields{shared.ReferencesIndexKey: key}); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, proposal := range proposals.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      proposal.Name,
+				Namespace: proposal.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
+// SetupWithManager adds reconciler to the manager
+func (r *ValidatorProposalReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &ethereumv1alpha1.ValidatorProposal{}, shared.ReferencesIndexKey, proposalNodes); err != nil {
+		return err
+	}
+
+	pred := predicate.GenerationChangedPredicate{}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ethereumv1alpha1.ValidatorProposal{}, builder.WithPredicates(pred)).
+		Watches(
+			&source.Kind{Type: &ethereumv1alpha1.Node{}},
+			handler.EnqueueRequestsFromMapFunc(r.referencingProposals),
+			builder.WithPredicates(pred),
+		).
+		Complete(r)
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Ethereum validator proposal controller", func() {
+
+	const (
+		interval = 2 * time.Second
+		timeout  = 2 * time.Minute
+	)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "validator-proposal",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "add-signer",
+		Namespace: ns.Name,
+	}
+
+	toCreate := &ethereumv1alpha1.ValidatorProposal{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: ethereumv1alpha1.ValidatorProposalSpec{
+			Nodes:   []string{"signer-node"},
+			Address: "0x48c5F25a884116d58A6287B72C9b069F936C9489",
+			Action:  ethereumv1alpha1.AddValidatorAction,
+		},
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+	})
+
+	It("should create validator proposal", func() {
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+	})
+
+	It("should report unresolved nodes in status", func() {
+		Eventually(func() []string {
+			fetched := &ethereumv1alpha1.ValidatorProposal{}
+			if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+				return []string{err.Error()}
+			}
+			return fetched.Status.UnresolvedReferences
+		}, timeout, interval).Should(ConsistOf("signer-node"))
+
+		fetched := &ethereumv1alpha1.ValidatorProposal{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.Phase).To(Equal(ethereumv1alpha1.ProposalPending))
+	})
+
+	It("should create public network node", func() {
+		node := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "signer-node",
+				Namespace: ns.Name,
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.GoerliNetwork,
+			},
+		}
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			node.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), node)).To(Succeed())
+	})
+
+	It("should report nodes not running private network", func() {
+		Eventually(func() string {
+			fetched := &ethereumv1alpha1.ValidatorProposal{}
+			if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+				return err.Error()
+			}
+			return fetched.Status.Message
+		}, timeout, interval).Should(Equal("node signer-node is not running private network"))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
@@ -9,10 +9,19 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
 	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
 	"github.com/kotalco/kotal/controllers/shared"
@@ -28,6 +37,8 @@ type BeaconNodeReconciler struct {
 // +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=beaconnodes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=watch;get;list;create;update;delete
 // +kubebuilder:rbac:groups=core,resources=services;persistentvolumeclaims,verbs=watch;get;create;update;list;delete
+// +kubebuilder:rbac:groups=ethereum.kotal.io,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=mevboosts,verbs=get;list;watch
 
 // Reconcile reconciles Ethereum 2.0 beacon node
 func (r *BeaconNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
@@ -45,6 +56,18 @@ func (r *BeaconNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	shared.UpdateLabels(&node, string(node.Spec.Client))
 
+	if node.Spec.ExecutionEngineRef != "" {
+		if err = r.resolveExecutionEngine(ctx, &node); err != nil {
+			return
+		}
+	}
+
+	if node.Spec.Builder != nil && node.Spec.Builder.MEVBoostRef != "" {
+		if err = r.resolveMEVBoost(ctx, &node); err != nil {
+			return
+		}
+	}
+
 	if err = r.reconcilePVC(ctx, &node); err != nil {
 		return
 	}
@@ -57,9 +80,88 @@ func (r *BeaconNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return
 	}
 
+	if err = r.updateStatus(ctx, &node); err != nil {
+		return
+	}
+
 	return
 }
 
+// updateStatus updates beacon node status
+func (r *BeaconNodeReconciler) updateStatus(ctx context.Context, node *ethereum2v1alpha1.BeaconNode) error {
+	node.Status.ExecutionEngine = ""
+	if node.Spec.ExecutionEngineRef != "" {
+		node.Status.ExecutionEngine = types.NamespacedName{Name: node.Spec.ExecutionEngineRef, Namespace: node.Namespace}.String()
+	}
+	node.Status.ExecutionEngineEndpoint = node.Spec.ExecutionEngineEndpoint
+
+	node.Status.MEVBoost = ""
+	node.Status.BuilderEndpoint = ""
+	if node.Spec.Builder != nil {
+		if node.Spec.Builder.MEVBoostRef != "" {
+			node.Status.MEVBoost = types.NamespacedName{Name: node.Spec.Builder.MEVBoostRef, Namespace: node.Namespace}.String()
+		}
+		node.Status.BuilderEndpoint = node.Spec.Builder.Endpoint
+	}
+
+	if err := r.Status().Update(ctx, node); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update beacon node status")
+		return err
+	}
+
+	return nil
+}
+
+// resolveExecutionEngine resolves execution engine endpoint and JWT secret from referenced execution engine node
+// execution engine node is resolved in beacon node namespace only, so its JWT secret can be mounted directly
+func (r *BeaconNodeReconciler) resolveExecutionEngine(ctx context.Context, node *ethereum2v1alpha1.BeaconNode) error {
+	key := types.NamespacedName{Name: node.Spec.ExecutionEngineRef, Namespace: node.Namespace}
+
+	engine := &ethereumv1alpha1.Node{}
+	if err := r.Client.Get(ctx, key, engine); err != nil {
+		return err
+	}
+
+	if !engine.Spec.Engine {
+		return fmt.Errorf("execution engine %s doesn't enable engine API", key)
+	}
+
+	node.Spec.ExecutionEngineEndpoint = fmt.Sprintf("http://%s.%s.svc:%d", engine.Name, engine.Namespace, engine.Spec.EnginePort)
+
+	// user provided JWT secret
+	if node.Spec.JWTSecretName != "" {
+		return nil
+	}
+
+	// execution engine JWT secret, provided or generated
+	if engine.Status.JWTSecretName == "" {
+		return fmt.Errorf("execution engine %s JWT secret is not ready", key)
+	}
+
+	node.Spec.JWTSecretName = engine.Status.JWTSecretName
+
+	return nil
+}
+
+// resolveMEVBoost resolves external block builder endpoint from referenced mev-boost
+// mev-boost is resolved in beacon node namespace only
+func (r *BeaconNodeReconciler) resolveMEVBoost(ctx context.Context, node *ethereum2v1alpha1.BeaconNode) error {
+	key := types.NamespacedName{Name: node.Spec.Builder.MEVBoostRef, Namespace: node.Namespace}
+
+	boost := &ethereum2v1alpha1.MEVBoost{}
+	if err := r.Client.Get(ctx, key, boost); err != nil {
+		return err
+	}
+
+	if boost.Spec.Network != node.Spec.Network {
+		return fmt.Errorf("mev-boost %s network %s doesn't match beacon node network %s", key, boost.Spec.Network, node.Spec.Network)
+	}
+
+	node.Spec.Builder.Endpoint = fmt.Sprintf("http://%s.%s.svc:%d", boost.Name, boost.Namespace, boost.Spec.Port)
+
+	return nil
+}
+
 // reconcileService reconciles beacon node service
 func (r *BeaconNodeReconciler) reconcileService(ctx context.Context, node *ethereum2v1alpha1.BeaconNode) error {
 	svc := corev1.Service{
@@ -258,6 +360,38 @@ func (r *BeaconNodeReconciler) nodeVolumes(node *ethereum2v1alpha1.BeaconNode) (
 		},
 	})
 
+	// custom network genesis files
+	if node.Spec.Genesis != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: "genesis",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: node.Spec.Genesis.ConfigMapName,
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  ethereum2Clients.GenesisConfigFile,
+							Path: ethereum2Clients.GenesisConfigFile,
+						},
+						{
+							Key:  ethereum2Clients.GenesisStateFile,
+							Path: ethereum2Clients.GenesisStateFile,
+						},
+						{
+							Key:  ethereum2Clients.GenesisDeployBlockFile,
+							Path: ethereum2Clients.GenesisDeployBlockFile,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	if node.Spec.Gateway != nil {
+		volumes = append(volumes, shared.GatewayVolumes(node.Spec.Gateway)...)
+	}
+
 	return
 }
 
@@ -285,6 +419,15 @@ func (r *BeaconNodeReconciler) nodeVolumeMounts(node *ethereum2v1alpha1.BeaconNo
 	}
 	mounts = append(mounts, secretMount)
 
+	if node.Spec.Genesis != nil {
+		genesisMount := corev1.VolumeMount{
+			Name:      "genesis",
+			MountPath: shared.PathConfig(homeDir),
+			ReadOnly:  true,
+		}
+		mounts = append(mounts, genesisMount)
+	}
+
 	return
 }
 
@@ -335,6 +478,38 @@ func (r *BeaconNodeReconciler) specStatefulset(node *ethereum2v1alpha1.BeaconNod
 		}
 	}
 
+	containers := []corev1.Container{
+		{
+			Name:         "node",
+			Command:      command,
+			Args:         args,
+			Image:        node.Spec.Image,
+			VolumeMounts: volumeMounts,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(node.Spec.Resources.CPU),
+					corev1.ResourceMemory: resource.MustParse(node.Spec.Resources.Memory),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(node.Spec.Resources.CPULimit),
+					corev1.ResourceMemory: resource.MustParse(node.Spec.Resources.MemoryLimit),
+				},
+			},
+		},
+	}
+
+	// gateway listens on node rest port, and proxies requests to localhost rest server
+	if node.Spec.Gateway != nil {
+		routes := []shared.GatewayRoute{
+			{
+				Name:     "rest",
+				Port:     node.Spec.RESTPort,
+				Upstream: fmt.Sprintf("http://127.0.0.1:%d", shared.GatewayRESTUpstreamPort),
+			},
+		}
+		containers = append(containers, shared.GatewayContainer(node.Spec.Gateway, shared.GatewayRESTProtocol, routes))
+	}
+
 	sts.Spec = appsv1.StatefulSetSpec{
 		Selector: &metav1.LabelSelector{
 			MatchLabels: node.GetLabels(),
@@ -346,37 +521,81 @@ func (r *BeaconNodeReconciler) specStatefulset(node *ethereum2v1alpha1.BeaconNod
 			Spec: corev1.PodSpec{
 				SecurityContext: shared.SecurityContext(),
 				InitContainers:  initContainers,
-				Containers: []corev1.Container{
-					{
-						Name:         "node",
-						Command:      command,
-						Args:         args,
-						Image:        node.Spec.Image,
-						VolumeMounts: volumeMounts,
-						Resources: corev1.ResourceRequirements{
-							Requests: corev1.ResourceList{
-								corev1.ResourceCPU:    resource.MustParse(node.Spec.Resources.CPU),
-								corev1.ResourceMemory: resource.MustParse(node.Spec.Resources.Memory),
-							},
-							Limits: corev1.ResourceList{
-								corev1.ResourceCPU:    resource.MustParse(node.Spec.Resources.CPULimit),
-								corev1.ResourceMemory: resource.MustParse(node.Spec.Resources.MemoryLimit),
-							},
-						},
-					},
-				},
-				Volumes: volumes,
+				Containers:      containers,
+				Volumes:         volumes,
 			},
 		},
 	}
 }
 
+// beaconNodeReferences returns namespaced names of execution engine node and mev-boost referenced by beacon node
+func beaconNodeReferences(obj client.Object) (refs []string) {
+	node := obj.(*ethereum2v1alpha1.BeaconNode)
+
+	if node.Spec.ExecutionEngineRef != "" {
+		refs = append(refs, types.NamespacedName{Name: node.Spec.ExecutionEngineRef, Namespace: node.Namespace}.String())
+	}
+
+	if node.Spec.Builder != nil && node.Spec.Builder.MEVBoostRef != "" {
+		refs = append(refs, types.NamespacedName{Name: node.Spec.Builder.MEVBoostRef, Namespace: node.Namespace}.String())
+	}
+
+	return
+}
+
+// pairedBeaconNodes returns reconcile requests for beacon nodes referencing the given execution engine node or mev-boost
+func (r *BeaconNodeReconciler) pairedBeaconNodes(obj client.Object) []reconcile.Request {
+	var nodes ethereum2v1alpha1.BeaconNodeList
+
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}.String()
+
+	if err := r.Client.List(context.Background(), &nodes, client.MatchingFields{shared.ReferencesIndexKey: key}); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, node := range nodes.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      node.Name,
+				Namespace: node.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
 // SetupWithManager adds reconciler to the manager
 func (r *BeaconNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &ethereum2v1alpha1.BeaconNode{}, shared.ReferencesIndexKey, beaconNodeReferences); err != nil {
+		return err
+	}
+
+	// execution engine endpoint depends on spec, JWT secret is reported in status
+	enginePred := predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return e.ObjectOld.(*ethereumv1alpha1.Node).Status.JWTSecretName != e.ObjectNew.(*ethereumv1alpha1.Node).Status.JWTSecretName
+			},
+		},
+	)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&ethereum2v1alpha1.BeaconNode{}).
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		Watches(
+			&source.Kind{Type: &ethereumv1alpha1.Node{}},
+			handler.EnqueueRequestsFromMapFunc(r.pairedBeaconNodes),
+			builder.WithPredicates(enginePred),
+		).
+		Watches(
+			&source.Kind{Type: &ethereum2v1alpha1.MEVBoost{}},
+			handler.EnqueueRequestsFromMapFunc(r.pairedBeaconNodes),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Complete(r)
 }
@@ -14,7 +14,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+	"github.com/kotalco/kotal/controllers/shared"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -147,4 +150,264 @@ var _ = Describe("Ethereum 2.0 beacon node", func() {
 		})
 
 	})
+
+	Context("Paired with execution engine", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "paired-beacon-node",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "my-node",
+			Namespace: ns.Name,
+		}
+
+		engineKey := types.NamespacedName{
+			Name:      "my-execution-engine",
+			Namespace: ns.Name,
+		}
+
+		engine := &ethereumv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      engineKey.Name,
+				Namespace: engineKey.Namespace,
+			},
+			Spec: ethereumv1alpha1.NodeSpec{
+				Client:  ethereumv1alpha1.GethClient,
+				Network: ethereumv1alpha1.GoerliNetwork,
+				Engine:  true,
+			},
+		}
+
+		toCreate := &ethereum2v1alpha1.BeaconNode{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: ethereum2v1alpha1.BeaconNodeSpec{
+				Client:             ethereum2v1alpha1.TekuClient,
+				Network:            "goerli",
+				ExecutionEngineRef: engineKey.Name,
+			},
+		}
+
+		It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+		})
+
+		It("Should create execution engine node", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				engine.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), engine)).Should(Succeed())
+		})
+
+		It("Should report execution engine JWT secret", func() {
+			// execution engine node controller is not running in this test suite
+			fetched := &ethereumv1alpha1.Node{}
+			Expect(k8sClient.Get(context.Background(), engineKey, fetched)).To(Succeed())
+			fetched.Status.JWTSecretName = "my-execution-engine-jwt"
+			Expect(k8sClient.Status().Update(context.Background(), fetched)).To(Succeed())
+		})
+
+		It("Should create beacon node", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(5 * time.Second)
+		})
+
+		It("Should report execution engine pairing in status", func() {
+			fetched := &ethereum2v1alpha1.BeaconNode{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			Expect(fetched.Status.ExecutionEngine).To(Equal(engineKey.String()))
+			Expect(fetched.Status.ExecutionEngineEndpoint).To(Equal(fmt.Sprintf("http://%s.%s.svc:%d", engineKey.Name, engineKey.Namespace, ethereumv1alpha1.DefaultEngineRPCPort)))
+		})
+
+		It("Should mount execution engine JWT secret", func() {
+			nodeSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, nodeSts)).To(Succeed())
+			// secrets volume projects JWT secret first
+			secrets := nodeSts.Spec.Template.Spec.Volumes[1]
+			Expect(secrets.Name).To(Equal("secrets"))
+			Expect(secrets.Projected.Sources[0].Secret.Name).To(Equal("my-execution-engine-jwt"))
+		})
+
+		It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		})
+	})
+
+	Context("Paired with mev-boost", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "builder-beacon-node",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "my-node",
+			Namespace: ns.Name,
+		}
+
+		boostKey := types.NamespacedName{
+			Name:      "my-mev-boost",
+			Namespace: ns.Name,
+		}
+
+		boost := &ethereum2v1alpha1.MEVBoost{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      boostKey.Name,
+				Namespace: boostKey.Namespace,
+			},
+			Spec: ethereum2v1alpha1.MEVBoostSpec{
+				Network: "mainnet",
+				Relays: []string{
+					"https://0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae@boost-relay.flashbots.net",
+				},
+			},
+		}
+
+		toCreate := &ethereum2v1alpha1.BeaconNode{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: ethereum2v1alpha1.BeaconNodeSpec{
+				Client:                  ethereum2v1alpha1.LighthouseClient,
+				Network:                 "mainnet",
+				ExecutionEngineEndpoint: "http://localhost:8551",
+				JWTSecretName:           "jwt-secret",
+				Builder: &ethereum2v1alpha1.BeaconNodeBuilder{
+					MEVBoostRef: boostKey.Name,
+				},
+			},
+		}
+
+		It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+		})
+
+		It("Should create mev-boost", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				boost.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), boost)).Should(Succeed())
+		})
+
+		It("Should create beacon node", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(5 * time.Second)
+		})
+
+		It("Should report mev-boost pairing in status", func() {
+			fetched := &ethereum2v1alpha1.BeaconNode{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			Expect(fetched.Status.MEVBoost).To(Equal(boostKey.String()))
+			Expect(fetched.Status.BuilderEndpoint).To(Equal(fmt.Sprintf("http://%s.%s.svc:%d", boostKey.Name, boostKey.Namespace, ethereum2v1alpha1.DefaultMEVBoostPort)))
+		})
+
+		It("Should pass mev-boost endpoint to beacon node", func() {
+			nodeSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, nodeSts)).To(Succeed())
+			Expect(nodeSts.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+				ethereum2Clients.LighthouseBuilder,
+				fmt.Sprintf("http://%s.%s.svc:%d", boostKey.Name, boostKey.Namespace, ethereum2v1alpha1.DefaultMEVBoostPort),
+			))
+		})
+
+		It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		})
+	})
+
+	Context("Joining custom network", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "devnet-beacon-node",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "my-node",
+			Namespace: ns.Name,
+		}
+
+		toCreate := &ethereum2v1alpha1.BeaconNode{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: ethereum2v1alpha1.BeaconNodeSpec{
+				Client:                  ethereum2v1alpha1.NimbusClient,
+				Network:                 "devnet",
+				ExecutionEngineEndpoint: "http://localhost:8551",
+				JWTSecretName:           "jwt-secret",
+				Genesis: &ethereum2v1alpha1.BeaconGenesis{
+					ConfigMapName: "devnet-genesis",
+				},
+			},
+		}
+
+		It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+		})
+
+		It("Should create beacon node", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(5 * time.Second)
+		})
+
+		It("Should mount genesis config map into config directory", func() {
+			homeDir := ethereum2Clients.NimbusHomeDir
+			mode := corev1.ConfigMapVolumeSourceDefaultMode
+			nodeSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, nodeSts)).To(Succeed())
+			Expect(nodeSts.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "genesis",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "devnet-genesis",
+						},
+						Items: []corev1.KeyToPath{
+							{
+								Key:  ethereum2Clients.GenesisConfigFile,
+								Path: ethereum2Clients.GenesisConfigFile,
+							},
+							{
+								Key:  ethereum2Clients.GenesisStateFile,
+								Path: ethereum2Clients.GenesisStateFile,
+							},
+							{
+								Key:  ethereum2Clients.GenesisDeployBlockFile,
+								Path: ethereum2Clients.GenesisDeployBlockFile,
+							},
+						},
+						DefaultMode: &mode,
+					},
+				},
+			}))
+			Expect(nodeSts.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "genesis",
+				MountPath: shared.PathConfig(homeDir),
+				ReadOnly:  true,
+			}))
+			Expect(nodeSts.Spec.Template.Spec.Containers[0].Args).To(ContainElement(
+				fmt.Sprintf("%s=%s", ethereum2Clients.NimbusNetwork, shared.PathConfig(homeDir)),
+			))
+		})
+
+		It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		})
+	})
 })
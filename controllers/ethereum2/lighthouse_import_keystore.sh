@@ -2,7 +2,14 @@
 
 set -e
 
-lighthouse account validator import --datadir ${KOTAL_DATA_PATH} --network ${KOTAL_NETWORK} \
+# custom network is loaded from genesis directory
+if [ -n "${KOTAL_GENESIS_PATH}" ]; then
+NETWORK="--testnet-dir ${KOTAL_GENESIS_PATH}"
+else
+NETWORK="--network ${KOTAL_NETWORK}"
+fi
+
+lighthouse account validator import --datadir ${KOTAL_DATA_PATH} ${NETWORK} \
 --keystore ${KOTAL_KEY_DIR}/keystore-${KOTAL_KEYSTORE_INDEX}.json \
 --reuse-password \
 --password-file ${KOTAL_KEY_DIR}/password.txt
\ No newline at end of file
@@ -0,0 +1,14 @@
+#!/bin/sh
+
+set -e
+
+# custom network is loaded from genesis chain config file
+if [ -n "${KOTAL_GENESIS_PATH}" ]; then
+NETWORK="--paramsFile ${KOTAL_GENESIS_PATH}/config.yaml"
+else
+NETWORK="--network ${KOTAL_NETWORK}"
+fi
+
+node /usr/app/packages/cli/bin/lodestar validator import --dataDir ${KOTAL_DATA_PATH} ${NETWORK} \
+--importKeystores ${KOTAL_KEY_DIR}/keystore-${KOTAL_KEYSTORE_INDEX}.json \
+--importKeystoresPassword ${KOTAL_KEY_DIR}/password.txt
\ No newline at end of file
@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+	"github.com/kotalco/kotal/controllers/shared"
+)
+
+// MEVBoostReconciler reconciles a MEVBoost object
+type MEVBoostReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=mevboosts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=mevboosts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=watch;get;list;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=services,verbs=watch;get;create;update;list;delete
+
+// Reconcile reconciles Ethereum 2.0 mev-boost
+func (r *MEVBoostReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	var boost ethereum2v1alpha1.MEVBoost
+
+	if err = r.Client.Get(ctx, req.NamespacedName, &boost); err != nil {
+		err = client.IgnoreNotFound(err)
+		return
+	}
+
+	// default the mev-boost if webhooks are disabled
+	if !shared.IsWebhookEnabled() {
+		boost.Default()
+	}
+
+	shared.UpdateLabels(&boost, "mev-boost")
+
+	if err = r.reconcileService(ctx, &boost); err != nil {
+		return
+	}
+
+	if err = r.reconcileStatefulset(ctx, &boost); err != nil {
+		return
+	}
+
+	if err = r.updateStatus(ctx, &boost); err != nil {
+		return
+	}
+
+	return
+}
+
+// updateStatus updates mev-boost status
+func (r *MEVBoostReconciler) updateStatus(ctx context.Context, boost *ethereum2v1alpha1.MEVBoost) error {
+	boost.Status.URL = fmt.Sprintf("http://%s.%s.svc:%d", boost.Name, boost.Namespace, boost.Spec.Port)
+
+	if err := r.Status().Update(ctx, boost); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update mev-boost status")
+		return err
+	}
+
+	return nil
+}
+
+// reconcileService reconciles mev-boost service
+func (r *MEVBoostReconciler) reconcileService(ctx context.Context, boost *ethereum2v1alpha1.MEVBoost) error {
+	svc := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      boost.Name,
+			Namespace: boost.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, &svc, func() error {
+		if err := ctrl.SetControllerReference(boost, &svc, r.Scheme); err != nil {
+			return err
+		}
+
+		r.specService(boost, &svc)
+
+		return nil
+	})
+
+	return err
+}
+
+// specService updates mev-boost service spec
+func (r *MEVBoostReconciler) specService(boost *ethereum2v1alpha1.MEVBoost, svc *corev1.Service) {
+	labels := boost.GetLabels()
+
+	svc.ObjectMeta.Labels = labels
+	svc.Spec.Ports = []corev1.ServicePort{
+		{
+			Name:       "http",
+			Port:       int32(boost.Spec.Port),
+			TargetPort: intstr.FromInt(int(boost.Spec.Port)),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+
+	svc.Spec.Selector = labels
+}
+
+// reconcileStatefulset reconciles mev-boost statefulset
+func (r *MEVBoostReconciler) reconcileStatefulset(ctx context.Context, boost *ethereum2v1alpha1.MEVBoost) error {
+	sts := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      boost.Name,
+			Namespace: boost.Namespace,
+		},
+	}
+
+	client, err := ethereum2Clients.NewClient(boost)
+	if err != nil {
+		return err
+	}
+
+	command := client.Command()
+	args := client.Args()
+	env := client.Env()
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, &sts, func() error {
+		if err := ctrl.SetControllerReference(boost, &sts, r.Scheme); err != nil {
+			return err
+		}
+
+		r.specStatefulset(boost, &sts, command, args, env)
+
+		return nil
+	})
+
+	return err
+}
+
+// specStatefulset updates mev-boost statefulset spec
+// mev-boost is stateless, so it has no volumes
+func (r *MEVBoostReconciler) specStatefulset(boost *ethereum2v1alpha1.MEVBoost, sts *appsv1.StatefulSet, command, args []string, env []corev1.EnvVar) {
+
+	sts.Labels = boost.GetLabels()
+
+	sts.Spec = appsv1.StatefulSetSpec{
+		Selector: &metav1.LabelSelector{
+			MatchLabels: boost.GetLabels(),
+		},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: boost.GetLabels(),
+			},
+			Spec: corev1.PodSpec{
+				SecurityContext: shared.SecurityContext(),
+				Containers: []corev1.Container{
+					{
+						Name:    "mev-boost",
+						Image:   boost.Spec.Image,
+						Command: command,
+						Args:    args,
+						Env:     env,
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse(boost.Spec.Resources.CPU),
+								corev1.ResourceMemory: resource.MustParse(boost.Spec.Resources.Memory),
+							},
+							Limits: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse(boost.Spec.Resources.CPULimit),
+								corev1.ResourceMemory: resource.MustParse(boost.Spec.Resources.MemoryLimit),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// SetupWithManager adds reconciler to the manager
+func (r *MEVBoostReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ethereum2v1alpha1.MEVBoost{}).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&corev1.Service{}).
+		Complete(r)
+}
@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ethereum 2.0 mev-boost", func() {
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mev-boost",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "mev-boost",
+		Namespace: ns.Name,
+	}
+
+	testImage := "flashbots/mev-boost:test"
+
+	spec := ethereum2v1alpha1.MEVBoostSpec{
+		Image:   testImage,
+		Network: "mainnet",
+		Relays: []string{
+			"https://0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae@boost-relay.flashbots.net",
+		},
+		MinBid: "0.05",
+	}
+
+	toCreate := &ethereum2v1alpha1.MEVBoost{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: spec,
+	}
+
+	t := true
+
+	boostOwnerReference := metav1.OwnerReference{
+		APIVersion:         "ethereum2.kotal.io/v1alpha1",
+		Kind:               "MEVBoost",
+		Name:               toCreate.Name,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns))
+	})
+
+	It("Should create mev-boost", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			toCreate.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+	})
+
+	It("should get mev-boost", func() {
+		fetched := &ethereum2v1alpha1.MEVBoost{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Spec).To(Equal(toCreate.Spec))
+		boostOwnerReference.UID = fetched.GetUID()
+		time.Sleep(5 * time.Second)
+	})
+
+	It("Should create statefulset", func() {
+		sts := &appsv1.StatefulSet{}
+		Expect(k8sClient.Get(context.Background(), key, sts)).To(Succeed())
+		Expect(sts.GetOwnerReferences()).To(ContainElement(boostOwnerReference))
+		Expect(sts.Spec.Template.Spec.Containers[0].Image).To(Equal(testImage))
+		Expect(sts.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+			ethereum2Clients.MEVBoostMinBid,
+			"0.05",
+		))
+	})
+
+	It("Should allocate correct resources to mev-boost statefulset", func() {
+		sts := &appsv1.StatefulSet{}
+		expectedResources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(ethereum2v1alpha1.DefaultMEVBoostCPURequest),
+				corev1.ResourceMemory: resource.MustParse(ethereum2v1alpha1.DefaultMEVBoostMemoryRequest),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(ethereum2v1alpha1.DefaultMEVBoostCPULimit),
+				corev1.ResourceMemory: resource.MustParse(ethereum2v1alpha1.DefaultMEVBoostMemoryLimit),
+			},
+		}
+		Expect(k8sClient.Get(context.Background(), key, sts)).To(Succeed())
+		Expect(sts.Spec.Template.Spec.Containers[0].Resources).To(Equal(expectedResources))
+	})
+
+	It("Should create mev-boost service", func() {
+		svc := &corev1.Service{}
+		Expect(k8sClient.Get(context.Background(), key, svc)).To(Succeed())
+		Expect(svc.GetOwnerReferences()).To(ContainElement(boostOwnerReference))
+		Expect(svc.Spec.Ports).To(ContainElements(
+			corev1.ServicePort{
+				Name:       "http",
+				Port:       int32(ethereum2v1alpha1.DefaultMEVBoostPort),
+				TargetPort: intstr.FromInt(int(ethereum2v1alpha1.DefaultMEVBoostPort)),
+				Protocol:   corev1.ProtocolTCP,
+			},
+		))
+	})
+
+	It("Should report mev-boost url", func() {
+		fetched := &ethereum2v1alpha1.MEVBoost{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.URL).To(Equal(fmt.Sprintf("http://mev-boost.mev-boost.svc:%d", ethereum2v1alpha1.DefaultMEVBoostPort)))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
@@ -0,0 +1,200 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+)
+
+const (
+	// migrationRequeueInterval is the interval source validator and beacon node are checked during migration
+	migrationRequeueInterval = 30 * time.Second
+	// migrationSourceIndexKey is the field index key of source validator migrated from
+	// index values are namespaced names of source validators in the format of namespace/name
+	migrationSourceIndexKey = "spec.migration.source"
+)
+
+// migrationPending returns true if validator is stopped until migration from source validator completes
+func migrationPending(validator *ethereum2v1alpha1.Validator) bool {
+	if validator.Spec.Migration == nil {
+		return false
+	}
+	return !meta.IsStatusConditionTrue(validator.Status.Conditions, ethereum2v1alpha1.MigratedCondition)
+}
+
+// setMigrationCondition sets validator migration condition
+// condition is replaced if reason changes, so last transition time is when the current reason started
+func setMigrationCondition(validator *ethereum2v1alpha1.Validator, status metav1.ConditionStatus, reason, message string) {
+	current := meta.FindStatusCondition(validator.Status.Conditions, ethereum2v1alpha1.MigratedCondition)
+	if current != nil && current.Reason != reason {
+		meta.RemoveStatusCondition(&validator.Status.Conditions, ethereum2v1alpha1.MigratedCondition)
+	}
+
+	meta.SetStatusCondition(&validator.Status.Conditions, metav1.Condition{
+		Type:               ethereum2v1alpha1.MigratedCondition,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: validator.Generation,
+	})
+}
+
+// sourceObserved returns true if source validator has been found since migration started
+func sourceObserved(validator *ethereum2v1alpha1.Validator) bool {
+	current := meta.FindStatusCondition(validator.Status.Conditions, ethereum2v1alpha1.MigratedCondition)
+	return current != nil && current.Reason != ethereum2v1alpha1.SourceNotFoundReason
+}
+
+// reconcileMigration waits until source validator is deleted or stopped and migration epochs have passed
+// source validator is checked as long as it exists, validator is stopped again if source validator is started
+// it returns the duration to wait before checking migration again, or zero if migration isn't pending
+func (r *ValidatorReconciler) reconcileMigration(ctx context.Context, validator *ethereum2v1alpha1.Validator) (time.Duration, error) {
+	if validator.Spec.Migration == nil {
+		meta.RemoveStatusCondition(&validator.Status.Conditions, ethereum2v1alpha1.MigratedCondition)
+		return 0, nil
+	}
+
+	migration := validator.Spec.Migration
+
+	if migration.Source != "" {
+		// source validator is in the same namespace only
+		source := types.NamespacedName{Name: migration.Source, Namespace: validator.Namespace}
+
+		err := r.Client.Get(ctx, source, &ethereum2v1alpha1.Validator{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return 0, err
+		}
+
+		// source validator that has never been found is mistyped, or has been deleted before this validator was created
+		if apierrors.IsNotFound(err) && !sourceObserved(validator) {
+			err = fmt.Errorf("source validator %s not found", source)
+			log.FromContext(ctx).Error(err, "unable to migrate from source validator")
+			setMigrationCondition(validator, metav1.ConditionFalse, ethereum2v1alpha1.SourceNotFoundReason, err.Error())
+			return migrationRequeueInterval, nil
+		}
+
+		stopped, err := r.sourceStopped(ctx, source)
+		if err != nil {
+			return 0, err
+		}
+
+		if !stopped {
+			setMigrationCondition(validator, metav1.ConditionFalse, ethereum2v1alpha1.SourceRunningReason, fmt.Sprintf("waiting for source validator %s to be deleted or stopped", source))
+			return migrationRequeueInterval, nil
+		}
+	}
+
+	if !migrationPending(validator) {
+		return 0, nil
+	}
+
+	// epochs are waited since source validator is gone, which is when waiting epochs condition transitioned
+	epochDuration, err := beaconEpochDuration(ctx, validator)
+	if err != nil {
+		setMigrationCondition(validator, metav1.ConditionFalse, ethereum2v1alpha1.WaitingEpochsReason, fmt.Sprintf("waiting %d epochs before starting validator, unable to get epoch duration: %s", migration.Epochs, err))
+		return migrationRequeueInterval, nil
+	}
+
+	setMigrationCondition(validator, metav1.ConditionFalse, ethereum2v1alpha1.WaitingEpochsReason, fmt.Sprintf("waiting %d epochs before starting validator", migration.Epochs))
+
+	waiting := meta.FindStatusCondition(validator.Status.Conditions, ethereum2v1alpha1.MigratedCondition)
+	remaining := time.Until(waiting.LastTransitionTime.Add(time.Duration(migration.Epochs) * epochDuration))
+	if remaining > 0 {
+		return remaining, nil
+	}
+
+	setMigrationCondition(validator, metav1.ConditionTrue, ethereum2v1alpha1.MigratedReason, "validator has been started")
+
+	return 0, nil
+}
+
+// sourceStopped returns true if source validator statefulset is gone or scaled down to zero replicas, and its pod is gone
+// pod absence alone isn't enough, statefulset recreates evicted pods
+func (r *ValidatorReconciler) sourceStopped(ctx context.Context, source types.NamespacedName) (bool, error) {
+	sts := &appsv1.StatefulSet{}
+	err := r.Client.Get(ctx, source, sts)
+	if err == nil && (sts.Spec.Replicas == nil || *sts.Spec.Replicas != 0) {
+		return false, nil
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	// source validator statefulset pod
+	key := types.NamespacedName{Name: fmt.Sprintf("%s-0", source.Name), Namespace: source.Namespace}
+
+	err = r.Client.Get(ctx, key, &corev1.Pod{})
+	if err == nil {
+		return false, nil
+	}
+
+	return apierrors.IsNotFound(err), client.IgnoreNotFound(err)
+}
+
+// beaconEpochDuration returns epoch duration from the first validator beacon endpoint that's reachable REST API url
+// beacon endpoints that aren't REST API urls like prysm gRPC endpoints are skipped
+func beaconEpochDuration(ctx context.Context, validator *ethereum2v1alpha1.Validator) (time.Duration, error) {
+	err := fmt.Errorf("validator %s has no beacon node REST API endpoint", validator.Name)
+
+	for _, endpoint := range validator.Spec.BeaconEndpoints {
+		client, clientErr := ethereum2Clients.NewBeaconAPIClient(endpoint)
+		if clientErr != nil {
+			continue
+		}
+
+		var duration time.Duration
+		if duration, err = client.EpochDuration(ctx); err == nil {
+			return duration, nil
+		}
+	}
+
+	return 0, err
+}
+
+// migrationSource returns namespaced name of source validator migrated from
+func migrationSource(obj client.Object) []string {
+	validator := obj.(*ethereum2v1alpha1.Validator)
+
+	if validator.Spec.Migration == nil || validator.Spec.Migration.Source == "" {
+		return nil
+	}
+
+	return []string{types.NamespacedName{Name: validator.Spec.Migration.Source, Namespace: validator.Namespace}.String()}
+}
+
+// migratingValidators returns reconcile requests for validators migrating from the given source validator
+// source validator statefulset has the same name as the source validator
+func (r *ValidatorReconciler) migratingValidators(obj client.Object) []reconcile.Request {
+	var validators ethereum2v1alpha1.ValidatorList
+
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}.String()
+
+	if err := r.Client.List(context.Background(), &validators, client.MatchingFields{migrationSourceIndexKey: key}); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, validator := range validators.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      validator.Name,
+				Namespace: validator.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
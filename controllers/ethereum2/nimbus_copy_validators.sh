@@ -4,4 +4,13 @@ set -e
 
 mkdir -p ${KOTAL_VALIDATORS_PATH}
 cp -RL ${KOTAL_SECRETS_PATH}/validator-keys ${KOTAL_VALIDATORS_PATH}
-cp -RL ${KOTAL_SECRETS_PATH}/validator-secrets ${KOTAL_VALIDATORS_PATH}
\ No newline at end of file
+cp -RL ${KOTAL_SECRETS_PATH}/validator-secrets ${KOTAL_VALIDATORS_PATH}
+
+# per validator key proposer config files are named <public key>.<file name>
+find ${KOTAL_VALIDATORS_PATH}/validator-keys -name 'suggested_*' -delete
+for file in ${KOTAL_CONFIG_PATH}/0x*; do
+	[ -e "${file}" ] || continue
+	name=$(basename ${file})
+	mkdir -p ${KOTAL_VALIDATORS_PATH}/validator-keys/${name%%.*}
+	cp -L ${file} ${KOTAL_VALIDATORS_PATH}/validator-keys/${name%%.*}/${name#*.}
+done
@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+)
+
+// proposerConfig is teku, prysm and lodestar per validator key proposer config
+type proposerConfig struct {
+	ProposerConfig map[string]proposerOptions `json:"proposer_config,omitempty"`
+	DefaultConfig  proposerOptions            `json:"default_config"`
+}
+
+// proposerOptions is validator key proposer options
+type proposerOptions struct {
+	FeeRecipient string           `json:"fee_recipient"`
+	Graffiti     string           `json:"graffiti,omitempty"`
+	Builder      *proposerBuilder `json:"builder,omitempty"`
+}
+
+// proposerBuilder is validator key block builder registration options
+type proposerBuilder struct {
+	Enabled  bool   `json:"enabled"`
+	GasLimit string `json:"gas_limit"`
+}
+
+// keystoreFeeRecipient returns keystore fee recipient, or validator fee recipient if not overridden
+func keystoreFeeRecipient(validator *ethereum2v1alpha1.Validator, keystore *ethereum2v1alpha1.Keystore) string {
+	if keystore.FeeRecipient != "" {
+		return string(keystore.FeeRecipient)
+	}
+	return string(validator.Spec.FeeRecipient)
+}
+
+// keystoreGasLimit returns keystore gas limit, or validator builder gas limit if not overridden
+func keystoreGasLimit(validator *ethereum2v1alpha1.Validator, keystore *ethereum2v1alpha1.Keystore) uint64 {
+	if keystore.GasLimit != 0 {
+		return keystore.GasLimit
+	}
+	return validator.Spec.Builder.GasLimit
+}
+
+// proposerConfigJSON returns teku, prysm and lodestar proposer config
+// validator fee recipient, graffiti and builder are the default config
+// keystores without public keys are skipped, because proposer config is keyed by public key
+func proposerConfigJSON(validator *ethereum2v1alpha1.Validator) string {
+	config := proposerConfig{
+		ProposerConfig: map[string]proposerOptions{},
+		DefaultConfig: proposerOptions{
+			FeeRecipient: string(validator.Spec.FeeRecipient),
+		},
+	}
+
+	if validator.Spec.Client.SupportsPerKeyGraffiti() {
+		config.DefaultConfig.Graffiti = validator.Spec.Graffiti
+	}
+
+	if validator.Spec.Builder != nil {
+		config.DefaultConfig.Builder = &proposerBuilder{
+			Enabled:  true,
+			GasLimit: fmt.Sprintf("%d", validator.Spec.Builder.GasLimit),
+		}
+	}
+
+	for i := range validator.Spec.Keystores {
+		keystore := &validator.Spec.Keystores[i]
+		if keystore.PublicKey == "" {
+			continue
+		}
+
+		options := proposerOptions{
+			FeeRecipient: keystoreFeeRecipient(validator, keystore),
+			Graffiti:     config.DefaultConfig.Graffiti,
+		}
+
+		if keystore.Graffiti != "" {
+			options.Graffiti = keystore.Graffiti
+		}
+
+		if validator.Spec.Builder != nil {
+			options.Builder = &proposerBuilder{
+				Enabled:  true,
+				GasLimit: fmt.Sprintf("%d", keystoreGasLimit(validator, keystore)),
+			}
+		}
+
+		config.ProposerConfig[strings.ToLower(keystore.PublicKey)] = options
+	}
+
+	content, _ := json.MarshalIndent(config, "", "  ")
+
+	return string(content)
+}
+
+// nimbusProposerFiles returns nimbus per validator key fee recipient and gas limit files
+// files are named <public key>.<file name> and copied into validator key directory by nimbus copy validators script
+func nimbusProposerFiles(validator *ethereum2v1alpha1.Validator) map[string]string {
+	files := map[string]string{}
+
+	for i := range validator.Spec.Keystores {
+		keystore := &validator.Spec.Keystores[i]
+		if keystore.PublicKey == "" {
+			continue
+		}
+
+		publicKey := strings.ToLower(keystore.PublicKey)
+
+		if keystore.FeeRecipient != "" {
+			files[fmt.Sprintf("%s.suggested_fee_recipient.hex", publicKey)] = string(keystore.FeeRecipient)
+		}
+
+		if keystore.GasLimit != 0 {
+			files[fmt.Sprintf("%s.suggested_gas_limit.json", publicKey)] = fmt.Sprintf("%d", keystore.GasLimit)
+		}
+	}
+
+	return files
+}
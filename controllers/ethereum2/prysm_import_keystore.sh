@@ -2,8 +2,15 @@
 
 set -e
 
+# wallet isn't network specific, custom network keystores are imported without network flag
+if [ -n "${KOTAL_GENESIS_PATH}" ]; then
+NETWORK=""
+else
+NETWORK="--${KOTAL_NETWORK}"
+fi
+
 validator accounts import --accept-terms-of-use \
---${KOTAL_NETWORK} \
+${NETWORK} \
 --wallet-dir=${KOTAL_DATA_PATH}/prysm-wallet \
 --keys-dir=${KOTAL_KEY_DIR}/keystore-${KOTAL_KEYSTORE_INDEX}.json \
 --account-password-file=${KOTAL_KEY_DIR}/password.txt \
@@ -0,0 +1,299 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+	"github.com/kotalco/kotal/controllers/shared"
+)
+
+// RemoteSignerReconciler reconciles a RemoteSigner object
+type RemoteSignerReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=remotesigners,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=remotesigners/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=watch;get;list;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=services;configmaps,verbs=watch;get;create;update;list;delete
+
+// Reconcile reconciles Ethereum 2.0 remote signer
+func (r *RemoteSignerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	var signer ethereum2v1alpha1.RemoteSigner
+
+	if err = r.Client.Get(ctx, req.NamespacedName, &signer); err != nil {
+		err = client.IgnoreNotFound(err)
+		return
+	}
+
+	// default the remote signer if webhooks are disabled
+	if !shared.IsWebhookEnabled() {
+		signer.Default()
+	}
+
+	shared.UpdateLabels(&signer, "web3signer")
+
+	if err = r.reconcileConfigmap(ctx, &signer); err != nil {
+		return
+	}
+
+	if err = r.reconcileService(ctx, &signer); err != nil {
+		return
+	}
+
+	if err = r.reconcileStatefulset(ctx, &signer); err != nil {
+		return
+	}
+
+	if err = r.updateStatus(ctx, &signer); err != nil {
+		return
+	}
+
+	return
+}
+
+// updateStatus updates remote signer status
+func (r *RemoteSignerReconciler) updateStatus(ctx context.Context, signer *ethereum2v1alpha1.RemoteSigner) error {
+	signer.Status.URL = fmt.Sprintf("http://%s.%s.svc:%d", signer.Name, signer.Namespace, signer.Spec.Port)
+
+	if err := r.Status().Update(ctx, signer); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update remote signer status")
+		return err
+	}
+
+	return nil
+}
+
+// reconcileConfigmap reconciles remote signer config map
+func (r *RemoteSignerReconciler) reconcileConfigmap(ctx context.Context, signer *ethereum2v1alpha1.RemoteSigner) error {
+	configmap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      signer.Name,
+			Namespace: signer.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, configmap, func() error {
+		if err := ctrl.SetControllerReference(signer, configmap, r.Scheme); err != nil {
+			return err
+		}
+
+		r.specConfigmap(signer, configmap)
+
+		return nil
+	})
+
+	return err
+}
+
+// specConfigmap updates remote signer configmap spec
+// each keystore is loaded by web3signer using signing key configuration file
+func (r *RemoteSignerReconciler) specConfigmap(signer *ethereum2v1alpha1.RemoteSigner, configmap *corev1.ConfigMap) {
+	configmap.ObjectMeta.Labels = signer.GetLabels()
+
+	secretsPath := shared.PathSecrets(ethereum2Clients.Web3SignerHomeDir)
+
+	configmap.Data = map[string]string{}
+	for _, keystore := range signer.Spec.Keystores {
+		keyDir := fmt.Sprintf("%s/validator-keys/%s", secretsPath, keystore.SecretName)
+		configmap.Data[fmt.Sprintf("%s.yaml", keystore.SecretName)] = fmt.Sprintf(
+			"type: file-keystore\nkeyType: BLS\nkeystoreFile: %s/keystore.json\nkeystorePasswordFile: %s/password.txt\n",
+			keyDir,
+			keyDir,
+		)
+	}
+}
+
+// reconcileService reconciles remote signer service
+func (r *RemoteSignerReconciler) reconcileService(ctx context.Context, signer *ethereum2v1alpha1.RemoteSigner) error {
+	svc := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      signer.Name,
+			Namespace: signer.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, &svc, func() error {
+		if err := ctrl.SetControllerReference(signer, &svc, r.Scheme); err != nil {
+			return err
+		}
+
+		r.specService(signer, &svc)
+
+		return nil
+	})
+
+	return err
+}
+
+// specService updates remote signer service spec
+func (r *RemoteSignerReconciler) specService(signer *ethereum2v1alpha1.RemoteSigner, svc *corev1.Service) {
+	labels := signer.GetLabels()
+
+	svc.ObjectMeta.Labels = labels
+	svc.Spec.Ports = []corev1.ServicePort{
+		{
+			Name:       "http",
+			Port:       int32(signer.Spec.Port),
+			TargetPort: intstr.FromInt(int(signer.Spec.Port)),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+
+	svc.Spec.Selector = labels
+}
+
+// reconcileStatefulset reconciles remote signer statefulset
+func (r *RemoteSignerReconciler) reconcileStatefulset(ctx context.Context, signer *ethereum2v1alpha1.RemoteSigner) error {
+	sts := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      signer.Name,
+			Namespace: signer.Namespace,
+		},
+	}
+
+	client, err := ethereum2Clients.NewClient(signer)
+	if err != nil {
+		return err
+	}
+
+	command := client.Command()
+	args := client.Args()
+	env := client.Env()
+	homeDir := client.HomeDir()
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, &sts, func() error {
+		if err := ctrl.SetControllerReference(signer, &sts, r.Scheme); err != nil {
+			return err
+		}
+
+		r.specStatefulset(signer, &sts, command, args, env, homeDir)
+
+		return nil
+	})
+
+	return err
+}
+
+// remoteSignerVolumes returns remote signer volumes
+func (r *RemoteSignerReconciler) remoteSignerVolumes(signer *ethereum2v1alpha1.RemoteSigner) (volumes []corev1.Volume) {
+	configVolume := corev1.Volume{
+		Name: "config",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: signer.Name,
+				},
+			},
+		},
+	}
+	volumes = append(volumes, configVolume)
+
+	for _, keystore := range signer.Spec.Keystores {
+		keystoreVolume := corev1.Volume{
+			Name: keystore.SecretName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: keystore.SecretName,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  "keystore",
+							Path: "keystore.json",
+						},
+						{
+							Key:  "password",
+							Path: "password.txt",
+						},
+					},
+				},
+			},
+		}
+		volumes = append(volumes, keystoreVolume)
+	}
+
+	return
+}
+
+// remoteSignerVolumeMounts returns remote signer volume mounts
+func (r *RemoteSignerReconciler) remoteSignerVolumeMounts(signer *ethereum2v1alpha1.RemoteSigner, homeDir string) (mounts []corev1.VolumeMount) {
+	configMount := corev1.VolumeMount{
+		Name:      "config",
+		MountPath: shared.PathConfig(homeDir),
+	}
+	mounts = append(mounts, configMount)
+
+	for _, keystore := range signer.Spec.Keystores {
+		keystoreMount := corev1.VolumeMount{
+			Name:      keystore.SecretName,
+			ReadOnly:  true,
+			MountPath: fmt.Sprintf("%s/validator-keys/%s", shared.PathSecrets(homeDir), keystore.SecretName),
+		}
+		mounts = append(mounts, keystoreMount)
+	}
+
+	return
+}
+
+// specStatefulset updates remote signer statefulset spec
+func (r *RemoteSignerReconciler) specStatefulset(signer *ethereum2v1alpha1.RemoteSigner, sts *appsv1.StatefulSet, command, args []string, env []corev1.EnvVar, homeDir string) {
+
+	sts.Labels = signer.GetLabels()
+
+	sts.Spec = appsv1.StatefulSetSpec{
+		Selector: &metav1.LabelSelector{
+			MatchLabels: signer.GetLabels(),
+		},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: signer.GetLabels(),
+			},
+			Spec: corev1.PodSpec{
+				SecurityContext: shared.SecurityContext(),
+				Containers: []corev1.Container{
+					{
+						Name:         "signer",
+						Image:        signer.Spec.Image,
+						Command:      command,
+						Args:         args,
+						Env:          env,
+						VolumeMounts: r.remoteSignerVolumeMounts(signer, homeDir),
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse(signer.Spec.Resources.CPU),
+								corev1.ResourceMemory: resource.MustParse(signer.Spec.Resources.Memory),
+							},
+							Limits: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse(signer.Spec.Resources.CPULimit),
+								corev1.ResourceMemory: resource.MustParse(signer.Spec.Resources.MemoryLimit),
+							},
+						},
+					},
+				},
+				Volumes: r.remoteSignerVolumes(signer),
+			},
+		},
+	}
+}
+
+// SetupWithManager adds reconciler to the manager
+func (r *RemoteSignerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ethereum2v1alpha1.RemoteSigner{}).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&corev1.Service{}).
+		Owns(&corev1.ConfigMap{}).
+		Complete(r)
+}
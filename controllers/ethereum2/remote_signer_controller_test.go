@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+	"github.com/kotalco/kotal/controllers/shared"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ethereum 2.0 remote signer", func() {
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web3signer",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "web3signer",
+		Namespace: ns.Name,
+	}
+
+	testImage := "consensys/web3signer:test"
+
+	spec := ethereum2v1alpha1.RemoteSignerSpec{
+		Image:   testImage,
+		Network: "mainnet",
+		Keystores: []ethereum2v1alpha1.Keystore{
+			{
+				PublicKey:  "0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+				SecretName: "my-validator",
+			},
+		},
+		SlashingProtectionDatabaseURL: "jdbc:postgresql://postgres:5432/web3signer",
+	}
+
+	toCreate := &ethereum2v1alpha1.RemoteSigner{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: spec,
+	}
+
+	t := true
+
+	signerOwnerReference := metav1.OwnerReference{
+		APIVersion:         "ethereum2.kotal.io/v1alpha1",
+		Kind:               "RemoteSigner",
+		Name:               toCreate.Name,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns))
+	})
+
+	It("Should create remote signer", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			toCreate.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+	})
+
+	It("should get remote signer", func() {
+		fetched := &ethereum2v1alpha1.RemoteSigner{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Spec).To(Equal(toCreate.Spec))
+		signerOwnerReference.UID = fetched.GetUID()
+		time.Sleep(5 * time.Second)
+	})
+
+	It("Should create statefulset", func() {
+		sts := &appsv1.StatefulSet{}
+		homeDir := ethereum2Clients.Web3SignerHomeDir
+
+		Expect(k8sClient.Get(context.Background(), key, sts)).To(Succeed())
+		Expect(sts.GetOwnerReferences()).To(ContainElement(signerOwnerReference))
+		Expect(sts.Spec.Template.Spec.Containers[0].Image).To(Equal(testImage))
+		Expect(sts.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElements(
+			corev1.VolumeMount{
+				Name:      "config",
+				MountPath: shared.PathConfig(homeDir),
+			},
+			corev1.VolumeMount{
+				Name:      "my-validator",
+				ReadOnly:  true,
+				MountPath: fmt.Sprintf("%s/validator-keys/my-validator", shared.PathSecrets(homeDir)),
+			},
+		))
+	})
+
+	It("Should allocate correct resources to remote signer statefulset", func() {
+		sts := &appsv1.StatefulSet{}
+		expectedResources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(ethereum2v1alpha1.DefaultRemoteSignerCPURequest),
+				corev1.ResourceMemory: resource.MustParse(ethereum2v1alpha1.DefaultRemoteSignerMemoryRequest),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(ethereum2v1alpha1.DefaultRemoteSignerCPULimit),
+				corev1.ResourceMemory: resource.MustParse(ethereum2v1alpha1.DefaultRemoteSignerMemoryLimit),
+			},
+		}
+		Expect(k8sClient.Get(context.Background(), key, sts)).To(Succeed())
+		Expect(sts.Spec.Template.Spec.Containers[0].Resources).To(Equal(expectedResources))
+	})
+
+	It("Should create remote signer configmap", func() {
+		configmap := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(context.Background(), key, configmap)).To(Succeed())
+		Expect(configmap.GetOwnerReferences()).To(ContainElement(signerOwnerReference))
+		Expect(configmap.Data).To(HaveKey("my-validator.yaml"))
+	})
+
+	It("Should create remote signer service", func() {
+		svc := &corev1.Service{}
+		Expect(k8sClient.Get(context.Background(), key, svc)).To(Succeed())
+		Expect(svc.GetOwnerReferences()).To(ContainElement(signerOwnerReference))
+		Expect(svc.Spec.Ports).To(ContainElements(
+			corev1.ServicePort{
+				Name:       "http",
+				Port:       int32(ethereum2v1alpha1.DefaultRemoteSignerPort),
+				TargetPort: intstr.FromInt(int(ethereum2v1alpha1.DefaultRemoteSignerPort)),
+				Protocol:   corev1.ProtocolTCP,
+			},
+		))
+	})
+
+	It("Should report remote signer url", func() {
+		fetched := &ethereum2v1alpha1.RemoteSigner{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.URL).To(Equal(fmt.Sprintf("http://web3signer.web3signer.svc:%d", ethereum2v1alpha1.DefaultRemoteSignerPort)))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
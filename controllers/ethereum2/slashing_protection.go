@@ -0,0 +1,325 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+	"github.com/kotalco/kotal/controllers/shared"
+)
+
+// SlashingProtectionFinalizer is validator finalizer exporting slashing protection interchange before deletion
+const SlashingProtectionFinalizer = "ethereum2.kotal.io/slashing-protection-export"
+
+// slashingProtectionStopped returns true if validator is stopped for exporting slashing protection interchange
+func slashingProtectionStopped(validator *ethereum2v1alpha1.Validator) bool {
+	if validator.Spec.SlashingProtection == nil {
+		return false
+	}
+	return validator.Spec.SlashingProtection.Export || !validator.DeletionTimestamp.IsZero()
+}
+
+// slashingProtectionSwitched returns true if validator client has been switched after exporting slashing protection interchange
+func slashingProtectionSwitched(validator *ethereum2v1alpha1.Validator) bool {
+	exportedClient := validator.Status.SlashingProtection.ExportedClient
+	return exportedClient != "" && exportedClient != validator.Spec.Client
+}
+
+// slashingProtectionVolumes returns slashing protection interchanges volumes
+// interchange is imported from user provided secret or config map,
+// and from exported interchange after switching validator client
+func slashingProtectionVolumes(validator *ethereum2v1alpha1.Validator) (volumes []corev1.Volume) {
+	if validator.Spec.SlashingProtection == nil {
+		return
+	}
+
+	items := []corev1.KeyToPath{
+		{
+			Key:  "interchange.json",
+			Path: "interchange.json",
+		},
+	}
+
+	if source := validator.Spec.SlashingProtection.Import; source != nil {
+		volume := corev1.Volume{Name: "slashing-protection"}
+		if source.SecretName != "" {
+			volume.VolumeSource.Secret = &corev1.SecretVolumeSource{
+				SecretName: source.SecretName,
+				Items:      items,
+			}
+		} else {
+			volume.VolumeSource.ConfigMap = &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: source.ConfigMapName,
+				},
+				Items: items,
+			}
+		}
+		volumes = append(volumes, volume)
+	}
+
+	if slashingProtectionSwitched(validator) {
+		volumes = append(volumes, corev1.Volume{
+			Name: "exported-slashing-protection",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: validator.Status.SlashingProtection.ExportSecretName,
+					Items:      items,
+				},
+			},
+		})
+	}
+
+	return
+}
+
+// slashingProtectionVolumeMounts returns slashing protection interchanges volume mounts
+func slashingProtectionVolumeMounts(validator *ethereum2v1alpha1.Validator, homeDir string) (mounts []corev1.VolumeMount) {
+	for _, volume := range slashingProtectionVolumes(validator) {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      volume.Name,
+			ReadOnly:  true,
+			MountPath: fmt.Sprintf("%s/%s", shared.PathSecrets(homeDir), volume.Name),
+		})
+	}
+	return
+}
+
+// slashingProtectionImportContainers returns init containers importing slashing protection interchanges
+func slashingProtectionImportContainers(validator *ethereum2v1alpha1.Validator, protector ethereum2Clients.SlashingProtector, homeDir string, mounts []corev1.VolumeMount) (containers []corev1.Container) {
+	if protector == nil {
+		return
+	}
+
+	for _, volume := range slashingProtectionVolumes(validator) {
+		file := fmt.Sprintf("%s/%s/interchange.json", shared.PathSecrets(homeDir), volume.Name)
+		containers = append(containers, corev1.Container{
+			Name:         fmt.Sprintf("import-%s", volume.Name),
+			Image:        validator.Spec.Image,
+			Command:      protector.ImportSlashingProtection(file),
+			VolumeMounts: mounts,
+		})
+	}
+
+	return
+}
+
+// exportSlashingProtection exports slashing protection interchange of stopped validator into export secret
+// it returns true if export has completed
+func (r *ValidatorReconciler) exportSlashingProtection(ctx context.Context, validator *ethereum2v1alpha1.Validator, protector ethereum2Clients.SlashingProtector, homeDir string) (bool, error) {
+	sts := &appsv1.StatefulSet{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: validator.Name, Namespace: validator.Namespace}, sts); err != nil {
+		return false, err
+	}
+
+	// validator pod must be terminated before mounting its data volume, and signing must stop before export
+	if sts.Status.Replicas != 0 {
+		return false, nil
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-slashing-protection-export", validator.Name),
+			Namespace: validator.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, job, func() error {
+		if err := ctrl.SetControllerReference(validator, job, r.Scheme); err != nil {
+			return err
+		}
+
+		// job pod template is immutable
+		if job.CreationTimestamp.IsZero() {
+			r.specExportJob(validator, job, protector, homeDir)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	// failed jobs are kept for inspection, and deleted by users to retry export
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			msg := fmt.Sprintf("export job %s failed: %s, delete the job to retry", job.Name, condition.Message)
+			setSlashingProtectionCondition(validator, metav1.ConditionFalse, ethereum2v1alpha1.ExportFailedReason, msg)
+			return false, nil
+		}
+	}
+
+	if job.Status.Succeeded == 0 {
+		return false, nil
+	}
+
+	interchange, err := r.exportJobInterchange(ctx, job)
+	if err != nil {
+		return false, err
+	}
+
+	if err := validateInterchange(interchange); err != nil {
+		msg := fmt.Sprintf("export job %s printed invalid interchange: %s, delete the job to retry", job.Name, err)
+		setSlashingProtectionCondition(validator, metav1.ConditionFalse, ethereum2v1alpha1.InvalidInterchangeReason, msg)
+		return false, nil
+	}
+
+	// export secret isn't owned by the validator, so it outlives validator deletion
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      validator.Spec.SlashingProtection.ExportSecretName,
+			Namespace: validator.Namespace,
+		},
+	}
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.ObjectMeta.Labels = validator.GetLabels()
+		secret.Data = map[string][]byte{
+			"interchange.json": interchange,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	// next export runs a new job against latest validator data
+	if err := r.Client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	setSlashingProtectionCondition(validator, metav1.ConditionTrue, ethereum2v1alpha1.ExportedReason, fmt.Sprintf("interchange exported into secret %s", secret.Name))
+
+	return true, nil
+}
+
+// setSlashingProtectionCondition sets validator slashing protection export condition
+func setSlashingProtectionCondition(validator *ethereum2v1alpha1.Validator, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&validator.Status.Conditions, metav1.Condition{
+		Type:               ethereum2v1alpha1.SlashingProtectionExportedCondition,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: validator.Generation,
+	})
+}
+
+// validateInterchange validates exported data is EIP-3076 slashing protection interchange
+// export job logs can have client output other than the interchange, which must not be saved as interchange
+func validateInterchange(data []byte) error {
+	if !json.Valid(data) {
+		return errors.New("not valid json")
+	}
+
+	var interchange struct {
+		Metadata *struct {
+			InterchangeFormatVersion string `json:"interchange_format_version"`
+			GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+		} `json:"metadata"`
+		Data []json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &interchange); err != nil {
+		return err
+	}
+
+	if interchange.Metadata == nil || interchange.Metadata.InterchangeFormatVersion == "" || interchange.Metadata.GenesisValidatorsRoot == "" {
+		return errors.New("missing metadata interchange_format_version or genesis_validators_root")
+	}
+
+	if interchange.Data == nil {
+		return errors.New("missing data")
+	}
+
+	return nil
+}
+
+// exportJobInterchange returns slashing protection interchange printed by export job pod
+func (r *ValidatorReconciler) exportJobInterchange(ctx context.Context, job *batchv1.Job) ([]byte, error) {
+	pods := &corev1.PodList{}
+	if err := r.Client.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+		return r.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: "interchange"}).DoRaw(ctx)
+	}
+
+	return nil, fmt.Errorf("slashing protection export job %s has no succeeded pods", job.Name)
+}
+
+// specExportJob updates slashing protection export job spec
+// interchange is exported into data volume by init container, then printed by job container
+func (r *ValidatorReconciler) specExportJob(validator *ethereum2v1alpha1.Validator, job *batchv1.Job, protector ethereum2Clients.SlashingProtector, homeDir string) {
+	job.Labels = validator.GetLabels()
+
+	exportDir := fmt.Sprintf("%s/kotal-slashing-protection", shared.PathData(homeDir))
+
+	mounts := []corev1.VolumeMount{
+		{
+			Name:      "data",
+			MountPath: shared.PathData(homeDir),
+		},
+	}
+	// custom network genesis is required by export commands
+	mounts = append(mounts, validatorGenesisVolumeMounts(validator, homeDir)...)
+
+	volumes := []corev1.Volume{
+		{
+			Name: "data",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: validator.Name,
+				},
+			},
+		},
+	}
+	volumes = append(volumes, validatorGenesisVolumes(validator)...)
+
+	job.Spec = batchv1.JobSpec{
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				SecurityContext: shared.SecurityContext(),
+				RestartPolicy:   corev1.RestartPolicyNever,
+				InitContainers: []corev1.Container{
+					{
+						Name:    "export",
+						Image:   validator.Spec.Image,
+						Command: []string{"/bin/sh", "-c"},
+						Args: []string{
+							fmt.Sprintf("mkdir -p %s && %s", exportDir, strings.Join(protector.ExportSlashingProtection(exportDir), " ")),
+						},
+						VolumeMounts: mounts,
+					},
+				},
+				Containers: []corev1.Container{
+					{
+						Name:         "interchange",
+						Image:        validator.Spec.Image,
+						Command:      []string{"cat", fmt.Sprintf("%s/%s", exportDir, ethereum2Clients.SlashingProtectionFile)},
+						VolumeMounts: mounts,
+					},
+				},
+				Volumes: volumes,
+			},
+		},
+	}
+}
@@ -16,6 +16,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
@@ -66,6 +67,9 @@ var _ = BeforeSuite(func() {
 	err = ethereum2v1alpha1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = ethereumv1alpha1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
 	// +kubebuilder:scaffold:scheme
 
 	// create new controller manager
@@ -96,6 +100,38 @@ var _ = BeforeSuite(func() {
 	validatorReconciler.SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
+	// start remote signer reconciler
+	remoteSignerReconciler := &RemoteSignerReconciler{
+		Client: k8sManager.GetClient(),
+		Scheme: scheme.Scheme,
+	}
+	remoteSignerReconciler.SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// start validator keys reconciler
+	validatorKeysReconciler := &ValidatorKeysReconciler{
+		Client: k8sManager.GetClient(),
+		Scheme: scheme.Scheme,
+	}
+	validatorKeysReconciler.SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// start voluntary exit reconciler
+	voluntaryExitReconciler := &VoluntaryExitReconciler{
+		Client: k8sManager.GetClient(),
+		Scheme: scheme.Scheme,
+	}
+	voluntaryExitReconciler.SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// start mev-boost reconciler
+	mevBoostReconciler := &MEVBoostReconciler{
+		Client: k8sManager.GetClient(),
+		Scheme: scheme.Scheme,
+	}
+	mevBoostReconciler.SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
 	go func() {
 		err = k8sManager.Start(ctx)
 		Expect(err).ToNot(HaveOccurred())
@@ -3,16 +3,29 @@ package controllers
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
 	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
@@ -23,6 +36,8 @@ import (
 type ValidatorReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// clientset reads slashing protection export job logs
+	clientset kubernetes.Interface
 }
 
 var (
@@ -32,12 +47,22 @@ var (
 	LighthouseImportKeyStore string
 	//go:embed nimbus_copy_validators.sh
 	NimbusCopyValidators string
+	//go:embed lodestar_import_keystore.sh
+	LodestarImportKeyStore string
 )
 
+// keysStatusPollInterval is the interval validator keys status is polled from beacon node
+const keysStatusPollInterval = time.Minute
+
 // +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=validators,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=validators/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=watch;get;list;create;update;delete
 // +kubebuilder:rbac:groups=core,resources=configmaps;persistentvolumeclaims,verbs=watch;get;create;update;list;delete
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=remotesigners,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=watch;get;list;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list
+// +kubebuilder:rbac:groups=core,resources=pods/log,verbs=get
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=watch;get;create;update;list
 
 // Reconcile reconciles Ethereum 2.0 validator client
 func (r *ValidatorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
@@ -48,6 +73,15 @@ func (r *ValidatorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return
 	}
 
+	if err = r.reconcileFinalizer(ctx, &validator); err != nil {
+		return
+	}
+
+	// validator is being deleted, and slashing protection export isn't required
+	if !validator.DeletionTimestamp.IsZero() && !controllerutil.ContainsFinalizer(&validator, SlashingProtectionFinalizer) {
+		return
+	}
+
 	// default the peer if webhooks are disabled
 	if !shared.IsWebhookEnabled() {
 		validator.Default()
@@ -55,6 +89,16 @@ func (r *ValidatorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	shared.UpdateLabels(&validator, string(validator.Spec.Client))
 
+	if validator.Spec.RemoteSigner != "" {
+		if err = r.resolveRemoteSigner(ctx, &validator); err != nil {
+			return
+		}
+	}
+
+	// keystores secrets can be created after the validator, and validator pods wait for them
+	// so workloads are reconciled, then keystores public keys are retried by returning the error
+	keysErr := r.resolvePublicKeys(ctx, &validator)
+
 	if err = r.reconcileConfigmap(ctx, &validator); err != nil {
 		return
 	}
@@ -63,13 +107,228 @@ func (r *ValidatorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return
 	}
 
+	migrationWait, err := r.reconcileMigration(ctx, &validator)
+	if err != nil {
+		return
+	}
+
 	if err = r.reconcileStatefulset(ctx, &validator); err != nil {
 		return
 	}
 
+	if err = r.reconcileSlashingProtectionExport(ctx, &validator); err != nil {
+		return
+	}
+
+	// keys status isn't reported with missing public keys
+	if keysErr == nil {
+		r.pollKeysStatus(ctx, &validator)
+	}
+
+	if err = r.updateStatus(ctx, &validator); err != nil {
+		return
+	}
+
+	if validator.Status.TotalKeys != 0 {
+		result.RequeueAfter = keysStatusPollInterval
+	}
+
+	if migrationWait != 0 && (result.RequeueAfter == 0 || migrationWait < result.RequeueAfter) {
+		result.RequeueAfter = migrationWait
+	}
+
+	// slashing protection interchange has been exported, validator can be deleted
+	if !validator.DeletionTimestamp.IsZero() && validator.Status.SlashingProtection.Exported {
+		patch := client.MergeFrom(validator.DeepCopy())
+		controllerutil.RemoveFinalizer(&validator, SlashingProtectionFinalizer)
+		err = r.Client.Patch(ctx, &validator, patch)
+	}
+
+	if err == nil && validator.DeletionTimestamp.IsZero() {
+		err = keysErr
+	}
+
+	return
+}
+
+// reconcileFinalizer adds slashing protection export finalizer if slashing protection is configured, or removes it
+func (r *ValidatorReconciler) reconcileFinalizer(ctx context.Context, validator *ethereum2v1alpha1.Validator) error {
+	patch := client.MergeFrom(validator.DeepCopy())
+
+	if validator.Spec.SlashingProtection != nil && validator.DeletionTimestamp.IsZero() {
+		if !controllerutil.AddFinalizer(validator, SlashingProtectionFinalizer) {
+			return nil
+		}
+	} else if validator.Spec.SlashingProtection == nil || validator.Spec.SlashingProtection.SkipExportOnDeletion {
+		// slashing protection isn't configured, or export is skipped on deletion
+		if !controllerutil.RemoveFinalizer(validator, SlashingProtectionFinalizer) {
+			return nil
+		}
+	} else {
+		return nil
+	}
+
+	return r.Client.Patch(ctx, validator, patch)
+}
+
+// reconcileSlashingProtectionExport exports slashing protection interchange if validator is stopped for export
+func (r *ValidatorReconciler) reconcileSlashingProtectionExport(ctx context.Context, validator *ethereum2v1alpha1.Validator) error {
+	if !slashingProtectionStopped(validator) {
+		validator.Status.SlashingProtection.Exported = false
+		meta.RemoveStatusCondition(&validator.Status.Conditions, ethereum2v1alpha1.SlashingProtectionExportedCondition)
+		return nil
+	}
+
+	if validator.Status.SlashingProtection.Exported {
+		return nil
+	}
+
+	client, err := ethereum2Clients.NewClient(validator)
+	if err != nil {
+		return err
+	}
+
+	protector, ok := client.(ethereum2Clients.SlashingProtector)
+	if !ok {
+		return fmt.Errorf("client %s doesn't support slashing protection export", validator.Spec.Client)
+	}
+
+	exported, err := r.exportSlashingProtection(ctx, validator, protector, client.HomeDir())
+	if err != nil || !exported {
+		return err
+	}
+
+	validator.Status.SlashingProtection.Exported = true
+	validator.Status.SlashingProtection.ExportedClient = validator.Spec.Client
+	validator.Status.SlashingProtection.ExportSecretName = validator.Spec.SlashingProtection.ExportSecretName
+
+	return nil
+}
+
+// resolveRemoteSigner resolves remote signer url and signed for public keys from referenced remote signer
+func (r *ValidatorReconciler) resolveRemoteSigner(ctx context.Context, validator *ethereum2v1alpha1.Validator) error {
+	// remote signers are referenced in the same namespace only
+	key := types.NamespacedName{Name: validator.Spec.RemoteSigner, Namespace: validator.Namespace}
+
+	signer := &ethereum2v1alpha1.RemoteSigner{}
+	if err := r.Client.Get(ctx, key, signer); err != nil {
+		return err
+	}
+
+	if signer.Spec.Network != validator.Spec.Network {
+		return fmt.Errorf("remote signer %s is signing for network %s", key, signer.Spec.Network)
+	}
+
+	validator.Status.RemoteSignerURL = fmt.Sprintf("http://%s.%s.svc:%d", signer.Name, signer.Namespace, signer.Spec.Port)
+
+	validator.Status.PublicKeys = []string{}
+	for _, keystore := range signer.Spec.Keystores {
+		validator.Status.PublicKeys = append(validator.Status.PublicKeys, keystore.PublicKey)
+	}
+
+	return nil
+}
+
+// resolvePublicKeys derives missing keystores public keys from keystores json
+// keystores that can't be read are retried, so validator keys status isn't reported with missing keys
+func (r *ValidatorReconciler) resolvePublicKeys(ctx context.Context, validator *ethereum2v1alpha1.Validator) error {
+	for i := range validator.Spec.Keystores {
+		keystore := &validator.Spec.Keystores[i]
+		if keystore.PublicKey != "" {
+			continue
+		}
+
+		key := types.NamespacedName{Name: keystore.SecretName, Namespace: validator.Namespace}
+		content, err := shared.GetSecret(ctx, r.Client, key, "keystore")
+		if err != nil {
+			return fmt.Errorf("unable to get keystore secret %s: %w", keystore.SecretName, err)
+		}
+
+		var encrypted struct {
+			PublicKey string `json:"pubkey"`
+		}
+		if err := json.Unmarshal([]byte(content), &encrypted); err != nil {
+			return fmt.Errorf("unable to parse keystore secret %s: %w", keystore.SecretName, err)
+		}
+
+		// public key is optional in EIP-2335 keystores
+		if encrypted.PublicKey == "" {
+			log.FromContext(ctx).Info("keystore has no public key", "secret", keystore.SecretName)
+			continue
+		}
+
+		keystore.PublicKey = "0x" + strings.TrimPrefix(encrypted.PublicKey, "0x")
+	}
+
+	return nil
+}
+
+// publicKeys returns public keys of validator keys
+func publicKeys(validator *ethereum2v1alpha1.Validator) (keys []string) {
+	if validator.Spec.RemoteSigner != "" {
+		return validator.Status.PublicKeys
+	}
+	for _, keystore := range validator.Spec.Keystores {
+		if keystore.PublicKey != "" {
+			keys = append(keys, keystore.PublicKey)
+		}
+	}
 	return
 }
 
+// pollKeysStatus updates validator keys status from the first reachable beacon node REST API
+// beacon endpoints that aren't REST API urls like prysm gRPC endpoints are skipped
+func (r *ValidatorReconciler) pollKeysStatus(ctx context.Context, validator *ethereum2v1alpha1.Validator) {
+	keys := publicKeys(validator)
+	validator.Status.TotalKeys = len(keys)
+
+	if len(keys) == 0 {
+		validator.Status.Keys = nil
+		validator.Status.ActiveKeys = 0
+		return
+	}
+
+	for _, endpoint := range validator.Spec.BeaconEndpoints {
+		client, err := ethereum2Clients.NewBeaconAPIClient(endpoint)
+		if err != nil {
+			continue
+		}
+
+		statuses, err := client.ValidatorKeys(ctx, keys)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "unable to get validator keys status", "endpoint", endpoint)
+			continue
+		}
+
+		validator.Status.Keys = statuses
+		validator.Status.ActiveKeys = 0
+		for _, status := range statuses {
+			if status.State == ethereum2v1alpha1.ActiveValidatorKey {
+				validator.Status.ActiveKeys++
+			}
+		}
+		return
+	}
+}
+
+// updateStatus updates validator status
+func (r *ValidatorReconciler) updateStatus(ctx context.Context, validator *ethereum2v1alpha1.Validator) error {
+	validator.Status.RemoteSigner = ""
+	if validator.Spec.RemoteSigner != "" {
+		validator.Status.RemoteSigner = shared.ParseReference(validator.Spec.RemoteSigner, validator.Namespace).String()
+	} else {
+		validator.Status.RemoteSignerURL = ""
+		validator.Status.PublicKeys = nil
+	}
+
+	if err := r.Status().Update(ctx, validator); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update validator status")
+		return err
+	}
+
+	return nil
+}
+
 // reconcilePVC reconciles validator persistent volume claim
 func (r *ValidatorReconciler) reconcilePVC(ctx context.Context, validator *ethereum2v1alpha1.Validator) error {
 	pvc := corev1.PersistentVolumeClaim{
@@ -145,6 +404,8 @@ func (r *ValidatorReconciler) createValidatorVolumes(validator *ethereum2v1alpha
 	}
 	volumes = append(volumes, configVolume)
 
+	volumes = append(volumes, validatorGenesisVolumes(validator)...)
+
 	// validator key/secret volumes
 	for i, keystore := range validator.Spec.Keystores {
 
@@ -210,7 +471,7 @@ func (r *ValidatorReconciler) createValidatorVolumes(validator *ethereum2v1alpha
 	// end of keystores loop
 
 	// nimbus: create projected volume that holds all secrets
-	if validator.Spec.Client == ethereum2v1alpha1.NimbusClient {
+	if validator.Spec.Client == ethereum2v1alpha1.NimbusClient && validator.Spec.RemoteSigner == "" {
 		validatorSecretsVolume := corev1.Volume{
 			Name: "validator-secrets",
 			VolumeSource: corev1.VolumeSource{
@@ -222,8 +483,10 @@ func (r *ValidatorReconciler) createValidatorVolumes(validator *ethereum2v1alpha
 		volumes = append(volumes, validatorSecretsVolume)
 	}
 
+	volumes = append(volumes, slashingProtectionVolumes(validator)...)
+
 	// prysm: wallet password volume
-	if validator.Spec.Client == ethereum2v1alpha1.PrysmClient {
+	if validator.Spec.Client == ethereum2v1alpha1.PrysmClient && validator.Spec.RemoteSigner == "" {
 		walletPasswordVolume := corev1.Volume{
 			// TODO: rename volume name to prysm-wallet-password
 			Name: validator.Spec.WalletPasswordSecret,
@@ -240,24 +503,88 @@ func (r *ValidatorReconciler) createValidatorVolumes(validator *ethereum2v1alpha
 			},
 		}
 		volumes = append(volumes, walletPasswordVolume)
+	}
 
-		// tls certificate
-		if validator.Spec.CertSecretName != "" {
-			certVolume := corev1.Volume{
-				Name: "cert",
-				VolumeSource: corev1.VolumeSource{
-					Secret: &corev1.SecretVolumeSource{
-						SecretName: validator.Spec.CertSecretName,
-					},
+	// prysm: tls certificate
+	if validator.Spec.Client == ethereum2v1alpha1.PrysmClient && validator.Spec.CertSecretName != "" {
+		certVolume := corev1.Volume{
+			Name: "cert",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: validator.Spec.CertSecretName,
 				},
-			}
-			volumes = append(volumes, certVolume)
+			},
 		}
+		volumes = append(volumes, certVolume)
+	}
+
+	return
+}
+
+// validatorGenesisVolumes returns custom network genesis volumes
+func validatorGenesisVolumes(validator *ethereum2v1alpha1.Validator) (volumes []corev1.Volume) {
+	if validator.Spec.Genesis == nil {
+		return
+	}
+
+	volumes = append(volumes, corev1.Volume{
+		Name: "genesis",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: validator.Spec.Genesis.ConfigMapName,
+				},
+				Items: []corev1.KeyToPath{
+					{
+						Key:  ethereum2Clients.GenesisConfigFile,
+						Path: ethereum2Clients.GenesisConfigFile,
+					},
+					{
+						Key:  ethereum2Clients.GenesisStateFile,
+						Path: ethereum2Clients.GenesisStateFile,
+					},
+					{
+						Key:  ethereum2Clients.GenesisDeployBlockFile,
+						Path: ethereum2Clients.GenesisDeployBlockFile,
+					},
+				},
+			},
+		},
+	})
+
+	return
+}
+
+// validatorGenesisVolumeMounts returns custom network genesis volume mounts
+// genesis is mounted into its own directory, config directory is used by validator config map
+func validatorGenesisVolumeMounts(validator *ethereum2v1alpha1.Validator, homeDir string) (mounts []corev1.VolumeMount) {
+	if validator.Spec.Genesis == nil {
+		return
 	}
 
+	mounts = append(mounts, corev1.VolumeMount{
+		Name:      "genesis",
+		MountPath: ethereum2Clients.GenesisDir(homeDir),
+		ReadOnly:  true,
+	})
+
 	return
 }
 
+// validatorGenesisEnv returns custom network genesis directory environment variable used by keystore import scripts
+func validatorGenesisEnv(validator *ethereum2v1alpha1.Validator, homeDir string) []corev1.EnvVar {
+	if validator.Spec.Genesis == nil {
+		return nil
+	}
+
+	return []corev1.EnvVar{
+		{
+			Name:  "KOTAL_GENESIS_PATH",
+			Value: ethereum2Clients.GenesisDir(homeDir),
+		},
+	}
+}
+
 // createValidatorVolumeMounts creates validator volume mounts
 // secrets-dir/
 // |___validator-keys/
@@ -282,6 +609,8 @@ func (r *ValidatorReconciler) createValidatorVolumeMounts(validator *ethereum2v1
 	}
 	mounts = append(mounts, configMount)
 
+	mounts = append(mounts, validatorGenesisVolumeMounts(validator, homeDir)...)
+
 	for _, keystore := range validator.Spec.Keystores {
 
 		keystoreMount := corev1.VolumeMount{
@@ -292,26 +621,27 @@ func (r *ValidatorReconciler) createValidatorVolumeMounts(validator *ethereum2v1
 	}
 
 	// prysm wallet password
-	if validator.Spec.Client == ethereum2v1alpha1.PrysmClient {
+	if validator.Spec.Client == ethereum2v1alpha1.PrysmClient && validator.Spec.RemoteSigner == "" {
 		walletPasswordMount := corev1.VolumeMount{
 			Name:      validator.Spec.WalletPasswordSecret,
 			ReadOnly:  true,
 			MountPath: fmt.Sprintf("%s/prysm-wallet", shared.PathSecrets(homeDir)),
 		}
 		mounts = append(mounts, walletPasswordMount)
+	}
 
-		if validator.Spec.CertSecretName != "" {
-			certMount := corev1.VolumeMount{
-				Name:      "cert",
-				ReadOnly:  true,
-				MountPath: fmt.Sprintf("%s/cert", shared.PathSecrets(homeDir)),
-			}
-			mounts = append(mounts, certMount)
+	// prysm tls certificate
+	if validator.Spec.Client == ethereum2v1alpha1.PrysmClient && validator.Spec.CertSecretName != "" {
+		certMount := corev1.VolumeMount{
+			Name:      "cert",
+			ReadOnly:  true,
+			MountPath: fmt.Sprintf("%s/cert", shared.PathSecrets(homeDir)),
 		}
+		mounts = append(mounts, certMount)
 	}
 
 	// nimbus client
-	if validator.Spec.Client == ethereum2v1alpha1.NimbusClient {
+	if validator.Spec.Client == ethereum2v1alpha1.NimbusClient && validator.Spec.RemoteSigner == "" {
 		ValidatorSecretsMount := corev1.VolumeMount{
 			Name:      "validator-secrets",
 			MountPath: fmt.Sprintf("%s/validator-secrets", shared.PathSecrets(homeDir)),
@@ -319,11 +649,13 @@ func (r *ValidatorReconciler) createValidatorVolumeMounts(validator *ethereum2v1
 		mounts = append(mounts, ValidatorSecretsMount)
 	}
 
+	mounts = append(mounts, slashingProtectionVolumeMounts(validator, homeDir)...)
+
 	return
 }
 
 // specStatefulset updates vvalidator statefulset spec
-func (r *ValidatorReconciler) specStatefulset(validator *ethereum2v1alpha1.Validator, sts *appsv1.StatefulSet, command, args []string, homeDir string) {
+func (r *ValidatorReconciler) specStatefulset(validator *ethereum2v1alpha1.Validator, sts *appsv1.StatefulSet, command, args []string, homeDir string, protector ethereum2Clients.SlashingProtector) {
 
 	sts.Labels = validator.GetLabels()
 
@@ -366,6 +698,7 @@ func (r *ValidatorReconciler) specStatefulset(validator *ethereum2v1alpha1.Valid
 				Args:         []string{fmt.Sprintf("%s/prysm_import_keystore.sh", shared.PathConfig(homeDir))},
 				VolumeMounts: mounts,
 			}
+			importKeystoreContainer.Env = append(importKeystoreContainer.Env, validatorGenesisEnv(validator, homeDir)...)
 			initContainers = append(initContainers, importKeystoreContainer)
 		}
 	}
@@ -398,13 +731,72 @@ func (r *ValidatorReconciler) specStatefulset(validator *ethereum2v1alpha1.Valid
 				Args:         []string{fmt.Sprintf("%s/lighthouse_import_keystore.sh", shared.PathConfig(homeDir))},
 				VolumeMounts: mounts,
 			}
+			importKeystoreContainer.Env = append(importKeystoreContainer.Env, validatorGenesisEnv(validator, homeDir)...)
 			initContainers = append(initContainers, importKeystoreContainer)
 
 		}
 		// TODO: delete validator definitions file
+
+		// remote signer keys and per key proposer config are loaded from validator definitions file in validators directory
+		if validator.Spec.RemoteSigner != "" || ethereum2Clients.ProposerConfigured(validator) {
+			validatorsDir := fmt.Sprintf("%s/validators", shared.PathData(homeDir))
+			copyDefinitionsContainer := corev1.Container{
+				Name:  "copy-validator-definitions",
+				Image: validator.Spec.Image,
+				Command: []string{
+					"/bin/sh",
+					"-c",
+				},
+				Args: []string{
+					fmt.Sprintf(`
+						mkdir -p %s &&
+						cp %s/validator_definitions.yml %s/validator_definitions.yml`,
+						validatorsDir,
+						shared.PathConfig(homeDir),
+						validatorsDir,
+					),
+				},
+				VolumeMounts: mounts,
+			}
+			initContainers = append(initContainers, copyDefinitionsContainer)
+		}
+	}
+
+	// lodestar: import keystores into data dir keystores and secrets directories
+	if validator.Spec.Client == ethereum2v1alpha1.LodestarClient {
+		for i, keystore := range validator.Spec.Keystores {
+			keyDir := fmt.Sprintf("%s/validator-keys/%s", shared.PathSecrets(homeDir), keystore.SecretName)
+			importKeystoreContainer := corev1.Container{
+				Name:  fmt.Sprintf("import-keystore-%s", keystore.SecretName),
+				Image: validator.Spec.Image,
+				Env: []corev1.EnvVar{
+					{
+						Name:  "KOTAL_NETWORK",
+						Value: validator.Spec.Network,
+					},
+					{
+						Name:  "KOTAL_DATA_PATH",
+						Value: shared.PathData(homeDir),
+					},
+					{
+						Name:  "KOTAL_KEY_DIR",
+						Value: keyDir,
+					},
+					{
+						Name:  "KOTAL_KEYSTORE_INDEX",
+						Value: fmt.Sprintf("%d", i),
+					},
+				},
+				Command:      []string{"/bin/sh"},
+				Args:         []string{fmt.Sprintf("%s/lodestar_import_keystore.sh", shared.PathConfig(homeDir))},
+				VolumeMounts: mounts,
+			}
+			importKeystoreContainer.Env = append(importKeystoreContainer.Env, validatorGenesisEnv(validator, homeDir)...)
+			initContainers = append(initContainers, importKeystoreContainer)
+		}
 	}
 
-	if validator.Spec.Client == ethereum2v1alpha1.NimbusClient {
+	if validator.Spec.Client == ethereum2v1alpha1.NimbusClient && validator.Spec.RemoteSigner == "" {
 		// copy secrets into rw directory under blockchain data directory
 		validatorsPath := fmt.Sprintf("%s/kotal-validators", shared.PathData(homeDir))
 		copyValidators := corev1.Container{
@@ -419,6 +811,10 @@ func (r *ValidatorReconciler) specStatefulset(validator *ethereum2v1alpha1.Valid
 					Name:  "KOTAL_VALIDATORS_PATH",
 					Value: validatorsPath,
 				},
+				{
+					Name:  "KOTAL_CONFIG_PATH",
+					Value: shared.PathConfig(homeDir),
+				},
 			},
 			Command:      []string{"/bin/sh"},
 			Args:         []string{fmt.Sprintf("%s/nimbus_copy_validators.sh", shared.PathConfig(homeDir))},
@@ -427,7 +823,17 @@ func (r *ValidatorReconciler) specStatefulset(validator *ethereum2v1alpha1.Valid
 		initContainers = append(initContainers, copyValidators)
 	}
 
+	// slashing protection interchanges are imported after validator keys
+	initContainers = append(initContainers, slashingProtectionImportContainers(validator, protector, homeDir, mounts)...)
+
+	// validator is stopped while exporting slashing protection interchange or migrating from source validator
+	replicas := int32(1)
+	if slashingProtectionStopped(validator) || migrationPending(validator) {
+		replicas = 0
+	}
+
 	sts.Spec = appsv1.StatefulSetSpec{
+		Replicas: &replicas,
 		Selector: &metav1.LabelSelector{
 			MatchLabels: validator.GetLabels(),
 		},
@@ -480,13 +886,14 @@ func (r *ValidatorReconciler) reconcileStatefulset(ctx context.Context, validato
 	command := client.Command()
 	args := client.Args()
 	homeDir := client.HomeDir()
+	protector, _ := client.(ethereum2Clients.SlashingProtector)
 
 	_, err = ctrl.CreateOrUpdate(ctx, r.Client, &sts, func() error {
 		if err := ctrl.SetControllerReference(validator, &sts, r.Scheme); err != nil {
 			return err
 		}
 
-		r.specStatefulset(validator, &sts, command, args, homeDir)
+		r.specStatefulset(validator, &sts, command, args, homeDir, protector)
 
 		return nil
 	})
@@ -500,17 +907,94 @@ func (r *ValidatorReconciler) specConfigmap(validator *ethereum2v1alpha1.Validat
 		configmap.Data = map[string]string{}
 	}
 
+	proposerConfigured := ethereum2Clients.ProposerConfigured(validator)
+
+	// per validator key proposer config files are rendered again if still configured
+	delete(configmap.Data, ethereum2Clients.ProposerConfigFile)
+	for file := range configmap.Data {
+		if strings.HasPrefix(file, "0x") {
+			delete(configmap.Data, file)
+		}
+	}
+
 	switch validator.Spec.Client {
+	case ethereum2v1alpha1.TekuClient:
+		if proposerConfigured {
+			configmap.Data[ethereum2Clients.ProposerConfigFile] = proposerConfigJSON(validator)
+		}
 	case ethereum2v1alpha1.PrysmClient:
 		configmap.Data["prysm_import_keystore.sh"] = PrysmImportKeyStore
+		if proposerConfigured {
+			configmap.Data[ethereum2Clients.ProposerConfigFile] = proposerConfigJSON(validator)
+		}
 	case ethereum2v1alpha1.LighthouseClient:
 		configmap.Data["lighthouse_import_keystore.sh"] = LighthouseImportKeyStore
+		if validator.Spec.RemoteSigner != "" || proposerConfigured {
+			configmap.Data["validator_definitions.yml"] = lighthouseValidatorDefinitions(validator)
+		} else {
+			delete(configmap.Data, "validator_definitions.yml")
+		}
 	case ethereum2v1alpha1.NimbusClient:
 		configmap.Data["nimbus_copy_validators.sh"] = NimbusCopyValidators
+		for file, content := range nimbusProposerFiles(validator) {
+			configmap.Data[file] = content
+		}
+	case ethereum2v1alpha1.LodestarClient:
+		configmap.Data["lodestar_import_keystore.sh"] = LodestarImportKeyStore
+		if proposerConfigured {
+			configmap.Data[ethereum2Clients.ProposerConfigFile] = proposerConfigJSON(validator)
+		}
 	}
 
 }
 
+// lighthouseValidatorDefinitions returns lighthouse validator definitions
+// keys signed for by remote signer are web3signer definitions
+// imported keystores are local keystore definitions with per key fee recipient, graffiti and gas limit
+func lighthouseValidatorDefinitions(validator *ethereum2v1alpha1.Validator) string {
+	definitions := ""
+
+	if validator.Spec.RemoteSigner != "" {
+		for _, publicKey := range validator.Status.PublicKeys {
+			definitions += fmt.Sprintf(
+				"- enabled: true\n  voting_public_key: \"%s\"\n  type: web3signer\n  url: \"%s\"\n",
+				publicKey,
+				validator.Status.RemoteSignerURL,
+			)
+		}
+		return definitions
+	}
+
+	homeDir := ethereum2Clients.LighthouseHomeDir
+
+	for _, keystore := range validator.Spec.Keystores {
+		if keystore.PublicKey == "" {
+			continue
+		}
+		publicKey := strings.ToLower(keystore.PublicKey)
+		// keystores are imported into validators directory by lighthouse import keystore script
+		definitions += fmt.Sprintf(
+			"- enabled: true\n  voting_public_key: \"%s\"\n  type: local_keystore\n  voting_keystore_path: \"%s/validators/%s/voting-keystore.json\"\n  voting_keystore_password_path: \"%s/validator-keys/%s/password.txt\"\n",
+			publicKey,
+			shared.PathData(homeDir),
+			publicKey,
+			shared.PathSecrets(homeDir),
+			keystore.SecretName,
+		)
+		if keystore.FeeRecipient != "" {
+			definitions += fmt.Sprintf("  suggested_fee_recipient: \"%s\"\n", keystore.FeeRecipient)
+		}
+		if keystore.Graffiti != "" {
+			definitions += fmt.Sprintf("  graffiti: %q\n", keystore.Graffiti)
+		}
+		if keystore.GasLimit != 0 {
+			definitions += fmt.Sprintf("  gas_limit: %d\n", keystore.GasLimit)
+		}
+	}
+
+	return definitions
+}
+
 // reconcileConfigmap reconciles validator config map
 func (r *ValidatorReconciler) reconcileConfigmap(ctx context.Context, validator *ethereum2v1alpha1.Validator) error {
 
@@ -535,12 +1019,75 @@ func (r *ValidatorReconciler) reconcileConfigmap(ctx context.Context, validator
 	return err
 }
 
+// remoteSignerReference returns namespaced name of remote signer referenced by validator
+func remoteSignerReference(obj client.Object) []string {
+	validator := obj.(*ethereum2v1alpha1.Validator)
+
+	if validator.Spec.RemoteSigner == "" {
+		return nil
+	}
+
+	return []string{shared.ParseReference(validator.Spec.RemoteSigner, validator.Namespace).String()}
+}
+
+// signedValidators returns reconcile requests for validators referencing the given remote signer
+func (r *ValidatorReconciler) signedValidators(obj client.Object) []reconcile.Request {
+	var validators ethereum2v1alpha1.ValidatorList
+
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}.String()
+
+	if err := r.Client.List(context.Background(), &validators, client.MatchingFields{shared.ReferencesIndexKey: key}); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, validator := range validators.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      validator.Name,
+				Namespace: validator.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
 // SetupWithManager adds reconciler to the manager
 func (r *ValidatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &ethereum2v1alpha1.Validator{}, shared.ReferencesIndexKey, remoteSignerReference); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &ethereum2v1alpha1.Validator{}, migrationSourceIndexKey, migrationSource); err != nil {
+		return err
+	}
+
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	r.clientset = clientset
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&ethereum2v1alpha1.Validator{}).
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&batchv1.Job{}).
+		Watches(
+			&source.Kind{Type: &ethereum2v1alpha1.RemoteSigner{}},
+			handler.EnqueueRequestsFromMapFunc(r.signedValidators),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// validators migrating from source validator are stopped while source validator is running
+		Watches(
+			&source.Kind{Type: &ethereum2v1alpha1.Validator{}},
+			handler.EnqueueRequestsFromMapFunc(r.migratingValidators),
+		).
+		Watches(
+			&source.Kind{Type: &appsv1.StatefulSet{}},
+			handler.EnqueueRequestsFromMapFunc(r.migratingValidators),
+		).
 		Complete(r)
 }
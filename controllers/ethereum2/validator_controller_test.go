@@ -10,6 +10,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -838,6 +840,10 @@ var _ = Describe("Ethereum 2.0 validator client", func() {
 					Name:  "KOTAL_VALIDATORS_PATH",
 					Value: fmt.Sprintf("%s/kotal-validators", shared.PathData(ethereum2Clients.NimbusHomeDir)),
 				},
+				corev1.EnvVar{
+					Name:  "KOTAL_CONFIG_PATH",
+					Value: shared.PathConfig(ethereum2Clients.NimbusHomeDir),
+				},
 			))
 			Expect(validatorSts.Spec.Template.Spec.InitContainers[0].Command).To(ConsistOf("/bin/sh"))
 			Expect(validatorSts.Spec.Template.Spec.InitContainers[0].Args).To(ConsistOf(
@@ -901,4 +907,442 @@ var _ = Describe("Ethereum 2.0 validator client", func() {
 
 	})
 
+	Context("Teku validator client with slashing protection", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "teku-slashing-protection",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "teku-validator",
+			Namespace: ns.Name,
+		}
+
+		spec := ethereum2v1alpha1.ValidatorSpec{
+			Network:         "mainnet",
+			Client:          ethereum2v1alpha1.TekuClient,
+			BeaconEndpoints: []string{"http://10.96.130.88:9999"},
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{
+					SecretName: "my-validator",
+				},
+			},
+			SlashingProtection: &ethereum2v1alpha1.SlashingProtection{
+				Import: &ethereum2v1alpha1.SlashingProtectionSource{
+					ConfigMapName: "my-interchange",
+				},
+			},
+		}
+
+		toCreate := &ethereum2v1alpha1.Validator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: spec,
+		}
+
+		It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.TODO(), ns))
+		})
+
+		It("Should create validator client", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(5 * time.Second)
+		})
+
+		It("Should add slashing protection export finalizer", func() {
+			fetched := &ethereum2v1alpha1.Validator{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			Expect(fetched.GetFinalizers()).To(ContainElement(SlashingProtectionFinalizer))
+		})
+
+		It("Should import slashing protection interchange before starting validator", func() {
+			validatorSts := &appsv1.StatefulSet{}
+			homeDir := ethereum2Clients.TekuHomeDir
+			file := fmt.Sprintf("%s/slashing-protection/interchange.json", shared.PathSecrets(homeDir))
+
+			Expect(k8sClient.Get(context.Background(), key, validatorSts)).To(Succeed())
+			Expect(*validatorSts.Spec.Replicas).To(Equal(int32(1)))
+			Expect(validatorSts.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+			Expect(validatorSts.Spec.Template.Spec.InitContainers[0].Name).To(Equal("import-slashing-protection"))
+			Expect(validatorSts.Spec.Template.Spec.InitContainers[0].Command).To(ContainElement(
+				fmt.Sprintf("%s=%s", ethereum2Clients.TekuSlashingProtectionFrom, file),
+			))
+		})
+
+		It("Should stop validator for slashing protection export", func() {
+			fetched := &ethereum2v1alpha1.Validator{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			fetched.Spec.SlashingProtection.Export = true
+			Expect(k8sClient.Update(context.Background(), fetched)).To(Succeed())
+			time.Sleep(5 * time.Second)
+
+			validatorSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, validatorSts)).To(Succeed())
+			Expect(*validatorSts.Spec.Replicas).To(Equal(int32(0)))
+		})
+
+		It("Should keep deleted validator until slashing protection is exported", func() {
+			fetched := &ethereum2v1alpha1.Validator{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			Expect(k8sClient.Delete(context.Background(), fetched)).To(Succeed())
+			time.Sleep(5 * time.Second)
+
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			Expect(fetched.DeletionTimestamp.IsZero()).To(BeFalse())
+			Expect(fetched.GetFinalizers()).To(ContainElement(SlashingProtectionFinalizer))
+		})
+
+		It("Should delete validator if slashing protection export is skipped", func() {
+			fetched := &ethereum2v1alpha1.Validator{}
+			Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+			fetched.Spec.SlashingProtection.SkipExportOnDeletion = true
+			Expect(k8sClient.Update(context.Background(), fetched)).To(Succeed())
+			time.Sleep(5 * time.Second)
+
+			err := k8sClient.Get(context.Background(), key, fetched)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("Should accept exported slashing protection interchange", func() {
+			interchange := `{
+				"metadata": {
+					"interchange_format_version": "5",
+					"genesis_validators_root": "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+				},
+				"data": []
+			}`
+			Expect(validateInterchange([]byte(interchange))).To(Succeed())
+		})
+
+		It("Should reject exported data that isn't slashing protection interchange", func() {
+			Expect(validateInterchange([]byte("INFO exporting slashing protection"))).NotTo(Succeed())
+			Expect(validateInterchange([]byte(`{"data": []}`))).NotTo(Succeed())
+			Expect(validateInterchange([]byte(`{"metadata": {"interchange_format_version": "5", "genesis_validators_root": "0x04"}}`))).NotTo(Succeed())
+		})
+
+		It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		})
+
+	})
+
+	Context("Lighthouse validator client with per key proposer config", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "lighthouse-proposer-config",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "lighthouse-validator",
+			Namespace: ns.Name,
+		}
+
+		publicKey := "0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8"
+
+		spec := ethereum2v1alpha1.ValidatorSpec{
+			Network:         "mainnet",
+			Client:          ethereum2v1alpha1.LighthouseClient,
+			BeaconEndpoints: []string{"http://10.96.130.88:9999"},
+			FeeRecipient:    "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			Builder:         &ethereum2v1alpha1.ValidatorBuilder{},
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{
+					PublicKey:    publicKey,
+					SecretName:   "my-validator",
+					FeeRecipient: "0x71c7656ec7ab88b098defb751b7401b5f6d8976f",
+					Graffiti:     "Proposed by customer #1",
+					GasLimit:     36000000,
+				},
+			},
+		}
+
+		toCreate := &ethereum2v1alpha1.Validator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: spec,
+		}
+
+		It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.TODO(), ns))
+		})
+
+		It("Should create validator client", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(5 * time.Second)
+		})
+
+		It("Should render per key proposer config into validator definitions", func() {
+			homeDir := ethereum2Clients.LighthouseHomeDir
+			configmap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(context.Background(), key, configmap)).To(Succeed())
+			Expect(configmap.Data["validator_definitions.yml"]).To(Equal(fmt.Sprintf(
+				"- enabled: true\n  voting_public_key: \"%s\"\n  type: local_keystore\n  voting_keystore_path: \"%s/validators/%s/voting-keystore.json\"\n  voting_keystore_password_path: \"%s/validator-keys/my-validator/password.txt\"\n  suggested_fee_recipient: \"0x71c7656ec7ab88b098defb751b7401b5f6d8976f\"\n  graffiti: \"Proposed by customer #1\"\n  gas_limit: 36000000\n",
+				publicKey,
+				shared.PathData(homeDir),
+				publicKey,
+				shared.PathSecrets(homeDir),
+			)))
+		})
+
+		It("Should copy validator definitions after importing keystores", func() {
+			validatorSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, validatorSts)).To(Succeed())
+			initContainers := validatorSts.Spec.Template.Spec.InitContainers
+			Expect(initContainers).To(HaveLen(2))
+			Expect(initContainers[0].Name).To(Equal("import-keystore-my-validator"))
+			Expect(initContainers[1].Name).To(Equal("copy-validator-definitions"))
+		})
+
+		It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		})
+	})
+
+	Context("Lighthouse validator client migrating from source validator", func() {
+		const (
+			interval = 2 * time.Second
+			timeout  = 2 * time.Minute
+		)
+
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "lighthouse-migration",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "lighthouse-validator",
+			Namespace: ns.Name,
+		}
+
+		spec := ethereum2v1alpha1.ValidatorSpec{
+			Network:                "mainnet",
+			Client:                 ethereum2v1alpha1.LighthouseClient,
+			BeaconEndpoints:        []string{"http://10.96.130.88:9999"},
+			DoppelgangerProtection: true,
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{
+					SecretName: "my-validator",
+					PublicKey:  "0x83dbb18e088cb16a07fca598db2ac24da3e8549601eedd75eb28d8a9d4be405f49f7dbdcad5c9d7df54a8a40a143e852",
+				},
+			},
+			Migration: &ethereum2v1alpha1.ValidatorMigration{
+				Source: "old-validator",
+				Epochs: 1,
+			},
+		}
+
+		toCreate := &ethereum2v1alpha1.Validator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: spec,
+		}
+
+		It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.TODO(), ns))
+		})
+
+		It("Should create validator client", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(5 * time.Second)
+		})
+
+		sourceKey := types.NamespacedName{
+			Name:      "old-validator",
+			Namespace: ns.Name,
+		}
+
+		source := &ethereum2v1alpha1.Validator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sourceKey.Name,
+				Namespace: sourceKey.Namespace,
+			},
+			Spec: ethereum2v1alpha1.ValidatorSpec{
+				Network:         "mainnet",
+				Client:          ethereum2v1alpha1.LighthouseClient,
+				BeaconEndpoints: []string{"http://10.96.130.88:9999"},
+				Keystores:       spec.Keystores,
+			},
+		}
+
+		// migrationReason returns validator migration condition reason
+		migrationReason := func() string {
+			fetched := &ethereum2v1alpha1.Validator{}
+			if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+				return err.Error()
+			}
+			condition := meta.FindStatusCondition(fetched.Status.Conditions, ethereum2v1alpha1.MigratedCondition)
+			if condition == nil || condition.Status != metav1.ConditionFalse {
+				return ""
+			}
+			return condition.Reason
+		}
+
+		It("Should report source validator that doesn't exist", func() {
+			Expect(migrationReason()).To(Equal(ethereum2v1alpha1.SourceNotFoundReason))
+		})
+
+		It("Should keep validator stopped until migration completes", func() {
+			validatorSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, validatorSts)).To(Succeed())
+			Expect(*validatorSts.Spec.Replicas).To(Equal(int32(0)))
+			Expect(validatorSts.Spec.Template.Spec.Containers[0].Args).To(ContainElement(ethereum2Clients.LighthouseEnableDoppelgangerProtection))
+		})
+
+		It("Should create source validator", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				source.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), source)).Should(Succeed())
+		})
+
+		It("Should wait for running source validator even if its pod is gone", func() {
+			Eventually(migrationReason, timeout, interval).Should(Equal(ethereum2v1alpha1.SourceRunningReason))
+
+			Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "old-validator-0", Namespace: ns.Name}, &corev1.Pod{})).ToNot(Succeed())
+			Consistently(migrationReason, 10*time.Second, interval).Should(Equal(ethereum2v1alpha1.SourceRunningReason))
+
+			validatorSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, validatorSts)).To(Succeed())
+			Expect(*validatorSts.Spec.Replicas).To(Equal(int32(0)))
+		})
+
+		It("Should delete source validator", func() {
+			Expect(k8sClient.Delete(context.Background(), source)).To(Succeed())
+			// statefulsets aren't garbage collected by test environment
+			sourceSts := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      sourceKey.Name,
+					Namespace: sourceKey.Namespace,
+				},
+			}
+			err := k8sClient.Delete(context.Background(), sourceSts)
+			Expect(err == nil || apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("Should report waiting epochs after source validator is deleted", func() {
+			Eventually(migrationReason, timeout, interval).Should(Equal(ethereum2v1alpha1.WaitingEpochsReason))
+
+			validatorSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, validatorSts)).To(Succeed())
+			Expect(*validatorSts.Spec.Replicas).To(Equal(int32(0)))
+		})
+
+		It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		})
+	})
+
+	Context("Lighthouse validator client on custom network", func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "devnet-validator",
+			},
+		}
+
+		key := types.NamespacedName{
+			Name:      "lighthouse-validator",
+			Namespace: ns.Name,
+		}
+
+		toCreate := &ethereum2v1alpha1.Validator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: ethereum2v1alpha1.ValidatorSpec{
+				Network: "devnet",
+				Client:  ethereum2v1alpha1.LighthouseClient,
+				Genesis: &ethereum2v1alpha1.ValidatorGenesis{
+					ConfigMapName: "devnet-genesis",
+				},
+				BeaconEndpoints: []string{"http://10.96.130.88:9999"},
+				Keystores: []ethereum2v1alpha1.Keystore{
+					{
+						PublicKey:  "0x83bc2e8a2e2d1d9a9e8e3e5a0c43b8b5a5e9b1b5ee1c4e0a1d4d1bb3e9f0f6c5e2d5b3c6a7f8e9d0c1b2a3f4e5d6c7b8",
+						SecretName: "my-validator",
+					},
+				},
+			},
+		}
+
+		It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+			Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+		})
+
+		It("Should create validator client", func() {
+			if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+				toCreate.Default()
+			}
+			Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+			time.Sleep(5 * time.Second)
+		})
+
+		It("Should mount genesis config map into genesis directory", func() {
+			homeDir := ethereum2Clients.LighthouseHomeDir
+			mode := corev1.ConfigMapVolumeSourceDefaultMode
+			validatorSts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(context.Background(), key, validatorSts)).To(Succeed())
+			Expect(validatorSts.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "genesis",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "devnet-genesis",
+						},
+						Items: []corev1.KeyToPath{
+							{
+								Key:  ethereum2Clients.GenesisConfigFile,
+								Path: ethereum2Clients.GenesisConfigFile,
+							},
+							{
+								Key:  ethereum2Clients.GenesisStateFile,
+								Path: ethereum2Clients.GenesisStateFile,
+							},
+							{
+								Key:  ethereum2Clients.GenesisDeployBlockFile,
+								Path: ethereum2Clients.GenesisDeployBlockFile,
+							},
+						},
+						DefaultMode: &mode,
+					},
+				},
+			}))
+			Expect(validatorSts.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "genesis",
+				MountPath: ethereum2Clients.GenesisDir(homeDir),
+				ReadOnly:  true,
+			}))
+			Expect(validatorSts.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+				ethereum2Clients.LighthouseTestnetDir,
+				ethereum2Clients.GenesisDir(homeDir),
+			))
+			Expect(validatorSts.Spec.Template.Spec.InitContainers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name:  "KOTAL_GENESIS_PATH",
+				Value: ethereum2Clients.GenesisDir(homeDir),
+			}))
+		})
+
+		It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+			Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		})
+	})
+
 })
@@ -0,0 +1,217 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	"github.com/kotalco/kotal/controllers/shared"
+	"github.com/kotalco/kotal/helpers"
+)
+
+// ValidatorKeysReconciler reconciles a ValidatorKeys object
+type ValidatorKeysReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=validatorkeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=validatorkeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets;configmaps,verbs=watch;get;create;update;list
+
+// Reconcile reconciles Ethereum 2.0 validator keys generated from mnemonic
+func (r *ValidatorKeysReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	var keys ethereum2v1alpha1.ValidatorKeys
+
+	if err = r.Client.Get(ctx, req.NamespacedName, &keys); err != nil {
+		err = client.IgnoreNotFound(err)
+		return
+	}
+
+	// default the validator keys if webhooks are disabled
+	if !shared.IsWebhookEnabled() {
+		keys.Default()
+	}
+
+	shared.UpdateLabels(&keys, "validator-keys")
+
+	mnemonicKey := types.NamespacedName{
+		Name:      keys.Spec.MnemonicSecretName,
+		Namespace: keys.Namespace,
+	}
+
+	mnemonic, err := shared.GetSecret(ctx, r.Client, mnemonicKey, "mnemonic")
+	if err != nil {
+		return
+	}
+
+	derived, err := helpers.DeriveValidatorKeys(mnemonic, keys.Spec.StartIndex, keys.Spec.Count)
+	if err != nil {
+		return
+	}
+
+	if err = r.reconcileSecrets(ctx, &keys, derived); err != nil {
+		return
+	}
+
+	if err = r.reconcileConfigmap(ctx, &keys, derived); err != nil {
+		return
+	}
+
+	if err = r.updateStatus(ctx, &keys, derived); err != nil {
+		return
+	}
+
+	return
+}
+
+// keystoreSecretName returns secret name of validator key keystore
+func keystoreSecretName(keys *ethereum2v1alpha1.ValidatorKeys, key *helpers.ValidatorKey) string {
+	return fmt.Sprintf("%s-%d", keys.Name, key.Index)
+}
+
+// reconcileSecrets creates validator keystore secrets holding [keystore] and [password]
+// keystores are encrypted using random salt and password, so existing secrets are never updated
+func (r *ValidatorKeysReconciler) reconcileSecrets(ctx context.Context, keys *ethereum2v1alpha1.ValidatorKeys, derived []helpers.ValidatorKey) error {
+	for i := range derived {
+		key := &derived[i]
+
+		secret := &corev1.Secret{}
+		name := types.NamespacedName{Name: keystoreSecretName(keys, key), Namespace: keys.Namespace}
+
+		err := r.Client.Get(ctx, name, secret)
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		random := make([]byte, 32)
+		if _, err = rand.Read(random); err != nil {
+			return err
+		}
+		password := hex.EncodeToString(random)
+
+		keystore, err := helpers.EncryptBLSKeystore(key.SecretKey, key.Path, password)
+		if err != nil {
+			return err
+		}
+
+		content, err := json.Marshal(keystore)
+		if err != nil {
+			return err
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.Name,
+				Namespace: name.Namespace,
+				Labels:    keys.GetLabels(),
+			},
+			StringData: map[string]string{
+				"keystore": string(content),
+				"password": password,
+			},
+		}
+
+		if err = ctrl.SetControllerReference(keys, secret, r.Scheme); err != nil {
+			return err
+		}
+
+		if err = r.Client.Create(ctx, secret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileConfigmap reconciles deposit data config map
+func (r *ValidatorKeysReconciler) reconcileConfigmap(ctx context.Context, keys *ethereum2v1alpha1.ValidatorKeys, derived []helpers.ValidatorKey) error {
+	deposits := []*helpers.DepositData{}
+	for i := range derived {
+		deposit, err := derived[i].DepositData(
+			string(keys.Spec.WithdrawalAddress),
+			keys.Spec.Amount,
+			keys.Spec.Network,
+			ethereum2v1alpha1.GenesisForkVersions[keys.Spec.Network],
+		)
+		if err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+	}
+
+	depositData, err := json.Marshal(deposits)
+	if err != nil {
+		return err
+	}
+
+	configmap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      depositDataConfigMapName(keys),
+			Namespace: keys.Namespace,
+		},
+	}
+
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, configmap, func() error {
+		if err := ctrl.SetControllerReference(keys, configmap, r.Scheme); err != nil {
+			return err
+		}
+
+		configmap.ObjectMeta.Labels = keys.GetLabels()
+		configmap.Data = map[string]string{
+			"deposit_data.json": string(depositData),
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// depositDataConfigMapName returns deposit data config map name
+func depositDataConfigMapName(keys *ethereum2v1alpha1.ValidatorKeys) string {
+	return fmt.Sprintf("%s-deposit-data", keys.Name)
+}
+
+// updateStatus updates validator keys status
+func (r *ValidatorKeysReconciler) updateStatus(ctx context.Context, keys *ethereum2v1alpha1.ValidatorKeys, derived []helpers.ValidatorKey) error {
+	keys.Status.Keystores = []ethereum2v1alpha1.Keystore{}
+	for i := range derived {
+		keys.Status.Keystores = append(keys.Status.Keystores, ethereum2v1alpha1.Keystore{
+			PublicKey:  "0x" + hex.EncodeToString(derived[i].PublicKey),
+			SecretName: keystoreSecretName(keys, &derived[i]),
+		})
+	}
+	keys.Status.DepositDataConfigMapName = depositDataConfigMapName(keys)
+
+	if err := r.Status().Update(ctx, keys); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update validator keys status")
+		return err
+	}
+
+	return nil
+}
+
+// SetupWithManager adds reconciler to the manager
+func (r *ValidatorKeysReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ethereum2v1alpha1.ValidatorKeys{}).
+		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
+		Complete(r)
+}
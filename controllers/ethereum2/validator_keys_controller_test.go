@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	"github.com/kotalco/kotal/helpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ethereum 2.0 validator keys", func() {
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "validator-keys",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "my-keys",
+		Namespace: ns.Name,
+	}
+
+	mnemonic := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-mnemonic",
+			Namespace: ns.Name,
+		},
+		StringData: map[string]string{
+			"mnemonic": "test test test test test test test test test test test junk",
+		},
+	}
+
+	spec := ethereum2v1alpha1.ValidatorKeysSpec{
+		Network:            "hoodi",
+		MnemonicSecretName: mnemonic.Name,
+		StartIndex:         3,
+		Count:              2,
+	}
+
+	toCreate := &ethereum2v1alpha1.ValidatorKeys{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: spec,
+	}
+
+	t := true
+
+	keysOwnerReference := metav1.OwnerReference{
+		APIVersion:         "ethereum2.kotal.io/v1alpha1",
+		Kind:               "ValidatorKeys",
+		Name:               toCreate.Name,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns))
+	})
+
+	It("Should create mnemonic secret", func() {
+		Expect(k8sClient.Create(context.Background(), mnemonic)).To(Succeed())
+	})
+
+	It("Should create validator keys", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			toCreate.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+	})
+
+	It("should get validator keys", func() {
+		fetched := &ethereum2v1alpha1.ValidatorKeys{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Spec).To(Equal(toCreate.Spec))
+		keysOwnerReference.UID = fetched.GetUID()
+		time.Sleep(5 * time.Second)
+	})
+
+	It("Should create keystore secrets", func() {
+		for _, name := range []string{"my-keys-3", "my-keys-4"} {
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: name, Namespace: ns.Name}, secret)).To(Succeed())
+			Expect(secret.GetOwnerReferences()).To(ContainElement(keysOwnerReference))
+			Expect(secret.Data).To(HaveKey("keystore"))
+			Expect(secret.Data).To(HaveKey("password"))
+
+			keystore := &helpers.BLSKeystore{}
+			Expect(json.Unmarshal(secret.Data["keystore"], keystore)).To(Succeed())
+			_, err := keystore.Decrypt(string(secret.Data["password"]))
+			Expect(err).To(BeNil())
+		}
+	})
+
+	It("Should create deposit data configmap", func() {
+		configmap := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "my-keys-deposit-data", Namespace: ns.Name}, configmap)).To(Succeed())
+		Expect(configmap.GetOwnerReferences()).To(ContainElement(keysOwnerReference))
+
+		deposits := []helpers.DepositData{}
+		Expect(json.Unmarshal([]byte(configmap.Data["deposit_data.json"]), &deposits)).To(Succeed())
+		Expect(deposits).To(HaveLen(2))
+		Expect(deposits[0].NetworkName).To(Equal("hoodi"))
+		Expect(deposits[0].ForkVersion).To(Equal("10000910"))
+		Expect(deposits[0].Amount).To(Equal(ethereum2v1alpha1.DefaultDepositAmount))
+	})
+
+	It("Should report generated keystores", func() {
+		fetched := &ethereum2v1alpha1.ValidatorKeys{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.DepositDataConfigMapName).To(Equal("my-keys-deposit-data"))
+		Expect(fetched.Status.Keystores).To(HaveLen(2))
+		Expect(fetched.Status.Keystores[0].SecretName).To(Equal("my-keys-3"))
+		Expect(fetched.Status.Keystores[1].SecretName).To(Equal("my-keys-4"))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
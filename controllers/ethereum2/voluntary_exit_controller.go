@@ -0,0 +1,375 @@
+package controllers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+	"github.com/kotalco/kotal/controllers/shared"
+	"github.com/kotalco/kotal/helpers"
+)
+
+// VoluntaryExitReconciler reconciles a VoluntaryExit object
+type VoluntaryExitReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// voluntaryExitRequeueInterval is the interval exiting validator keys are checked until they exit
+const voluntaryExitRequeueInterval = time.Minute
+
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=voluntaryexits,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=voluntaryexits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ethereum2.kotal.io,resources=validators,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile signs and submits validator keys voluntary exits until the keys exit
+func (r *VoluntaryExitReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+
+	var exit ethereum2v1alpha1.VoluntaryExit
+
+	if err = r.Client.Get(ctx, req.NamespacedName, &exit); err != nil {
+		err = client.IgnoreNotFound(err)
+		return
+	}
+
+	// exited validator keys are final
+	if exit.Status.Phase == ethereum2v1alpha1.VoluntaryExitCompleted {
+		return
+	}
+
+	exit.Status.Message = ""
+
+	if err := r.exit(ctx, &exit); err != nil {
+		log.FromContext(ctx).Error(err, "unable to exit validator keys")
+		exit.Status.Message = err.Error()
+	}
+
+	if err = r.updateStatus(ctx, &exit); err != nil {
+		return
+	}
+
+	if exit.Status.Phase != ethereum2v1alpha1.VoluntaryExitCompleted {
+		result.RequeueAfter = voluntaryExitRequeueInterval
+	}
+
+	return
+}
+
+// updateStatus updates voluntary exit status
+func (r *VoluntaryExitReconciler) updateStatus(ctx context.Context, exit *ethereum2v1alpha1.VoluntaryExit) error {
+	if exit.Status.Phase == "" {
+		exit.Status.Phase = ethereum2v1alpha1.VoluntaryExitPending
+	}
+
+	if err := r.Status().Update(ctx, exit); err != nil {
+		log.FromContext(ctx).Error(err, "unable to update voluntary exit status")
+		return err
+	}
+
+	return nil
+}
+
+// beaconClient returns beacon node REST API client exits are submitted to
+// beacon endpoint is used if provided, otherwise first validator beacon endpoint that's REST API url
+func beaconClient(exit *ethereum2v1alpha1.VoluntaryExit, validator *ethereum2v1alpha1.Validator) (ethereum2Clients.BeaconAPIClient, error) {
+	if exit.Spec.BeaconEndpoint != "" {
+		return ethereum2Clients.NewBeaconAPIClient(exit.Spec.BeaconEndpoint)
+	}
+
+	for _, endpoint := range validator.Spec.BeaconEndpoints {
+		if client, err := ethereum2Clients.NewBeaconAPIClient(endpoint); err == nil {
+			return client, nil
+		}
+	}
+
+	return nil, fmt.Errorf("validator %s has no beacon node REST API endpoint", validator.Name)
+}
+
+// exit signs and submits voluntary exits of active validator keys that haven't been submitted yet
+// validator keys exit epoch and state are tracked in status until all keys have exited
+func (r *VoluntaryExitReconciler) exit(ctx context.Context, exit *ethereum2v1alpha1.VoluntaryExit) error {
+	// validators are referenced in the same namespace only
+	key := types.NamespacedName{Name: exit.Spec.Validator, Namespace: exit.Namespace}
+
+	validator := &ethereum2v1alpha1.Validator{}
+	if err := r.Client.Get(ctx, key, validator); err != nil {
+		return err
+	}
+
+	// no exit is signed unless all exiting keys are validator keys
+	validatorKeys, err := r.validatorPublicKeys(ctx, validator)
+	if err != nil {
+		return err
+	}
+	for _, publicKey := range exit.Spec.PublicKeys {
+		if !validatorKeys[normalizePublicKey(publicKey)] {
+			return fmt.Errorf("public key %s isn't a key of validator %s", publicKey, validator.Name)
+		}
+	}
+
+	beacon, err := beaconClient(exit, validator)
+	if err != nil {
+		return err
+	}
+
+	keys, err := beacon.ValidatorKeys(ctx, exit.Spec.PublicKeys)
+	if err != nil {
+		return err
+	}
+
+	submitted := map[string]bool{}
+	for _, status := range exit.Status.Keys {
+		submitted[strings.ToLower(status.PublicKey)] = status.Submitted
+	}
+
+	statuses := []ethereum2v1alpha1.VoluntaryExitKeyStatus{}
+	exited, pending := 0, 0
+	var exitErr error
+	var fork *ethereum2Clients.ForkInfo
+	var epoch uint64
+
+	for _, key := range keys {
+		status := ethereum2v1alpha1.VoluntaryExitKeyStatus{
+			PublicKey: key.PublicKey,
+			Index:     key.Index,
+			State:     key.State,
+			Submitted: submitted[strings.ToLower(key.PublicKey)],
+			ExitEpoch: key.ExitEpoch,
+		}
+
+		switch {
+		case key.State == ethereum2v1alpha1.ExitedValidatorKey || key.State == ethereum2v1alpha1.SlashedValidatorKey:
+			// exited and slashed keys are no longer validating
+		case key.ExitEpoch != "" || status.Submitted:
+			// exit has been initiated or is waiting in beacon node operations pool
+		case key.State != ethereum2v1alpha1.ActiveValidatorKey:
+			if exitErr == nil {
+				exitErr = fmt.Errorf("validator key %s is %s, only active keys can exit", key.PublicKey, key.State)
+			}
+		case exitErr == nil:
+			if fork == nil {
+				if fork, err = beacon.ForkInfo(ctx); err != nil {
+					return err
+				}
+				if epoch, err = beacon.CurrentEpoch(ctx); err != nil {
+					return err
+				}
+			}
+
+			message := ethereum2Clients.VoluntaryExit{
+				Epoch:          strconv.FormatUint(epoch, 10),
+				ValidatorIndex: key.Index,
+			}
+
+			if exitErr = r.submit(ctx, beacon, validator, key.PublicKey, &message, fork); exitErr == nil {
+				status.Submitted = true
+			}
+		}
+
+		if key.State == ethereum2v1alpha1.ExitedValidatorKey || key.State == ethereum2v1alpha1.SlashedValidatorKey {
+			exited++
+		} else if !status.Submitted && status.ExitEpoch == "" {
+			pending++
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	exit.Status.Keys = statuses
+
+	switch {
+	case exited == len(statuses):
+		exit.Status.Phase = ethereum2v1alpha1.VoluntaryExitCompleted
+	case pending == 0:
+		exit.Status.Phase = ethereum2v1alpha1.VoluntaryExitSubmitted
+	default:
+		exit.Status.Phase = ethereum2v1alpha1.VoluntaryExitPending
+	}
+
+	return exitErr
+}
+
+// submit signs voluntary exit of validator key and submits it to the beacon node
+func (r *VoluntaryExitReconciler) submit(ctx context.Context, beacon ethereum2Clients.BeaconAPIClient, validator *ethereum2v1alpha1.Validator, publicKey string, message *ethereum2Clients.VoluntaryExit, fork *ethereum2Clients.ForkInfo) error {
+	epoch, err := strconv.ParseUint(message.Epoch, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	index, err := strconv.ParseUint(message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	signingRoot, err := helpers.VoluntaryExitSigningRoot(epoch, index, fork.CapellaForkVersion, fork.GenesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+
+	var signature string
+
+	if validator.Spec.RemoteSigner != "" {
+		if validator.Status.RemoteSignerURL == "" {
+			return fmt.Errorf("validator %s remote signer url is not resolved yet", validator.Name)
+		}
+		signer := ethereum2Clients.NewRemoteSignerAPIClient(validator.Status.RemoteSignerURL)
+		signature, err = signer.SignVoluntaryExit(ctx, publicKey, message, fork, "0x"+hex.EncodeToString(signingRoot))
+		if err != nil {
+			return err
+		}
+	} else {
+		secretKey, err := r.secretKey(ctx, validator, publicKey)
+		if err != nil {
+			return err
+		}
+		signed, err := helpers.BLSSign(secretKey, signingRoot)
+		if err != nil {
+			return err
+		}
+		signature = "0x" + hex.EncodeToString(signed)
+	}
+
+	return beacon.SubmitVoluntaryExit(ctx, &ethereum2Clients.SignedVoluntaryExit{
+		Message:   *message,
+		Signature: signature,
+	})
+}
+
+// normalizePublicKey returns lower case public key without 0x prefix
+func normalizePublicKey(publicKey string) string {
+	return strings.ToLower(strings.TrimPrefix(publicKey, "0x"))
+}
+
+// validatorPublicKeys returns normalized public keys of validator keystores, or keys signed for by its remote signer
+// keystores without public key are read from keystore secrets
+func (r *VoluntaryExitReconciler) validatorPublicKeys(ctx context.Context, validator *ethereum2v1alpha1.Validator) (map[string]bool, error) {
+	keys := map[string]bool{}
+
+	if validator.Spec.RemoteSigner != "" {
+		if len(validator.Status.PublicKeys) == 0 {
+			return nil, fmt.Errorf("validator %s remote signer public keys are not resolved yet", validator.Name)
+		}
+		for _, publicKey := range validator.Status.PublicKeys {
+			keys[normalizePublicKey(publicKey)] = true
+		}
+		return keys, nil
+	}
+
+	for _, keystore := range validator.Spec.Keystores {
+		if keystore.PublicKey != "" {
+			keys[normalizePublicKey(keystore.PublicKey)] = true
+			continue
+		}
+
+		key := types.NamespacedName{Name: keystore.SecretName, Namespace: validator.Namespace}
+		content, err := shared.GetSecret(ctx, r.Client, key, "keystore")
+		if err != nil {
+			return nil, err
+		}
+
+		encrypted := &helpers.BLSKeystore{}
+		if err := json.Unmarshal([]byte(content), encrypted); err != nil {
+			return nil, err
+		}
+		keys[normalizePublicKey(encrypted.PublicKey)] = true
+	}
+
+	return keys, nil
+}
+
+// secretKey decrypts validator keystore of the given public key
+func (r *VoluntaryExitReconciler) secretKey(ctx context.Context, validator *ethereum2v1alpha1.Validator, publicKey string) (*big.Int, error) {
+	for _, keystore := range validator.Spec.Keystores {
+		key := types.NamespacedName{Name: keystore.SecretName, Namespace: validator.Namespace}
+
+		content, err := shared.GetSecret(ctx, r.Client, key, "keystore")
+		if err != nil {
+			return nil, err
+		}
+
+		encrypted := &helpers.BLSKeystore{}
+		if err := json.Unmarshal([]byte(content), encrypted); err != nil {
+			return nil, err
+		}
+
+		if normalizePublicKey(encrypted.PublicKey) != normalizePublicKey(publicKey) {
+			continue
+		}
+
+		password, err := shared.GetSecret(ctx, r.Client, key, "password")
+		if err != nil {
+			return nil, err
+		}
+
+		return encrypted.Decrypt(password)
+	}
+
+	return nil, fmt.Errorf("validator has no keystore for public key %s", publicKey)
+}
+
+// exitValidator returns namespaced name of the validator whose keys are exiting
+func exitValidator(obj client.Object) []string {
+	exit := obj.(*ethereum2v1alpha1.VoluntaryExit)
+
+	return []string{types.NamespacedName{Name: exit.Spec.Validator, Namespace: exit.Namespace}.String()}
+}
+
+// validatorExits returns reconcile requests for voluntary exits of the given validator keys
+func (r *VoluntaryExitReconciler) validatorExits(obj client.Object) []reconcile.Request {
+	var exits ethereum2v1alpha1.VoluntaryExitList
+
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}.String()
+
+	if err := r.Client.List(context.Background(), &exits, client.MatchingFields{shared.ReferencesIndexKey: key}); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, exit := range exits.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      exit.Name,
+				Namespace: exit.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
+// SetupWithManager adds reconciler to the manager
+func (r *VoluntaryExitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &ethereum2v1alpha1.VoluntaryExit{}, shared.ReferencesIndexKey, exitValidator); err != nil {
+		return err
+	}
+
+	pred := predicate.GenerationChangedPredicate{}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ethereum2v1alpha1.VoluntaryExit{}, builder.WithPredicates(pred)).
+		Watches(
+			&source.Kind{Type: &ethereum2v1alpha1.Validator{}},
+			handler.EnqueueRequestsFromMapFunc(r.validatorExits),
+			builder.WithPredicates(pred),
+		).
+		Complete(r)
+}
@@ -0,0 +1,329 @@
+package controllers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ethereum2Clients "github.com/kotalco/kotal/clients/ethereum2"
+	"github.com/kotalco/kotal/helpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeBeaconNode is beacon node REST API serving single validator that exits once its voluntary exit is submitted
+type fakeBeaconNode struct {
+	sync.Mutex
+	publicKey string
+	submitted *ethereum2Clients.SignedVoluntaryExit
+}
+
+func (b *fakeBeaconNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.Lock()
+	defer b.Unlock()
+
+	switch r.URL.Path {
+	case "/eth/v1/beacon/genesis":
+		w.Write([]byte(`{"data":{"genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"}}`))
+	case "/eth/v1/config/spec":
+		w.Write([]byte(`{"data":{"CAPELLA_FORK_VERSION":"0x03000000","SLOTS_PER_EPOCH":"32"}}`))
+	case "/eth/v1/beacon/headers/head":
+		w.Write([]byte(`{"data":{"header":{"message":{"slot":"6400"}}}}`))
+	case "/eth/v1/beacon/pool/voluntary_exits":
+		b.submitted = &ethereum2Clients.SignedVoluntaryExit{}
+		json.NewDecoder(r.Body).Decode(b.submitted)
+	case "/eth/v1/beacon/states/head/validators":
+		status, exitEpoch := "active_ongoing", "18446744073709551615"
+		if b.submitted != nil {
+			status, exitEpoch = "exited_unslashed", "205"
+		}
+		fmt.Fprintf(w, `{"data":[{"index":"7","balance":"32000000000","status":"%s","validator":{"pubkey":"%s","effective_balance":"32000000000","exit_epoch":"%s"}}]}`, status, b.publicKey, exitEpoch)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("Ethereum 2.0 voluntary exit", func() {
+
+	const (
+		interval = 2 * time.Second
+		timeout  = 2 * time.Minute
+	)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "voluntary-exit",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "my-exit",
+		Namespace: ns.Name,
+	}
+
+	keys, _ := helpers.DeriveValidatorKeys("test test test test test test test test test test test junk", 0, 1)
+	publicKey := "0x" + hex.EncodeToString(keys[0].PublicKey)
+
+	beaconNode := &fakeBeaconNode{publicKey: publicKey}
+	server := httptest.NewServer(beaconNode)
+
+	validator := &ethereum2v1alpha1.Validator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "exiting-validator",
+			Namespace: ns.Name,
+		},
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Network:         "mainnet",
+			Client:          ethereum2v1alpha1.TekuClient,
+			BeaconEndpoints: []string{server.URL},
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{
+					SecretName: "exiting-validator-keystore",
+				},
+			},
+		},
+	}
+
+	toCreate := &ethereum2v1alpha1.VoluntaryExit{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: ethereum2v1alpha1.VoluntaryExitSpec{
+			Validator:  validator.Name,
+			PublicKeys: []string{publicKey},
+		},
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+	})
+
+	It("Should create validator keystore secret", func() {
+		keystore, err := helpers.EncryptBLSKeystore(keys[0].SecretKey, keys[0].Path, "secret")
+		Expect(err).To(BeNil())
+		content, err := json.Marshal(keystore)
+		Expect(err).To(BeNil())
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "exiting-validator-keystore",
+				Namespace: ns.Name,
+			},
+			StringData: map[string]string{
+				"keystore": string(content),
+				"password": "secret",
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+	})
+
+	It("Should create validator", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			validator.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), validator)).To(Succeed())
+	})
+
+	It("Should create voluntary exit", func() {
+		Expect(k8sClient.Create(context.Background(), toCreate)).To(Succeed())
+	})
+
+	It("Should submit signed voluntary exit to beacon node", func() {
+		Eventually(func() bool {
+			beaconNode.Lock()
+			defer beaconNode.Unlock()
+			return beaconNode.submitted != nil
+		}, timeout, interval).Should(BeTrue())
+
+		beaconNode.Lock()
+		defer beaconNode.Unlock()
+
+		// current epoch is head slot 6400 / 32 slots per epoch
+		Expect(beaconNode.submitted.Message).To(Equal(ethereum2Clients.VoluntaryExit{Epoch: "200", ValidatorIndex: "7"}))
+
+		signingRoot, err := helpers.VoluntaryExitSigningRoot(200, 7, "0x03000000", "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95")
+		Expect(err).To(BeNil())
+		signature, err := helpers.BLSSign(keys[0].SecretKey, signingRoot)
+		Expect(err).To(BeNil())
+		Expect(beaconNode.submitted.Signature).To(Equal("0x" + hex.EncodeToString(signature)))
+	})
+
+	It("Should track validator key exit epoch in status", func() {
+		Eventually(func() ethereum2v1alpha1.VoluntaryExitPhase {
+			fetched := &ethereum2v1alpha1.VoluntaryExit{}
+			if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+				return ""
+			}
+			return fetched.Status.Phase
+		}, timeout, interval).Should(Equal(ethereum2v1alpha1.VoluntaryExitCompleted))
+
+		fetched := &ethereum2v1alpha1.VoluntaryExit{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.Message).To(BeEmpty())
+		Expect(fetched.Status.Keys).To(Equal([]ethereum2v1alpha1.VoluntaryExitKeyStatus{
+			{
+				PublicKey: publicKey,
+				Index:     "7",
+				State:     ethereum2v1alpha1.ExitedValidatorKey,
+				Submitted: true,
+				ExitEpoch: "205",
+			},
+		}))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		server.Close()
+	})
+
+})
+
+var _ = Describe("Ethereum 2.0 unauthorized voluntary exit", func() {
+
+	const (
+		interval = 2 * time.Second
+		timeout  = 2 * time.Minute
+	)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "unauthorized-voluntary-exit",
+		},
+	}
+
+	keys, _ := helpers.DeriveValidatorKeys("test test test test test test test test test test test junk", 0, 2)
+	publicKey := "0x" + hex.EncodeToString(keys[0].PublicKey)
+	foreignPublicKey := "0x" + hex.EncodeToString(keys[1].PublicKey)
+
+	beaconNode := &fakeBeaconNode{publicKey: publicKey}
+	server := httptest.NewServer(beaconNode)
+
+	validator := &ethereum2v1alpha1.Validator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "exiting-validator",
+			Namespace: ns.Name,
+		},
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Network:         "mainnet",
+			Client:          ethereum2v1alpha1.TekuClient,
+			BeaconEndpoints: []string{server.URL},
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{
+					SecretName: "exiting-validator-keystore",
+				},
+			},
+		},
+	}
+
+	crossNamespaceKey := types.NamespacedName{
+		Name:      "cross-namespace-exit",
+		Namespace: ns.Name,
+	}
+
+	foreignKeyKey := types.NamespacedName{
+		Name:      "foreign-key-exit",
+		Namespace: ns.Name,
+	}
+
+	// message returns voluntary exit status message once it's reported
+	message := func(key types.NamespacedName) func() string {
+		return func() string {
+			fetched := &ethereum2v1alpha1.VoluntaryExit{}
+			if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+				return ""
+			}
+			return fetched.Status.Message
+		}
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+	})
+
+	It("Should create validator keystore secret", func() {
+		keystore, err := helpers.EncryptBLSKeystore(keys[0].SecretKey, keys[0].Path, "secret")
+		Expect(err).To(BeNil())
+		content, err := json.Marshal(keystore)
+		Expect(err).To(BeNil())
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "exiting-validator-keystore",
+				Namespace: ns.Name,
+			},
+			StringData: map[string]string{
+				"keystore": string(content),
+				"password": "secret",
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+	})
+
+	It("Should create validator", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			validator.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), validator)).To(Succeed())
+	})
+
+	It("Should not resolve validator in another namespace", func() {
+		exit := &ethereum2v1alpha1.VoluntaryExit{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crossNamespaceKey.Name,
+				Namespace: crossNamespaceKey.Namespace,
+			},
+			Spec: ethereum2v1alpha1.VoluntaryExitSpec{
+				Validator:  fmt.Sprintf("%s.%s", validator.Name, ns.Name),
+				PublicKeys: []string{publicKey},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), exit)).To(Succeed())
+
+		Eventually(message(crossNamespaceKey), timeout, interval).Should(ContainSubstring("not found"))
+	})
+
+	It("Should not exit keys that aren't validator keys", func() {
+		exit := &ethereum2v1alpha1.VoluntaryExit{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      foreignKeyKey.Name,
+				Namespace: foreignKeyKey.Namespace,
+			},
+			Spec: ethereum2v1alpha1.VoluntaryExitSpec{
+				Validator:  validator.Name,
+				PublicKeys: []string{publicKey, foreignPublicKey},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), exit)).To(Succeed())
+
+		Eventually(message(foreignKeyKey), timeout, interval).Should(Equal(
+			fmt.Sprintf("public key %s isn't a key of validator %s", foreignPublicKey, validator.Name),
+		))
+	})
+
+	It("Should not submit any voluntary exit", func() {
+		Consistently(func() bool {
+			beaconNode.Lock()
+			defer beaconNode.Unlock()
+			return beaconNode.submitted == nil
+		}, 10*time.Second, interval).Should(BeTrue())
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+		server.Close()
+	})
+
+})
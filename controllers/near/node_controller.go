@@ -3,7 +3,9 @@ package controllers
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	nearv1alpha1 "github.com/kotalco/kotal/apis/near/v1alpha1"
 	nearClients "github.com/kotalco/kotal/clients/near"
@@ -13,11 +15,17 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // NodeReconciler reconciles a Node object
@@ -39,6 +47,7 @@ var (
 // +kubebuilder:rbac:groups=near.kotal.io,resources=nodes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=watch;get;list;create;update;delete
 // +kubebuilder:rbac:groups=core,resources=configmaps;persistentvolumeclaims;services,verbs=watch;get;create;update;list;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=watch;get;list
 
 func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	var node nearv1alpha1.Node
@@ -54,6 +63,7 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 	}
 
 	shared.UpdateLabels(&node, "nearcore")
+	r.updateBootnodes(ctx, &node)
 
 	if err = r.reconcilePVC(ctx, &node); err != nil {
 		return
@@ -63,7 +73,8 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 		return
 	}
 
-	if err = r.reconcileService(ctx, &node); err != nil {
+	ip, err := r.reconcileService(ctx, &node)
+	if err != nil {
 		return
 	}
 
@@ -71,17 +82,96 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 		return
 	}
 
-	if err = r.updateStatus(ctx, &node); err != nil {
+	if err = r.updateStatus(ctx, &node, ip); err != nil {
 		return
 	}
 
 	return
 }
 
+// getBootnodeURL fetch boot node URL from NEAR node reference in the format of name or name.namespace
+func (r *NodeReconciler) getBootnodeURL(ctx context.Context, ref, ns string) (string, error) {
+	node := &nearv1alpha1.Node{}
+
+	if err := r.Client.Get(ctx, shared.ParseReference(ref, ns), node); err != nil {
+		return "", err
+	}
+
+	return node.Status.BootnodeURL, nil
+}
+
+// updateBootnodes replaces NEAR node references with their boot node URL
+// references that can't be resolved are removed and reported in status
+func (r *NodeReconciler) updateBootnodes(ctx context.Context, node *nearv1alpha1.Node) {
+	log := log.FromContext(ctx)
+
+	bootnodes := []string{}
+	node.Status.UnresolvedReferences = nil
+
+	for _, bootnode := range node.Spec.Bootnodes {
+		if strings.Contains(bootnode, "@") {
+			bootnodes = append(bootnodes, bootnode)
+			continue
+		}
+
+		url, err := r.getBootnodeURL(ctx, bootnode, node.Namespace)
+		if err != nil {
+			// don't return the error, node maybe not created yet
+			// node will be reconciled again once referenced node is created
+			log.Error(err, "failed to get boot node URL", "reference", bootnode)
+			node.Status.UnresolvedReferences = append(node.Status.UnresolvedReferences, bootnode)
+			continue
+		}
+
+		// referenced node is not up and running yet, or has no node private key
+		if url == "" {
+			node.Status.UnresolvedReferences = append(node.Status.UnresolvedReferences, bootnode)
+			continue
+		}
+
+		bootnodes = append(bootnodes, url)
+	}
+
+	node.Spec.Bootnodes = bootnodes
+}
+
+// getBootnodeURLFromKey returns node boot node URL from node key secret and service ip
+func (r *NodeReconciler) getBootnodeURLFromKey(ctx context.Context, node *nearv1alpha1.Node, ip string) (string, error) {
+	key := types.NamespacedName{
+		Name:      node.Spec.NodePrivateKeySecretName,
+		Namespace: node.Namespace,
+	}
+
+	nodeKey, err := shared.GetSecret(ctx, r.Client, key, "key")
+	if err != nil {
+		return "", err
+	}
+
+	var keyFile struct {
+		PublicKey string `json:"public_key"`
+	}
+
+	if err := json.Unmarshal([]byte(nodeKey), &keyFile); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s@%s:%d", keyFile.PublicKey, ip, node.Spec.P2PPort), nil
+}
+
 // updateStatus updates NEAR node status
-func (r *NodeReconciler) updateStatus(ctx context.Context, peer *nearv1alpha1.Node) error {
+func (r *NodeReconciler) updateStatus(ctx context.Context, peer *nearv1alpha1.Node, ip string) error {
 	peer.Status.Client = "nearcore"
 
+	// boot node URL requires known node public key
+	if peer.Spec.NodePrivateKeySecretName != "" && ip != "" {
+		url, err := r.getBootnodeURLFromKey(ctx, peer, ip)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "unable to get node boot node URL")
+			return err
+		}
+		peer.Status.BootnodeURL = url
+	}
+
 	if err := r.Status().Update(ctx, peer); err != nil {
 		log.FromContext(ctx).Error(err, "unable to update node status")
 		return err
@@ -91,7 +181,7 @@ func (r *NodeReconciler) updateStatus(ctx context.Context, peer *nearv1alpha1.No
 }
 
 // reconcileService reconciles NEAR node service
-func (r *NodeReconciler) reconcileService(ctx context.Context, node *nearv1alpha1.Node) error {
+func (r *NodeReconciler) reconcileService(ctx context.Context, node *nearv1alpha1.Node) (ip string, err error) {
 	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      node.Name,
@@ -99,7 +189,7 @@ func (r *NodeReconciler) reconcileService(ctx context.Context, node *nearv1alpha
 		},
 	}
 
-	_, err := ctrl.CreateOrUpdate(ctx, r.Client, svc, func() error {
+	_, err = ctrl.CreateOrUpdate(ctx, r.Client, svc, func() error {
 		if err := ctrl.SetControllerReference(node, svc, r.Scheme); err != nil {
 			return err
 		}
@@ -107,7 +197,13 @@ func (r *NodeReconciler) reconcileService(ctx context.Context, node *nearv1alpha
 		return nil
 	})
 
-	return err
+	if err != nil {
+		return
+	}
+
+	ip = svc.Spec.ClusterIP
+
+	return
 }
 
 // specService updates NEAR node service spec
@@ -448,14 +544,67 @@ func (r *NodeReconciler) specStatefulSet(node *nearv1alpha1.Node, sts *appsv1.St
 
 }
 
+// nodeReferences returns namespaced names of nodes referenced by bootnodes
+func nodeReferences(obj client.Object) []string {
+	node := obj.(*nearv1alpha1.Node)
+	references := []string{}
+
+	for _, bootnode := range node.Spec.Bootnodes {
+		if strings.Contains(bootnode, "@") {
+			continue
+		}
+		references = append(references, shared.ParseReference(bootnode, node.Namespace).String())
+	}
+
+	return references
+}
+
+// referencingNodes returns reconcile requests for nodes referencing the given node
+func (r *NodeReconciler) referencingNodes(obj client.Object) []reconcile.Request {
+	var nodes nearv1alpha1.NodeList
+
+	key := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}.String()
+
+	if err := r.Client.List(context.Background(), &nodes, client.MatchingFields{shared.ReferencesIndexKey: key}); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, node := range nodes.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      node.Name,
+				Namespace: node.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
 func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &nearv1alpha1.Node{}, shared.ReferencesIndexKey, nodeReferences); err != nil {
+		return err
+	}
+
 	pred := predicate.GenerationChangedPredicate{}
+	// referenced nodes boot node URL is reported in status, which doesn't change generation
+	bootnodeURLPred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.(*nearv1alpha1.Node).Status.BootnodeURL != e.ObjectNew.(*nearv1alpha1.Node).Status.BootnodeURL
+		},
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&nearv1alpha1.Node{}).
-		WithEventFilter(pred).
-		Owns(&appsv1.StatefulSet{}).
-		Owns(&corev1.ConfigMap{}).
-		Owns(&corev1.PersistentVolumeClaim{}).
-		Owns(&corev1.Service{}).
+		For(&nearv1alpha1.Node{}, builder.WithPredicates(pred)).
+		Owns(&appsv1.StatefulSet{}, builder.WithPredicates(pred)).
+		Owns(&corev1.ConfigMap{}, builder.WithPredicates(pred)).
+		Owns(&corev1.PersistentVolumeClaim{}, builder.WithPredicates(pred)).
+		Owns(&corev1.Service{}, builder.WithPredicates(pred)).
+		Watches(
+			&source.Kind{Type: &nearv1alpha1.Node{}},
+			handler.EnqueueRequestsFromMapFunc(r.referencingNodes),
+			builder.WithPredicates(bootnodeURLPred),
+		).
 		Complete(r)
 }
@@ -343,3 +343,136 @@ var _ = Describe("NEAR node controller", func() {
 	})
 
 })
+
+var _ = Describe("NEAR node boot nodes references", func() {
+
+	const (
+		interval = 2 * time.Second
+		timeout  = 2 * time.Minute
+	)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "near-references",
+		},
+	}
+
+	key := types.NamespacedName{
+		Name:      "near-node",
+		Namespace: ns.Name,
+	}
+
+	referencedKey := types.NamespacedName{
+		Name:      "near-bootnode",
+		Namespace: ns.Name,
+	}
+
+	publicKey := "ed25519:86EtEy7epneKyrcJwSWP7zsisTkfDRH5CFVszt4qiQYw"
+
+	toCreate := &nearv1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: nearv1alpha1.NodeSpec{
+			Network:   "mainnet",
+			Bootnodes: []string{referencedKey.Name},
+		},
+	}
+
+	referenced := &nearv1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      referencedKey.Name,
+			Namespace: referencedKey.Namespace,
+		},
+		Spec: nearv1alpha1.NodeSpec{
+			Network:                  "mainnet",
+			NodePrivateKeySecretName: "bootnode-key",
+		},
+	}
+
+	// bootnodeArg returns node statefulset boot nodes argument value
+	bootnodeArg := func() string {
+		sts := &appsv1.StatefulSet{}
+		if err := k8sClient.Get(context.Background(), key, sts); err != nil {
+			return err.Error()
+		}
+		args := sts.Spec.Template.Spec.Containers[0].Args
+		for i, arg := range args {
+			if arg == nearClients.NearArgBootnodes && i+1 < len(args) {
+				return args[i+1]
+			}
+		}
+		return ""
+	}
+
+	It(fmt.Sprintf("Should create %s namespace", ns.Name), func() {
+		Expect(k8sClient.Create(context.TODO(), ns)).To(Succeed())
+	})
+
+	It("Should create boot node key secret", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bootnode-key",
+				Namespace: ns.Name,
+			},
+			StringData: map[string]string{
+				"key": fmt.Sprintf(`{"account_id":"node","public_key":"%s","secret_key":"ed25519:secret"}`, publicKey),
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+	})
+
+	It("Should create node referencing a node that doesn't exist yet", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			toCreate.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), toCreate)).Should(Succeed())
+		time.Sleep(5 * time.Second)
+	})
+
+	It("Should report unresolved boot node reference in status", func() {
+		fetched := &nearv1alpha1.Node{}
+		Expect(k8sClient.Get(context.Background(), key, fetched)).To(Succeed())
+		Expect(fetched.Status.UnresolvedReferences).To(ConsistOf(referencedKey.Name))
+		Expect(bootnodeArg()).To(BeEmpty())
+	})
+
+	It("Should create the referenced node", func() {
+		if os.Getenv("USE_EXISTING_CLUSTER") != "true" {
+			referenced.Default()
+		}
+		Expect(k8sClient.Create(context.Background(), referenced)).Should(Succeed())
+	})
+
+	It("Should resolve boot node reference once referenced node boot node URL is reported", func() {
+		Eventually(func() []string {
+			fetched := &nearv1alpha1.Node{}
+			if err := k8sClient.Get(context.Background(), key, fetched); err != nil {
+				return []string{err.Error()}
+			}
+			return fetched.Status.UnresolvedReferences
+		}, timeout, interval).Should(BeEmpty())
+
+		fetched := &nearv1alpha1.Node{}
+		Expect(k8sClient.Get(context.Background(), referencedKey, fetched)).To(Succeed())
+		Expect(fetched.Status.BootnodeURL).To(HavePrefix(publicKey + "@"))
+		Expect(fetched.Status.BootnodeURL).To(HaveSuffix(fmt.Sprintf(":%d", nearv1alpha1.DefaultP2PPort)))
+
+		Eventually(bootnodeArg, timeout, interval).Should(Equal(fetched.Status.BootnodeURL))
+	})
+
+	It("Should re-reconcile node when referenced node boot node URL changes", func() {
+		fetched := &nearv1alpha1.Node{}
+		Expect(k8sClient.Get(context.Background(), referencedKey, fetched)).To(Succeed())
+		fetched.Spec.P2PPort = 24568
+		Expect(k8sClient.Update(context.Background(), fetched)).To(Succeed())
+
+		Eventually(bootnodeArg, timeout, interval).Should(HaveSuffix(":24568"))
+	})
+
+	It(fmt.Sprintf("Should delete %s namespace", ns.Name), func() {
+		Expect(k8sClient.Delete(context.Background(), ns)).To(Succeed())
+	})
+
+})
@@ -0,0 +1,143 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// GatewayRPCUpstreamPort is node JSON-RPC server localhost port behind the gateway
+	GatewayRPCUpstreamPort = 18545
+	// GatewayWSUpstreamPort is node web socket server localhost port behind the gateway
+	GatewayWSUpstreamPort = 18546
+	// GatewayRESTUpstreamPort is beacon node REST API server localhost port behind the gateway
+	GatewayRESTUpstreamPort = 15052
+	// GatewayJSONRPCProtocol is JSON-RPC over HTTP or web socket gateway protocol
+	GatewayJSONRPCProtocol = "jsonrpc"
+	// GatewayRESTProtocol is REST API gateway protocol
+	GatewayRESTProtocol = "rest"
+	// gatewayAPIKeysPath is API keys secret mount path in the gateway container
+	gatewayAPIKeysPath = "/etc/gateway/api-keys"
+	// gatewayJWTPath is JWT secret mount path in the gateway container
+	gatewayJWTPath = "/etc/gateway/jwt"
+)
+
+// GatewayRoute is gateway listening port proxying requests to localhost upstream
+type GatewayRoute struct {
+	// Name is the port name
+	Name string
+	// Port is gateway listening port
+	Port uint
+	// Upstream is node localhost server url
+	Upstream string
+}
+
+// GatewayArgs returns gateway container arguments
+func GatewayArgs(gateway *sharedAPI.RPCGateway, protocol string, routes []GatewayRoute) []string {
+	args := []string{"--protocol", protocol}
+
+	for _, route := range routes {
+		args = append(args, "--route", fmt.Sprintf("0.0.0.0:%d=%s", route.Port, route.Upstream))
+	}
+
+	if len(gateway.AllowedMethods) != 0 {
+		args = append(args, "--allow", strings.Join(gateway.AllowedMethods, ","))
+	}
+
+	if len(gateway.DeniedMethods) != 0 {
+		args = append(args, "--deny", strings.Join(gateway.DeniedMethods, ","))
+	}
+
+	if gateway.APIKeysSecretName != "" {
+		args = append(args, "--api-keys-dir", gatewayAPIKeysPath)
+	}
+
+	if gateway.JWTSecretName != "" {
+		args = append(args, "--jwt-secret", fmt.Sprintf("%s/secret", gatewayJWTPath))
+	}
+
+	if gateway.RateLimit != 0 {
+		args = append(args, "--rate-limit", fmt.Sprintf("%d", gateway.RateLimit))
+		args = append(args, "--rate-limit-burst", fmt.Sprintf("%d", gateway.RateLimitBurst))
+	}
+
+	if gateway.RequestLogging {
+		args = append(args, "--log-requests")
+	}
+
+	return args
+}
+
+// GatewayContainer returns gateway sidecar container exposing routes ports
+func GatewayContainer(gateway *sharedAPI.RPCGateway, protocol string, routes []GatewayRoute) corev1.Container {
+	ports := []corev1.ContainerPort{}
+	for _, route := range routes {
+		ports = append(ports, corev1.ContainerPort{
+			Name:          route.Name,
+			ContainerPort: int32(route.Port),
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+
+	mounts := []corev1.VolumeMount{}
+	if gateway.APIKeysSecretName != "" {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "gateway-api-keys",
+			MountPath: gatewayAPIKeysPath,
+			ReadOnly:  true,
+		})
+	}
+	if gateway.JWTSecretName != "" {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "gateway-jwt",
+			MountPath: gatewayJWTPath,
+			ReadOnly:  true,
+		})
+	}
+
+	return corev1.Container{
+		Name:         "gateway",
+		Image:        gateway.Image,
+		Args:         GatewayArgs(gateway, protocol, routes),
+		Ports:        ports,
+		VolumeMounts: mounts,
+	}
+}
+
+// GatewayVolumes returns gateway API keys and JWT secrets volumes
+func GatewayVolumes(gateway *sharedAPI.RPCGateway) []corev1.Volume {
+	volumes := []corev1.Volume{}
+
+	if gateway.APIKeysSecretName != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: "gateway-api-keys",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: gateway.APIKeysSecretName,
+				},
+			},
+		})
+	}
+
+	if gateway.JWTSecretName != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: "gateway-jwt",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: gateway.JWTSecretName,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  "secret",
+							Path: "secret",
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return volumes
+}
@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+)
+
+func TestGatewayArgs(t *testing.T) {
+	gateway := &sharedAPI.RPCGateway{
+		AllowedMethods:    []string{"eth_*", "net_version"},
+		DeniedMethods:     []string{"eth_sendRawTransaction"},
+		APIKeysSecretName: "api-keys",
+		RateLimit:         10,
+		RateLimitBurst:    20,
+		RequestLogging:    true,
+	}
+
+	routes := []GatewayRoute{
+		{Name: "json-rpc", Port: 8545, Upstream: "http://127.0.0.1:18545"},
+		{Name: "ws", Port: 8546, Upstream: "ws://127.0.0.1:18546"},
+	}
+
+	expected := []string{
+		"--protocol", GatewayJSONRPCProtocol,
+		"--route", "0.0.0.0:8545=http://127.0.0.1:18545",
+		"--route", "0.0.0.0:8546=ws://127.0.0.1:18546",
+		"--allow", "eth_*,net_version",
+		"--deny", "eth_sendRawTransaction",
+		"--api-keys-dir", "/etc/gateway/api-keys",
+		"--rate-limit", "10",
+		"--rate-limit-burst", "20",
+		"--log-requests",
+	}
+
+	got := GatewayArgs(gateway, GatewayJSONRPCProtocol, routes)
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected gateway args to be %v but got %v", expected, got)
+	}
+}
+
+func TestGatewayVolumes(t *testing.T) {
+	gateway := &sharedAPI.RPCGateway{
+		JWTSecretName: "gateway-jwt",
+	}
+
+	volumes := GatewayVolumes(gateway)
+
+	if len(volumes) != 1 || volumes[0].Secret.SecretName != "gateway-jwt" {
+		t.Errorf("expected jwt secret volume only but got %v", volumes)
+	}
+}
@@ -0,0 +1,27 @@
+package shared
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ReferencesIndexKey is the field index key of resources referenced by a node
+// index values are namespaced names of referenced resources in the format of namespace/name
+const ReferencesIndexKey = "spec.references"
+
+// ParseReference parses resource reference in the format of name or name.namespace
+// references without namespace refer to resources in the given namespace
+func ParseReference(ref, namespace string) types.NamespacedName {
+	name := ref
+
+	if parts := strings.Split(ref, "."); len(parts) > 1 {
+		name = parts[0]
+		namespace = parts[1]
+	}
+
+	return types.NamespacedName{
+		Name:      name,
+		Namespace: namespace,
+	}
+}
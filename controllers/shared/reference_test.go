@@ -0,0 +1,29 @@
+package shared
+
+import "testing"
+
+func TestParseReference(t *testing.T) {
+	cases := []struct {
+		ref       string
+		name      string
+		namespace string
+	}{
+		{
+			ref:       "my-node",
+			name:      "my-node",
+			namespace: "default",
+		},
+		{
+			ref:       "my-node.my-namespace",
+			name:      "my-node",
+			namespace: "my-namespace",
+		},
+	}
+
+	for _, c := range cases {
+		got := ParseReference(c.ref, "default")
+		if got.Name != c.name || got.Namespace != c.namespace {
+			t.Errorf("expected reference %s to be %s/%s, got %s", c.ref, c.namespace, c.name, got)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package gateway
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnauthorized is returned if request has no credentials, or invalid credentials
+	ErrUnauthorized = errors.New("unauthorized")
+	// errInvalidToken is returned if JWT token can't be verified
+	errInvalidToken = errors.New("invalid token")
+)
+
+const (
+	// APIKeyHeader is the header used to send API keys
+	APIKeyHeader = "X-API-Key"
+	// TokenQueryParam is the query parameter used to send API keys or JWT tokens
+	// by clients that can't set headers, like browser web socket clients
+	TokenQueryParam = "token"
+)
+
+// Authenticator authenticates requests using API keys or HS256 signed JWT tokens
+type Authenticator struct {
+	mu sync.RWMutex
+	// apiKeysDir is directory of API keys, file name is the key owner and file content is the key
+	apiKeysDir string
+	// apiKeys is API key to owner
+	apiKeys map[string]string
+	// jwtSecret is JWT tokens HMAC signing key
+	jwtSecret []byte
+}
+
+// NewAuthenticator creates authenticator from API keys directory and JWT secret file
+// any of them can be empty
+func NewAuthenticator(apiKeysDir, jwtSecretPath string) (*Authenticator, error) {
+	a := &Authenticator{apiKeysDir: apiKeysDir}
+
+	if jwtSecretPath != "" {
+		secret, err := os.ReadFile(jwtSecretPath)
+		if err != nil {
+			return nil, err
+		}
+		a.jwtSecret = []byte(strings.TrimSpace(string(secret)))
+		if len(a.jwtSecret) == 0 {
+			return nil, fmt.Errorf("jwt secret %s is empty", jwtSecretPath)
+		}
+	}
+
+	if err := a.Reload(); err != nil {
+		return nil, err
+	}
+
+	if len(a.apiKeys) == 0 && a.jwtSecret == nil {
+		return nil, errors.New("no api keys or jwt secret are provided")
+	}
+
+	return a, nil
+}
+
+// Reload reloads API keys from API keys directory
+// kubernetes updates mounted secrets in place, so new and revoked keys are picked without restart
+func (a *Authenticator) Reload() error {
+	if a.apiKeysDir == "" {
+		return nil
+	}
+
+	entries, err := os.ReadDir(a.apiKeysDir)
+	if err != nil {
+		return err
+	}
+
+	apiKeys := map[string]string{}
+	for _, entry := range entries {
+		owner := entry.Name()
+		// skip kubernetes secret volume internal files like ..data
+		if strings.HasPrefix(owner, ".") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(a.apiKeysDir, owner))
+		if err != nil {
+			// directories are skipped
+			continue
+		}
+		if key := strings.TrimSpace(string(content)); key != "" {
+			apiKeys[key] = owner
+		}
+	}
+
+	a.mu.Lock()
+	a.apiKeys = apiKeys
+	a.mu.Unlock()
+
+	return nil
+}
+
+// Watch reloads API keys every interval until stop is closed
+func (a *Authenticator) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// keep serving with previous keys if reloading failed
+			_ = a.Reload()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// credential returns request API key or JWT token
+func credential(r *http.Request) string {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimPrefix(auth, "Bearer ")
+	}
+	return r.URL.Query().Get(TokenQueryParam)
+}
+
+// Authenticate returns the request caller, which is API key owner or JWT token subject
+func (a *Authenticator) Authenticate(r *http.Request) (string, error) {
+	cred := credential(r)
+	if cred == "" {
+		return "", ErrUnauthorized
+	}
+
+	a.mu.RLock()
+	for key, owner := range a.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(cred)) == 1 {
+			a.mu.RUnlock()
+			return owner, nil
+		}
+	}
+	a.mu.RUnlock()
+
+	if a.jwtSecret != nil {
+		subject, err := verifyToken(a.jwtSecret, cred, now())
+		if err == nil {
+			return "jwt:" + subject, nil
+		}
+	}
+
+	return "", ErrUnauthorized
+}
+
+// jwtHeader is HS256 JWT token header
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// claims is JWT token registered claims used by the gateway
+type claims struct {
+	Subject   string `json:"sub,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	NotBefore int64  `json:"nbf,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+}
+
+// sign returns HS256 signature of the signing input
+func sign(secret []byte, input string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(input))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// SignToken returns HS256 JWT token for subject that expires after ttl
+func SignToken(secret []byte, subject string, ttl time.Duration) (string, error) {
+	issued := now()
+	payload, err := json.Marshal(claims{
+		Subject:   subject,
+		IssuedAt:  issued.Unix(),
+		ExpiresAt: issued.Add(ttl).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	input := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return input + "." + sign(secret, input), nil
+}
+
+// verifyToken verifies HS256 JWT token signature and validity at the given time, and returns its subject
+func verifyToken(secret []byte, token string, at time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errInvalidToken
+	}
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", errInvalidToken
+	}
+	var h struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(header, &h); err != nil || h.Alg != "HS256" {
+		return "", errInvalidToken
+	}
+
+	expected := sign(secret, parts[0]+"."+parts[1])
+	if !hmac.Equal([]byte(expected), []byte(parts[2])) {
+		return "", errInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errInvalidToken
+	}
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return "", errInvalidToken
+	}
+
+	if c.ExpiresAt != 0 && at.Unix() >= c.ExpiresAt {
+		return "", errInvalidToken
+	}
+	if c.NotBefore != 0 && at.Unix() < c.NotBefore {
+		return "", errInvalidToken
+	}
+
+	return c.Subject, nil
+}
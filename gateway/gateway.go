@@ -0,0 +1,161 @@
+// Package gateway is authenticated JSON-RPC and REST gateway running in front of blockchain nodes
+// nodes are bound to localhost, and the gateway is the only way to reach their APIs
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// now returns current time, replaced in tests
+var now = time.Now
+
+// JSON-RPC error codes returned by the gateway
+const (
+	// ParseErrorCode is returned if request body is not valid JSON
+	ParseErrorCode = -32700
+	// MethodNotAllowedCode is returned if method is denied by the gateway
+	MethodNotAllowedCode = -32601
+	// UnauthorizedCode is returned if request has no valid credentials
+	UnauthorizedCode = -32001
+	// RateLimitedCode is returned if caller exceeded the rate limit
+	RateLimitedCode = -32005
+)
+
+// maxBodySize is the maximum accepted request body size
+const maxBodySize = 5 * 1024 * 1024
+
+// Protocol is the upstream API protocol
+type Protocol string
+
+const (
+	// JSONRPCProtocol is JSON-RPC over HTTP or web socket, policy is applied on methods
+	JSONRPCProtocol Protocol = "jsonrpc"
+	// RESTProtocol is REST API like beacon node API, policy is applied on URL paths
+	RESTProtocol Protocol = "rest"
+)
+
+// Gateway authenticates, rate limits, filters and logs requests before proxying them upstream
+type Gateway struct {
+	// Policy is the methods allow and deny lists
+	Policy Policy
+	// Auth authenticates requests
+	Auth *Authenticator
+	// Limiter limits requests per caller, nil allows all requests
+	Limiter *RateLimiter
+	// Logging logs every request with caller and methods
+	Logging bool
+}
+
+// Handler returns handler proxying requests to upstream
+// web socket upstream (ws or wss scheme) is proxied message by message
+func (g *Gateway) Handler(protocol Protocol, upstream *url.URL) (http.Handler, error) {
+	switch {
+	case protocol == RESTProtocol:
+		return g.restHandler(upstream), nil
+	case upstream.Scheme == "http" || upstream.Scheme == "https":
+		return g.jsonrpcHandler(upstream), nil
+	case upstream.Scheme == "ws" || upstream.Scheme == "wss":
+		return g.websocketHandler(upstream), nil
+	}
+	return nil, fmt.Errorf("unsupported upstream %s", upstream)
+}
+
+// logRequest logs request if logging is enabled
+func (g *Gateway) logRequest(caller string, methods []string, status string, started time.Time) {
+	if !g.Logging {
+		return
+	}
+	log.Printf("caller=%q methods=%q status=%q duration=%s", caller, strings.Join(methods, ","), status, time.Since(started))
+}
+
+// rpcError is JSON-RPC 2.0 error
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// rpcErrorResponse is JSON-RPC 2.0 error response
+type rpcErrorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   rpcError        `json:"error"`
+}
+
+// errorResponse returns JSON-RPC error response for request id
+func errorResponse(id json.RawMessage, code int, message string) rpcErrorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return rpcErrorResponse{
+		JSONRPC: "2.0",
+		ID:      id,
+		Error:   rpcError{Code: code, Message: message},
+	}
+}
+
+// writeJSON writes v as JSON response with status code
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// authenticate authenticates request and checks caller rate limit for n requests
+// returned http status is zero if request is accepted
+func (g *Gateway) authenticate(r *http.Request, n int) (caller string, status int, err error) {
+	caller, err = g.Auth.Authenticate(r)
+	if err != nil {
+		return "", http.StatusUnauthorized, err
+	}
+
+	if !g.Limiter.Allow(caller, n) {
+		return caller, http.StatusTooManyRequests, errors.New("rate limit exceeded")
+	}
+
+	return caller, 0, nil
+}
+
+// rpcCall is JSON-RPC 2.0 request, only fields used by the gateway are decoded
+type rpcCall struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// parseCalls parses single or batch JSON-RPC request
+func parseCalls(body []byte) (calls []rpcCall, raw []json.RawMessage, batch bool, err error) {
+	trimmed := strings.TrimSpace(string(body))
+	batch = strings.HasPrefix(trimmed, "[")
+
+	if batch {
+		if err = json.Unmarshal(body, &raw); err != nil {
+			return
+		}
+	} else {
+		raw = []json.RawMessage{json.RawMessage(body)}
+	}
+
+	calls = make([]rpcCall, len(raw))
+	for i := range raw {
+		if err = json.Unmarshal(raw[i], &calls[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// methods returns calls methods
+func methods(calls []rpcCall) []string {
+	names := make([]string, len(calls))
+	for i := range calls {
+		names[i] = calls[i].Method
+	}
+	return names
+}
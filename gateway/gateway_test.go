@@ -0,0 +1,314 @@
+package gateway
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gateway", func() {
+
+	secret := []byte("gateway-jwt-secret")
+
+	// newAuthenticator creates authenticator with alice API key and JWT secret
+	newAuthenticator := func() *Authenticator {
+		dir, err := os.MkdirTemp("", "gateway")
+		Expect(err).To(BeNil())
+		Expect(os.WriteFile(filepath.Join(dir, "alice"), []byte("alice-key\n"), 0600)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(dir, "..data"), 0700)).To(Succeed())
+		jwtSecret := filepath.Join(dir, "..data", "secret")
+		Expect(os.WriteFile(jwtSecret, secret, 0600)).To(Succeed())
+		auth, err := NewAuthenticator(dir, jwtSecret)
+		Expect(err).To(BeNil())
+		return auth
+	}
+
+	// echo is JSON-RPC upstream returning requests methods as results
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls, _, batch, _ := parseCalls(body)
+		responses := []map[string]interface{}{}
+		for _, call := range calls {
+			responses = append(responses, map[string]interface{}{"jsonrpc": "2.0", "id": call.ID, "result": call.Method})
+		}
+		if batch {
+			writeJSON(w, http.StatusOK, responses)
+		} else {
+			writeJSON(w, http.StatusOK, responses[0])
+		}
+	}
+
+	Context("Policy", func() {
+		It("Should allow all methods if no allowed methods are provided", func() {
+			policy := Policy{}
+			Expect(policy.Allows("debug_traceTransaction")).To(BeTrue())
+		})
+
+		It("Should allow methods matching allowed patterns", func() {
+			policy := Policy{Allowed: []string{"eth_*", "net_version"}}
+			Expect(policy.Allows("eth_blockNumber")).To(BeTrue())
+			Expect(policy.Allows("net_version")).To(BeTrue())
+			Expect(policy.Allows("net_peerCount")).To(BeFalse())
+			Expect(policy.Allows("admin_peers")).To(BeFalse())
+		})
+
+		It("Should deny methods matching denied patterns even if allowed", func() {
+			policy := Policy{Allowed: []string{"eth_*"}, Denied: []string{"eth_sendRawTransaction"}}
+			Expect(policy.Allows("eth_call")).To(BeTrue())
+			Expect(policy.Allows("eth_sendRawTransaction")).To(BeFalse())
+		})
+
+		It("Should match REST paths", func() {
+			policy := Policy{Allowed: []string{"/eth/v1/node/*"}}
+			Expect(policy.Allows("/eth/v1/node/syncing")).To(BeTrue())
+			Expect(policy.Allows("/eth/v1/validator/duties")).To(BeFalse())
+		})
+	})
+
+	Context("Authenticator", func() {
+		auth := newAuthenticator()
+
+		request := func(header, value string) *http.Request {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			if header != "" {
+				r.Header.Set(header, value)
+			}
+			return r
+		}
+
+		It("Should authenticate API key owner", func() {
+			caller, err := auth.Authenticate(request(APIKeyHeader, "alice-key"))
+			Expect(err).To(BeNil())
+			Expect(caller).To(Equal("alice"))
+		})
+
+		It("Should authenticate API key sent as bearer token", func() {
+			caller, err := auth.Authenticate(request("Authorization", "Bearer alice-key"))
+			Expect(err).To(BeNil())
+			Expect(caller).To(Equal("alice"))
+		})
+
+		It("Should authenticate JWT token subject", func() {
+			token, err := SignToken(secret, "bob", time.Minute)
+			Expect(err).To(BeNil())
+			caller, err := auth.Authenticate(request("Authorization", "Bearer "+token))
+			Expect(err).To(BeNil())
+			Expect(caller).To(Equal("jwt:bob"))
+		})
+
+		It("Should authenticate token sent as query parameter", func() {
+			r := httptest.NewRequest(http.MethodGet, "/?token=alice-key", nil)
+			caller, err := auth.Authenticate(r)
+			Expect(err).To(BeNil())
+			Expect(caller).To(Equal("alice"))
+		})
+
+		It("Should reject expired JWT token", func() {
+			token, err := SignToken(secret, "bob", -time.Minute)
+			Expect(err).To(BeNil())
+			_, err = auth.Authenticate(request("Authorization", "Bearer "+token))
+			Expect(err).To(Equal(ErrUnauthorized))
+		})
+
+		It("Should reject JWT token signed by another secret", func() {
+			token, err := SignToken([]byte("another-secret"), "bob", time.Minute)
+			Expect(err).To(BeNil())
+			_, err = auth.Authenticate(request("Authorization", "Bearer "+token))
+			Expect(err).To(Equal(ErrUnauthorized))
+		})
+
+		It("Should reject unknown API key and missing credentials", func() {
+			_, err := auth.Authenticate(request(APIKeyHeader, "mallory-key"))
+			Expect(err).To(Equal(ErrUnauthorized))
+			_, err = auth.Authenticate(request("", ""))
+			Expect(err).To(Equal(ErrUnauthorized))
+		})
+
+		It("Should fail if no API keys or JWT secret are provided", func() {
+			_, err := NewAuthenticator(GinkgoT().TempDir(), "")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("Rate limiter", func() {
+		It("Should allow all requests without rate limit", func() {
+			limiter := NewRateLimiter(0, 0)
+			Expect(limiter).To(BeNil())
+			Expect(limiter.Allow("alice", 1000)).To(BeTrue())
+		})
+
+		It("Should limit every caller separately", func() {
+			limiter := NewRateLimiter(1, 2)
+			Expect(limiter.Allow("alice", 2)).To(BeTrue())
+			Expect(limiter.Allow("alice", 1)).To(BeFalse())
+			Expect(limiter.Allow("bob", 1)).To(BeTrue())
+		})
+	})
+
+	Context("JSON-RPC over HTTP", func() {
+		upstream := httptest.NewServer(http.HandlerFunc(echo))
+		upstreamURL, _ := url.Parse(upstream.URL)
+
+		g := &Gateway{
+			Policy:  Policy{Denied: []string{"admin_*"}},
+			Auth:    newAuthenticator(),
+			Limiter: NewRateLimiter(5, 5),
+		}
+		handler, err := g.Handler(JSONRPCProtocol, upstreamURL)
+		Expect(err).To(BeNil())
+
+		call := func(key, body string) (int, string) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			if key != "" {
+				r.Header.Set(APIKeyHeader, key)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			return w.Code, strings.TrimSpace(w.Body.String())
+		}
+
+		It("Should reject unauthenticated requests", func() {
+			code, body := call("", `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+			Expect(code).To(Equal(http.StatusUnauthorized))
+			Expect(body).To(ContainSubstring(`"code":-32001`))
+		})
+
+		It("Should forward allowed methods", func() {
+			code, body := call("alice-key", `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+			Expect(code).To(Equal(http.StatusOK))
+			Expect(body).To(MatchJSON(`{"jsonrpc":"2.0","id":1,"result":"eth_blockNumber"}`))
+		})
+
+		It("Should deny methods not allowed", func() {
+			code, body := call("alice-key", `{"jsonrpc":"2.0","id":2,"method":"admin_peers"}`)
+			Expect(code).To(Equal(http.StatusOK))
+			Expect(body).To(MatchJSON(`{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"method admin_peers is not allowed"}}`))
+		})
+
+		It("Should merge denied calls responses in batch requests", func() {
+			code, body := call("alice-key", `[{"jsonrpc":"2.0","id":3,"method":"net_version"},{"jsonrpc":"2.0","id":4,"method":"admin_nodeInfo"}]`)
+			Expect(code).To(Equal(http.StatusOK))
+			responses := []json.RawMessage{}
+			Expect(json.Unmarshal([]byte(body), &responses)).To(Succeed())
+			Expect(responses).To(HaveLen(2))
+			Expect(responses[0]).To(MatchJSON(`{"jsonrpc":"2.0","id":3,"result":"net_version"}`))
+			Expect(responses[1]).To(MatchJSON(`{"jsonrpc":"2.0","id":4,"error":{"code":-32601,"message":"method admin_nodeInfo is not allowed"}}`))
+		})
+
+		It("Should reject invalid JSON", func() {
+			code, body := call("alice-key", `{"jsonrpc":`)
+			Expect(code).To(Equal(http.StatusBadRequest))
+			Expect(body).To(ContainSubstring(`"code":-32700`))
+		})
+
+		It("Should rate limit callers", func() {
+			code, body := call("alice-key", `[{"jsonrpc":"2.0","id":5,"method":"net_version"},{"jsonrpc":"2.0","id":6,"method":"net_version"},{"jsonrpc":"2.0","id":7,"method":"net_version"},{"jsonrpc":"2.0","id":8,"method":"net_version"},{"jsonrpc":"2.0","id":9,"method":"net_version"},{"jsonrpc":"2.0","id":10,"method":"net_version"}]`)
+			Expect(code).To(Equal(http.StatusTooManyRequests))
+			Expect(body).To(ContainSubstring(`"code":-32005`))
+		})
+	})
+
+	Context("REST", func() {
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// credentials must not reach the node
+			Expect(r.Header.Get(APIKeyHeader)).To(BeEmpty())
+			_, _ = w.Write([]byte(r.URL.Path))
+		}))
+		upstreamURL, _ := url.Parse(upstream.URL)
+
+		g := &Gateway{
+			Policy: Policy{Allowed: []string{"/eth/v1/node/*"}},
+			Auth:   newAuthenticator(),
+		}
+		handler, err := g.Handler(RESTProtocol, upstreamURL)
+		Expect(err).To(BeNil())
+
+		get := func(key, path string) (int, string) {
+			r := httptest.NewRequest(http.MethodGet, path, nil)
+			if key != "" {
+				r.Header.Set(APIKeyHeader, key)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			return w.Code, w.Body.String()
+		}
+
+		It("Should forward allowed paths", func() {
+			code, body := get("alice-key", "/eth/v1/node/syncing")
+			Expect(code).To(Equal(http.StatusOK))
+			Expect(body).To(Equal("/eth/v1/node/syncing"))
+		})
+
+		It("Should forbid paths not allowed", func() {
+			code, _ := get("alice-key", "/eth/v1/validator/duties/proposer/1")
+			Expect(code).To(Equal(http.StatusForbidden))
+		})
+
+		It("Should reject unauthenticated requests", func() {
+			code, _ := get("", "/eth/v1/node/syncing")
+			Expect(code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Context("JSON-RPC over web socket", func() {
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			for {
+				_, message, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				var c rpcCall
+				_ = json.Unmarshal(message, &c)
+				_ = conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": c.ID, "result": c.Method})
+			}
+		}))
+		upstreamURL, _ := url.Parse("ws" + strings.TrimPrefix(upstream.URL, "http"))
+
+		g := &Gateway{
+			Policy: Policy{Allowed: []string{"eth_*"}},
+			Auth:   newAuthenticator(),
+		}
+		handler, err := g.Handler(JSONRPCProtocol, upstreamURL)
+		Expect(err).To(BeNil())
+		server := httptest.NewServer(handler)
+		endpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+
+		It("Should reject unauthenticated connections", func() {
+			_, resp, err := websocket.DefaultDialer.Dial(endpoint, nil)
+			Expect(err).NotTo(BeNil())
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Should forward allowed methods and deny the rest", func() {
+			conn, _, err := websocket.DefaultDialer.Dial(endpoint+"?token=alice-key", nil)
+			Expect(err).To(BeNil())
+			defer conn.Close()
+
+			Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`))).To(Succeed())
+			_, message, err := conn.ReadMessage()
+			Expect(err).To(BeNil())
+			Expect(message).To(MatchJSON(`{"jsonrpc":"2.0","id":1,"result":"eth_chainId"}`))
+
+			Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":2,"method":"debug_traceBlock"}`))).To(Succeed())
+			_, message, err = conn.ReadMessage()
+			Expect(err).To(BeNil())
+			Expect(message).To(MatchJSON(`{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"method debug_traceBlock is not allowed"}}`))
+		})
+	})
+
+})
@@ -0,0 +1,128 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// upstreamTimeout is upstream HTTP requests timeout
+const upstreamTimeout = 60 * time.Second
+
+// InternalErrorCode is returned if upstream node can't be reached
+const InternalErrorCode = -32603
+
+// jsonrpcHandler returns JSON-RPC over HTTP handler
+// denied calls in batch requests are answered by the gateway, and the rest are forwarded upstream
+func (g *Gateway) jsonrpcHandler(upstream *url.URL) http.Handler {
+	client := &http.Client{Timeout: upstreamTimeout}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started := now()
+
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			writeJSON(w, http.StatusRequestEntityTooLarge, errorResponse(nil, ParseErrorCode, err.Error()))
+			return
+		}
+
+		calls, raw, batch, err := parseCalls(body)
+		if err != nil || len(calls) == 0 {
+			writeJSON(w, http.StatusBadRequest, errorResponse(nil, ParseErrorCode, "parse error"))
+			return
+		}
+
+		caller, status, err := g.authenticate(r, len(calls))
+		if status != 0 {
+			code := UnauthorizedCode
+			if status == http.StatusTooManyRequests {
+				code = RateLimitedCode
+			}
+			writeJSON(w, status, errorResponse(nil, code, err.Error()))
+			g.logRequest(caller, methods(calls), strconv.Itoa(status), started)
+			return
+		}
+
+		forwarded := []json.RawMessage{}
+		denied := []rpcErrorResponse{}
+		for i, call := range calls {
+			if g.Policy.Allows(call.Method) {
+				forwarded = append(forwarded, raw[i])
+				continue
+			}
+			// notifications have no id and expect no response
+			if len(call.ID) != 0 {
+				denied = append(denied, errorResponse(call.ID, MethodNotAllowedCode, fmt.Sprintf("method %s is not allowed", call.Method)))
+			}
+		}
+
+		if len(forwarded) == 0 {
+			if batch {
+				writeJSON(w, http.StatusOK, denied)
+			} else if len(denied) != 0 {
+				writeJSON(w, http.StatusOK, denied[0])
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			g.logRequest(caller, methods(calls), "denied", started)
+			return
+		}
+
+		payload := body
+		if batch {
+			payload, _ = json.Marshal(forwarded)
+		}
+
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, upstream.String(), bytes.NewReader(payload))
+		if err != nil {
+			writeJSON(w, http.StatusBadGateway, errorResponse(nil, InternalErrorCode, err.Error()))
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		// keep client host, so node virtual hosts allowlist is applied
+		req.Host = r.Host
+
+		resp, err := client.Do(req)
+		if err != nil {
+			writeJSON(w, http.StatusBadGateway, errorResponse(nil, InternalErrorCode, "upstream node is unavailable"))
+			g.logRequest(caller, methods(calls), strconv.Itoa(http.StatusBadGateway), started)
+			return
+		}
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			writeJSON(w, http.StatusBadGateway, errorResponse(nil, InternalErrorCode, err.Error()))
+			return
+		}
+
+		// merge gateway denied calls responses with upstream batch responses
+		if batch && len(denied) != 0 {
+			responses := []json.RawMessage{}
+			if json.Unmarshal(respBody, &responses) == nil {
+				for _, d := range denied {
+					encoded, _ := json.Marshal(d)
+					responses = append(responses, encoded)
+				}
+				respBody, _ = json.Marshal(responses)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(resp.StatusCode)
+		_, _ = w.Write(respBody)
+
+		g.logRequest(caller, methods(calls), strconv.Itoa(resp.StatusCode), started)
+	})
+}
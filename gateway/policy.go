@@ -0,0 +1,38 @@
+package gateway
+
+import "strings"
+
+// Policy is the methods allow and deny lists
+// method patterns are exact method names, or prefixes ending with *, like eth_*
+type Policy struct {
+	// Allowed is allowed method patterns, all methods are allowed if empty
+	Allowed []string
+	// Denied is denied method patterns, takes precedence over allowed patterns
+	Denied []string
+}
+
+// match returns true if method matches the pattern
+func match(pattern, method string) bool {
+	if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+		return strings.HasPrefix(method, prefix)
+	}
+	return pattern == method
+}
+
+// matchAny returns true if method matches any of the patterns
+func matchAny(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if match(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows returns true if method is allowed through the gateway
+func (p *Policy) Allows(method string) bool {
+	if matchAny(p.Denied, method) {
+		return false
+	}
+	return len(p.Allowed) == 0 || matchAny(p.Allowed, method)
+}
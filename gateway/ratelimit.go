@@ -0,0 +1,50 @@
+package gateway
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter limits requests per caller
+type RateLimiter struct {
+	mu       sync.Mutex
+	limit    rate.Limit
+	burst    int
+	limiters map[string]*rate.Limiter
+}
+
+// NewRateLimiter creates rate limiter allowing perSecond requests with burst for every caller
+// nil rate limiter is returned if perSecond is zero, which allows all requests
+func NewRateLimiter(perSecond, burst uint) *RateLimiter {
+	if perSecond == 0 {
+		return nil
+	}
+
+	if burst < perSecond {
+		burst = perSecond
+	}
+
+	return &RateLimiter{
+		limit:    rate.Limit(perSecond),
+		burst:    int(burst),
+		limiters: map[string]*rate.Limiter{},
+	}
+}
+
+// Allow returns true if caller can make n requests now
+func (l *RateLimiter) Allow(caller string, n int) bool {
+	if l == nil {
+		return true
+	}
+
+	l.mu.Lock()
+	limiter, ok := l.limiters[caller]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[caller] = limiter
+	}
+	l.mu.Unlock()
+
+	return limiter.AllowN(now(), n)
+}
@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+)
+
+// statusRecorder records response status code
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records status code before writing it
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// Flush flushes streamed responses like beacon node server sent events
+func (s *statusRecorder) Flush() {
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// restHandler returns REST API handler, policy is applied on request URL path
+func (g *Gateway) restHandler(upstream *url.URL) http.Handler {
+	proxy := httputil.NewSingleHostReverseProxy(upstream)
+	// stream server sent events without buffering
+	proxy.FlushInterval = -1
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started := now()
+		paths := []string{r.URL.Path}
+
+		caller, status, err := g.authenticate(r, 1)
+		if status != 0 {
+			http.Error(w, err.Error(), status)
+			g.logRequest(caller, paths, strconv.Itoa(status), started)
+			return
+		}
+
+		if !g.Policy.Allows(r.URL.Path) {
+			http.Error(w, "path is not allowed", http.StatusForbidden)
+			g.logRequest(caller, paths, "denied", started)
+			return
+		}
+
+		// credentials are not forwarded to the node
+		r.Header.Del(APIKeyHeader)
+		r.Header.Del("Authorization")
+		query := r.URL.Query()
+		query.Del(TokenQueryParam)
+		r.URL.RawQuery = query.Encode()
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		proxy.ServeHTTP(recorder, r)
+
+		g.logRequest(caller, paths, strconv.Itoa(recorder.status), started)
+	})
+}
@@ -0,0 +1,166 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// upgrader upgrades client connections to web socket
+// clients are authenticated using credentials, not request origin
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// deniedCalls returns error responses if any of the calls is denied
+// batch requests are rejected as a whole, because web socket responses can't be merged
+func (g *Gateway) deniedCalls(calls []rpcCall) (responses []rpcErrorResponse) {
+	denied := false
+	for _, call := range calls {
+		if !g.Policy.Allows(call.Method) {
+			denied = true
+		}
+	}
+
+	if !denied {
+		return nil
+	}
+
+	responses = []rpcErrorResponse{}
+	for _, call := range calls {
+		if len(call.ID) == 0 {
+			continue
+		}
+		message := "batch contains methods that are not allowed"
+		if !g.Policy.Allows(call.Method) {
+			message = fmt.Sprintf("method %s is not allowed", call.Method)
+		}
+		responses = append(responses, errorResponse(call.ID, MethodNotAllowedCode, message))
+	}
+
+	return
+}
+
+// websocketHandler returns JSON-RPC over web socket handler
+// upstream messages are relayed as is, and client messages are filtered and rate limited one by one
+func (g *Gateway) websocketHandler(upstream *url.URL) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started := now()
+
+		caller, status, err := g.authenticate(r, 1)
+		if status != 0 {
+			http.Error(w, err.Error(), status)
+			g.logRequest(caller, []string{"websocket"}, strconv.Itoa(status), started)
+			return
+		}
+
+		// keep client host, so node virtual hosts allowlist is applied
+		header := http.Header{"Host": []string{r.Host}}
+		upstreamConn, _, err := websocket.DefaultDialer.DialContext(r.Context(), upstream.String(), header)
+		if err != nil {
+			http.Error(w, "upstream node is unavailable", http.StatusBadGateway)
+			g.logRequest(caller, []string{"websocket"}, strconv.Itoa(http.StatusBadGateway), started)
+			return
+		}
+		defer upstreamConn.Close()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// upgrader has replied with the error
+			return
+		}
+		defer conn.Close()
+
+		g.logRequest(caller, []string{"websocket"}, "connected", started)
+
+		// mu guards writes to client connection
+		var mu sync.Mutex
+		reply := func(v interface{}) error {
+			encoded, _ := json.Marshal(v)
+			mu.Lock()
+			defer mu.Unlock()
+			return conn.WriteMessage(websocket.TextMessage, encoded)
+		}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			// closing client connection stops reading client messages
+			defer conn.Close()
+			for {
+				messageType, message, err := upstreamConn.ReadMessage()
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				err = conn.WriteMessage(messageType, message)
+				mu.Unlock()
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				break
+			}
+			started := now()
+
+			calls, _, batch, err := parseCalls(message)
+			if err != nil || len(calls) == 0 {
+				if reply(errorResponse(nil, ParseErrorCode, "parse error")) != nil {
+					break
+				}
+				continue
+			}
+
+			var rejected []rpcErrorResponse
+			status := "forwarded"
+
+			if !g.Limiter.Allow(caller, len(calls)) {
+				status = strconv.Itoa(http.StatusTooManyRequests)
+				rejected = []rpcErrorResponse{}
+				for _, call := range calls {
+					if len(call.ID) != 0 {
+						rejected = append(rejected, errorResponse(call.ID, RateLimitedCode, "rate limit exceeded"))
+					}
+				}
+			} else if rejected = g.deniedCalls(calls); rejected != nil {
+				status = "denied"
+			}
+
+			g.logRequest(caller, methods(calls), status, started)
+
+			if rejected == nil {
+				if upstreamConn.WriteMessage(messageType, message) != nil {
+					break
+				}
+				continue
+			}
+
+			if len(rejected) == 0 {
+				// rejected notifications expect no response
+				continue
+			}
+
+			if batch {
+				err = reply(rejected)
+			} else {
+				err = reply(rejected[0])
+			}
+			if err != nil {
+				break
+			}
+		}
+
+		upstreamConn.Close()
+		<-done
+	})
+}
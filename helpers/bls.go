@@ -0,0 +1,151 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+// blsFieldModulus is BLS12-381 base field modulus
+var blsFieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+
+// blsCurveOrder is BLS12-381 subgroup order r
+var blsCurveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// blsSignatureDST is the domain separation tag of Ethereum proof of possession BLS signatures
+const blsSignatureDST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+
+// BLSPublicKey returns compressed BLS12-381 G1 public key of secret key
+func BLSPublicKey(secretKey *big.Int) []byte {
+	g1 := bls12381.NewG1()
+	publicKey := g1.MulScalar(g1.New(), g1.One(), secretKey)
+	return compressG1(g1.ToBytes(publicKey))
+}
+
+// BLSSign returns compressed BLS12-381 G2 signature of message signed by secret key
+func BLSSign(secretKey *big.Int, message []byte) ([]byte, error) {
+	g2 := bls12381.NewG2()
+	point, err := hashToG2(message, []byte(blsSignatureDST))
+	if err != nil {
+		return nil, err
+	}
+	signature := g2.MulScalar(g2.New(), point, secretKey)
+	return compressG2(g2.ToBytes(signature)), nil
+}
+
+// hashToG2 hashes message into G2 point using hash_to_curve BLS12381G2_XMD:SHA-256_SSWU_RO_ suite
+// https://www.rfc-editor.org/rfc/rfc9380
+func hashToG2(message, dst []byte) (*bls12381.PointG2, error) {
+	uniform, err := expandMessageXMD(message, dst, 256)
+	if err != nil {
+		return nil, err
+	}
+
+	g2 := bls12381.NewG2()
+	sum := g2.Zero()
+	for i := 0; i < 2; i++ {
+		// field element is encoded as c1 || c0
+		u := make([]byte, 96)
+		c0 := new(big.Int).Mod(new(big.Int).SetBytes(uniform[128*i:128*i+64]), blsFieldModulus)
+		c1 := new(big.Int).Mod(new(big.Int).SetBytes(uniform[128*i+64:128*i+128]), blsFieldModulus)
+		c1.FillBytes(u[:48])
+		c0.FillBytes(u[48:])
+
+		// cofactor clearing of each point is equivalent to clearing the sum cofactor
+		point, err := g2.MapToCurve(u)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(sum, sum, point)
+	}
+
+	return g2.Affine(sum), nil
+}
+
+// expandMessageXMD expands message into length uniformly random bytes using SHA-256
+func expandMessageXMD(message, dst []byte, length int) ([]byte, error) {
+	ell := (length + sha256.Size - 1) / sha256.Size
+	if ell > 255 || len(dst) > 255 {
+		return nil, errors.New("invalid expand message length")
+	}
+
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sha256.BlockSize))
+	h.Write(message)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	uniform := append([]byte{}, bi...)
+	for i := 2; i <= ell; i++ {
+		xored := make([]byte, sha256.Size)
+		for j := range xored {
+			xored[j] = b0[j] ^ bi[j]
+		}
+		h.Reset()
+		h.Write(xored)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		uniform = append(uniform, bi...)
+	}
+
+	return uniform[:length], nil
+}
+
+// compressG1 compresses uncompressed x || y G1 point using zcash serialization format
+func compressG1(uncompressed []byte) []byte {
+	out := append([]byte{}, uncompressed[:48]...)
+	if isZero(uncompressed) {
+		out[0] = 0xc0
+		return out
+	}
+	out[0] |= 0x80
+	if isLargest(new(big.Int).SetBytes(uncompressed[48:])) {
+		out[0] |= 0x20
+	}
+	return out
+}
+
+// compressG2 compresses uncompressed x || y G2 point using zcash serialization format
+// field elements are encoded as c1 || c0
+func compressG2(uncompressed []byte) []byte {
+	out := append([]byte{}, uncompressed[:96]...)
+	if isZero(uncompressed) {
+		out[0] = 0xc0
+		return out
+	}
+	out[0] |= 0x80
+	y1 := new(big.Int).SetBytes(uncompressed[96:144])
+	y0 := new(big.Int).SetBytes(uncompressed[144:])
+	if (y1.Sign() != 0 && isLargest(y1)) || (y1.Sign() == 0 && isLargest(y0)) {
+		out[0] |= 0x20
+	}
+	return out
+}
+
+// isLargest returns true if field element y is lexicographically larger than -y
+func isLargest(y *big.Int) bool {
+	half := new(big.Int).Rsh(new(big.Int).Sub(blsFieldModulus, big.NewInt(1)), 1)
+	return y.Cmp(half) > 0
+}
+
+// isZero returns true if all bytes are zeros
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,25 @@
+package helpers
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BLS12-381 keys and signatures", func() {
+
+	It("should derive public key", func() {
+		sk, _ := new(big.Int).SetString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3", 16)
+		Expect(hex.EncodeToString(BLSPublicKey(sk))).To(Equal("a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"))
+	})
+
+	It("should sign message", func() {
+		sk, _ := new(big.Int).SetString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3", 16)
+		signature, err := BLSSign(sk, make([]byte, 32))
+		Expect(err).To(BeNil())
+		Expect(hex.EncodeToString(signature)).To(Equal("b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"))
+	})
+
+})
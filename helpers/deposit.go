@@ -0,0 +1,184 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// DepositCLIVersion is staking deposit cli version of generated deposit data
+// it's required by the staking launchpad to accept deposit data
+const DepositCLIVersion = "2.7.0"
+
+// depositDomainType is DOMAIN_DEPOSIT domain type
+var depositDomainType = []byte{0x03, 0x00, 0x00, 0x00}
+
+// ValidatorKey is validator signing key derived from mnemonic
+type ValidatorKey struct {
+	// Index is validator key index in EIP-2334 path
+	Index uint32
+	// Path is signing key EIP-2334 path
+	Path string
+	// SecretKey is signing secret key
+	SecretKey *big.Int
+	// PublicKey is signing compressed public key
+	PublicKey []byte
+	// WithdrawalPublicKey is withdrawal key compressed public key
+	WithdrawalPublicKey []byte
+}
+
+// DepositData is validator deposit in staking deposit cli deposit_data.json format
+type DepositData struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"network_name"`
+	DepositCLIVersion     string `json:"deposit_cli_version"`
+}
+
+// DeriveValidatorKeys derives count validator keys starting at index start from BIP-39 mnemonic
+// signing keys are derived using EIP-2334 path m/12381/3600/i/0/0 and withdrawal keys using m/12381/3600/i/0
+func DeriveValidatorKeys(mnemonic string, start, count uint32) (keys []ValidatorKey, err error) {
+	seed, err := mnemonicSeed(mnemonic)
+	if err != nil {
+		return
+	}
+
+	for i := start; i < start+count; i++ {
+		var withdrawalKey, signingKey *big.Int
+		if withdrawalKey, err = deriveSKFromPath(seed, []uint32{12381, 3600, i, 0}); err != nil {
+			return
+		}
+		if signingKey, err = deriveChildSK(withdrawalKey, 0); err != nil {
+			return
+		}
+		keys = append(keys, ValidatorKey{
+			Index:               i,
+			Path:                fmt.Sprintf("m/12381/3600/%d/0/0", i),
+			SecretKey:           signingKey,
+			PublicKey:           BLSPublicKey(signingKey),
+			WithdrawalPublicKey: BLSPublicKey(withdrawalKey),
+		})
+	}
+
+	return
+}
+
+// WithdrawalCredentials returns validator key withdrawal credentials
+// execution layer withdrawal credentials are used if withdrawal address is provided
+// otherwise BLS withdrawal credentials of withdrawal key are used
+func (k *ValidatorKey) WithdrawalCredentials(withdrawalAddress string) ([]byte, error) {
+	credentials := make([]byte, 32)
+
+	if withdrawalAddress == "" {
+		hashed := sha256.Sum256(k.WithdrawalPublicKey)
+		copy(credentials[1:], hashed[1:])
+		return credentials, nil
+	}
+
+	address, err := hex.DecodeString(strings.TrimPrefix(withdrawalAddress, "0x"))
+	if err != nil || len(address) != 20 {
+		return nil, fmt.Errorf("invalid withdrawal address %s", withdrawalAddress)
+	}
+	credentials[0] = 0x01
+	copy(credentials[12:], address)
+
+	return credentials, nil
+}
+
+// DepositData returns signed deposit data of amount in gwei for network with genesis fork version
+func (k *ValidatorKey) DepositData(withdrawalAddress string, amount uint64, network, forkVersion string) (*DepositData, error) {
+	fork, err := hex.DecodeString(strings.TrimPrefix(forkVersion, "0x"))
+	if err != nil || len(fork) != 4 {
+		return nil, fmt.Errorf("invalid fork version %s", forkVersion)
+	}
+
+	credentials, err := k.WithdrawalCredentials(withdrawalAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amountChunk := uint64Root(amount)
+
+	// DepositMessage(pubkey, withdrawal_credentials, amount) hash tree root
+	messageRoot := merkleize(bytesRoot(k.PublicKey), credentials, amountChunk)
+
+	// deposits are valid across forks, so they're signed using genesis fork version and empty genesis validators root
+	domain := computeDomain(depositDomainType, fork, make([]byte, 32))
+	signingRoot := merkleize(messageRoot, domain)
+
+	signature, err := BLSSign(k.SecretKey, signingRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// DepositData(pubkey, withdrawal_credentials, amount, signature) hash tree root
+	dataRoot := merkleize(bytesRoot(k.PublicKey), credentials, amountChunk, bytesRoot(signature))
+
+	return &DepositData{
+		PublicKey:             hex.EncodeToString(k.PublicKey),
+		WithdrawalCredentials: hex.EncodeToString(credentials),
+		Amount:                amount,
+		Signature:             hex.EncodeToString(signature),
+		DepositMessageRoot:    hex.EncodeToString(messageRoot),
+		DepositDataRoot:       hex.EncodeToString(dataRoot),
+		ForkVersion:           hex.EncodeToString(fork),
+		NetworkName:           network,
+		DepositCLIVersion:     DepositCLIVersion,
+	}, nil
+}
+
+// computeDomain returns signature domain of domain type, fork version and genesis validators root
+func computeDomain(domainType, forkVersion, genesisValidatorsRoot []byte) []byte {
+	forkDataRoot := merkleize(bytesRoot(forkVersion), genesisValidatorsRoot)
+	return append(append([]byte{}, domainType...), forkDataRoot[:28]...)
+}
+
+// uint64Root returns SSZ hash tree root of uint64
+func uint64Root(v uint64) []byte {
+	chunk := make([]byte, 32)
+	binary.LittleEndian.PutUint64(chunk, v)
+	return chunk
+}
+
+// bytesRoot returns SSZ hash tree root of fixed size bytes
+func bytesRoot(b []byte) []byte {
+	chunks := [][]byte{}
+	for i := 0; i < len(b); i += 32 {
+		chunk := make([]byte, 32)
+		copy(chunk, b[i:])
+		chunks = append(chunks, chunk)
+	}
+	return merkleize(chunks...)
+}
+
+// merkleize returns SSZ merkle root of 32 bytes chunks padded to the next power of two
+func merkleize(chunks ...[]byte) []byte {
+	size := 1
+	for size < len(chunks) {
+		size *= 2
+	}
+
+	layer := append([][]byte{}, chunks...)
+	for len(layer) < size {
+		layer = append(layer, make([]byte, 32))
+	}
+
+	for len(layer) > 1 {
+		next := [][]byte{}
+		for i := 0; i < len(layer); i += 2 {
+			hashed := sha256.Sum256(append(append([]byte{}, layer[i]...), layer[i+1]...))
+			next = append(next, hashed[:])
+		}
+		layer = next
+	}
+
+	return layer[0]
+}
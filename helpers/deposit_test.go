@@ -0,0 +1,141 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validator keys deposit data", func() {
+
+	mnemonic := "test test test test test test test test test test test junk"
+
+	It("should derive validator keys on EIP-2334 path", func() {
+		keys, err := DeriveValidatorKeys(mnemonic, 2, 2)
+		Expect(err).To(BeNil())
+		Expect(keys).To(HaveLen(2))
+
+		seed, err := mnemonicSeed(mnemonic)
+		Expect(err).To(BeNil())
+
+		for i, key := range keys {
+			index := uint32(2 + i)
+			signingKey, err := deriveSKFromPath(seed, []uint32{12381, 3600, index, 0, 0})
+			Expect(err).To(BeNil())
+			withdrawalKey, err := deriveSKFromPath(seed, []uint32{12381, 3600, index, 0})
+			Expect(err).To(BeNil())
+
+			Expect(key.Index).To(Equal(index))
+			Expect(key.SecretKey).To(Equal(signingKey))
+			Expect(key.PublicKey).To(Equal(BLSPublicKey(signingKey)))
+			Expect(key.WithdrawalPublicKey).To(Equal(BLSPublicKey(withdrawalKey)))
+		}
+		Expect(keys[0].Path).To(Equal("m/12381/3600/2/0/0"))
+		Expect(keys[1].Path).To(Equal("m/12381/3600/3/0/0"))
+	})
+
+	It("should reject invalid mnemonic", func() {
+		_, err := DeriveValidatorKeys("test test", 0, 1)
+		Expect(err).To(MatchError("mnemonic words count must be multiple of 3"))
+	})
+
+	It("should generate deposit data with BLS withdrawal credentials", func() {
+		keys, err := DeriveValidatorKeys(mnemonic, 0, 1)
+		Expect(err).To(BeNil())
+
+		deposit, err := keys[0].DepositData("", 32000000000, "mainnet", "0x00000000")
+		Expect(err).To(BeNil())
+		Expect(deposit.PublicKey).To(Equal(hex.EncodeToString(keys[0].PublicKey)))
+		Expect(deposit.WithdrawalCredentials).To(HavePrefix("00"))
+		Expect(deposit.WithdrawalCredentials).To(HaveLen(64))
+		Expect(deposit.Amount).To(Equal(uint64(32000000000)))
+		Expect(deposit.Signature).To(HaveLen(192))
+		Expect(deposit.ForkVersion).To(Equal("00000000"))
+		Expect(deposit.NetworkName).To(Equal("mainnet"))
+		Expect(deposit.DepositCLIVersion).To(Equal(DepositCLIVersion))
+
+		// BLS signatures are deterministic
+		again, err := keys[0].DepositData("", 32000000000, "mainnet", "0x00000000")
+		Expect(err).To(BeNil())
+		Expect(again).To(Equal(deposit))
+
+		// deposit signature domain depends on network fork version
+		sepolia, err := keys[0].DepositData("", 32000000000, "sepolia", "0x90000069")
+		Expect(err).To(BeNil())
+		Expect(sepolia.DepositMessageRoot).To(Equal(deposit.DepositMessageRoot))
+		Expect(sepolia.Signature).NotTo(Equal(deposit.Signature))
+		Expect(sepolia.DepositDataRoot).NotTo(Equal(deposit.DepositDataRoot))
+	})
+
+	It("should generate deposit data with execution layer withdrawal credentials", func() {
+		keys, err := DeriveValidatorKeys(mnemonic, 0, 1)
+		Expect(err).To(BeNil())
+
+		address := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+		deposit, err := keys[0].DepositData(address, 32000000000, "mainnet", "0x00000000")
+		Expect(err).To(BeNil())
+		Expect(deposit.WithdrawalCredentials).To(Equal("010000000000000000000000" + strings.ToLower(address[2:])))
+
+		_, err = keys[0].DepositData("0x1234", 32000000000, "mainnet", "0x00000000")
+		Expect(err).To(MatchError("invalid withdrawal address 0x1234"))
+	})
+
+	It("should generate mainnet deposit data", func() {
+		keys, err := DeriveValidatorKeys(mnemonic, 0, 1)
+		Expect(err).To(BeNil())
+
+		deposit, err := keys[0].DepositData("", 32000000000, "mainnet", "0x00000000")
+		Expect(err).To(BeNil())
+
+		// expected values are pinned, roots and signing domain are checked independently below
+		Expect(deposit.PublicKey).To(Equal("a39882700ed7f72fcdbac07081b7c0c912cb8647ed8494926e6c9c2fc1a7415c7c60e3afcc3d3278fe25b50b851c3ad5"))
+		Expect(deposit.WithdrawalCredentials).To(Equal("00b96d660dfd1fe0ff58bc8d944d96b72e904da5fdcf2ff0b95e700f9e381475"))
+		Expect(deposit.Signature).To(Equal("a8831da32be45e779bad271df1fb21e91be519cfbfcb0eb99bfd3c02a94e63e44de10c94d303e1ab444abf8dd7f2412316e1ab775c46f711a201a1b4ae32bb88d82ba006ea2e5e1c1a618a71f868d76c7f7fdd86f666ca3a648863e1c7c7c96c"))
+		Expect(deposit.DepositMessageRoot).To(Equal("5c1f0066f7de3970dba2860f797f9eff5605b98ddc96e32b271dbc204b2a5936"))
+		Expect(deposit.DepositDataRoot).To(Equal("4a83c56b2b8d6f0b5e69f6aa705fc4cf2026a31a0a34696bcb7d77803e570d99"))
+
+		hash := func(chunks ...[]byte) []byte {
+			h := sha256.New()
+			for _, chunk := range chunks {
+				h.Write(chunk)
+			}
+			return h.Sum(nil)
+		}
+		decode := func(s string) []byte {
+			b, err := hex.DecodeString(s)
+			Expect(err).To(BeNil())
+			return b
+		}
+
+		zero := make([]byte, 32)
+		publicKey := decode(deposit.PublicKey)
+		credentials := decode(deposit.WithdrawalCredentials)
+		signature := decode(deposit.Signature)
+		amount := make([]byte, 32)
+		binary.LittleEndian.PutUint64(amount, deposit.Amount)
+
+		// pubkey is 2 chunks, signature is 3 chunks padded to 4
+		publicKeyRoot := hash(publicKey[:32], append(append([]byte{}, publicKey[32:]...), make([]byte, 16)...))
+		signatureRoot := hash(hash(signature[:64]), hash(signature[64:], zero))
+
+		Expect(hex.EncodeToString(hash(hash(publicKeyRoot, credentials), hash(amount, zero)))).To(Equal(deposit.DepositMessageRoot))
+		Expect(hex.EncodeToString(hash(hash(publicKeyRoot, credentials), hash(amount, signatureRoot)))).To(Equal(deposit.DepositDataRoot))
+
+		// mainnet DOMAIN_DEPOSIT with genesis fork version and empty genesis validators root
+		domain := computeDomain(depositDomainType, decode(deposit.ForkVersion), zero)
+		Expect(hex.EncodeToString(domain)).To(Equal("03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9"))
+	})
+
+	It("should compute SSZ merkle root of padded chunks", func() {
+		a, b, c := make([]byte, 32), make([]byte, 32), make([]byte, 32)
+		a[0], b[0], c[0] = 1, 2, 3
+		Expect(merkleize(a, b, c)).To(Equal(merkleize(merkleize(a, b), merkleize(c, make([]byte, 32)))))
+		Expect(merkleize(a)).To(Equal(a))
+		Expect(bytesRoot([]byte{1, 2, 3, 4})).To(Equal(append([]byte{1, 2, 3, 4}, make([]byte, 28)...)))
+	})
+
+})
@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// deriveMasterSK derives EIP-2333 BLS master secret key from seed
+// https://eips.ethereum.org/EIPS/eip-2333
+func deriveMasterSK(seed []byte) (*big.Int, error) {
+	if len(seed) < 32 {
+		return nil, errors.New("seed must be at least 32 bytes")
+	}
+	return hkdfModR(seed)
+}
+
+// deriveChildSK derives EIP-2333 BLS child secret key of parent secret key at index
+func deriveChildSK(parentSK *big.Int, index uint32) (*big.Int, error) {
+	lamportPK, err := parentSKToLamportPK(parentSK, index)
+	if err != nil {
+		return nil, err
+	}
+	return hkdfModR(lamportPK)
+}
+
+// deriveSKFromPath derives BLS secret key of EIP-2334 path indices from seed
+func deriveSKFromPath(seed []byte, path []uint32) (sk *big.Int, err error) {
+	if sk, err = deriveMasterSK(seed); err != nil {
+		return
+	}
+	for _, index := range path {
+		if sk, err = deriveChildSK(sk, index); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// hkdfModR derives BLS secret key from input key material
+func hkdfModR(ikm []byte) (*big.Int, error) {
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	sk := new(big.Int)
+
+	for sk.Sign() == 0 {
+		hashed := sha256.Sum256(salt)
+		salt = hashed[:]
+
+		prk := hkdf.Extract(sha256.New, append(append([]byte{}, ikm...), 0), salt)
+		okm := make([]byte, 48)
+		// key_info is empty, and L is 48 encoded in 2 bytes
+		if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte{0, 48}), okm); err != nil {
+			return nil, err
+		}
+
+		sk.SetBytes(okm).Mod(sk, blsCurveOrder)
+	}
+
+	return sk, nil
+}
+
+// parentSKToLamportPK returns compressed lamport public key of parent secret key at index
+func parentSKToLamportPK(parentSK *big.Int, index uint32) ([]byte, error) {
+	salt := binary.BigEndian.AppendUint32(nil, index)
+
+	ikm := make([]byte, 32)
+	parentSK.FillBytes(ikm)
+	notIKM := make([]byte, 32)
+	for i := range ikm {
+		notIKM[i] = ^ikm[i]
+	}
+
+	lamport0, err := ikmToLamportSK(ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	lamport1, err := ikmToLamportSK(notIKM, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	lamportPK := sha256.New()
+	for _, chunk := range append(lamport0, lamport1...) {
+		hashed := sha256.Sum256(chunk)
+		lamportPK.Write(hashed[:])
+	}
+
+	return lamportPK.Sum(nil), nil
+}
+
+// ikmToLamportSK returns 255 lamport secret key chunks of input key material
+func ikmToLamportSK(ikm, salt []byte) ([][]byte, error) {
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	okm := make([]byte, 255*32)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, nil), okm); err != nil {
+		return nil, err
+	}
+
+	chunks := make([][]byte, 255)
+	for i := range chunks {
+		chunks[i] = okm[i*32 : (i+1)*32]
+	}
+	return chunks, nil
+}
@@ -0,0 +1,56 @@
+package helpers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EIP-2333 BLS key derivation", func() {
+
+	// test vectors from https://eips.ethereum.org/EIPS/eip-2333#test-cases
+	cases := []struct {
+		seed       string
+		masterSK   string
+		childIndex uint32
+		childSK    string
+	}{
+		{
+			seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			masterSK:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			childIndex: 0,
+			childSK:    "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			seed:       "3141592653589793238462643383279502884197169399375105820974944592",
+			masterSK:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			childIndex: 3141592653,
+			childSK:    "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		It(fmt.Sprintf("should derive master and child secret keys of test case %d", i), func() {
+			seed, err := hex.DecodeString(c.seed)
+			Expect(err).To(BeNil())
+
+			masterSK, err := deriveMasterSK(seed)
+			Expect(err).To(BeNil())
+			Expect(masterSK.String()).To(Equal(c.masterSK))
+
+			childSK, err := deriveChildSK(masterSK, c.childIndex)
+			Expect(err).To(BeNil())
+			Expect(childSK.String()).To(Equal(c.childSK))
+		})
+	}
+
+	It("should reject short seeds", func() {
+		_, err := deriveMasterSK(big.NewInt(1).Bytes())
+		Expect(err).To(MatchError("seed must be at least 32 bytes"))
+	})
+
+})
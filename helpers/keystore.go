@@ -0,0 +1,194 @@
+package helpers
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// keystorePBKDF2Iterations is PBKDF2 iterations count used to encrypt keystores
+const keystorePBKDF2Iterations = 262144
+
+// BLSKeystore is EIP-2335 BLS12-381 secret key keystore
+// https://eips.ethereum.org/EIPS/eip-2335
+type BLSKeystore struct {
+	Crypto      BLSKeystoreCrypto `json:"crypto"`
+	Description string            `json:"description"`
+	PublicKey   string            `json:"pubkey"`
+	Path        string            `json:"path"`
+	UUID        string            `json:"uuid"`
+	Version     int               `json:"version"`
+}
+
+// BLSKeystoreCrypto is keystore key derivation, checksum and cipher modules
+type BLSKeystoreCrypto struct {
+	KDF      BLSKeystoreModule `json:"kdf"`
+	Checksum BLSKeystoreModule `json:"checksum"`
+	Cipher   BLSKeystoreModule `json:"cipher"`
+}
+
+// BLSKeystoreModule is keystore crypto module
+type BLSKeystoreModule struct {
+	Function string                 `json:"function"`
+	Params   map[string]interface{} `json:"params"`
+	Message  string                 `json:"message"`
+}
+
+// EncryptBLSKeystore encrypts secret key of EIP-2334 path into keystore using password
+// keystore is encrypted using pbkdf2 key derivation and aes-128-ctr cipher
+func EncryptBLSKeystore(secretKey *big.Int, path, password string) (*BLSKeystore, error) {
+	salt := make([]byte, 32)
+	iv := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	key := pbkdf2.Key(processPassword(password), salt, keystorePBKDF2Iterations, 32, sha256.New)
+
+	secret := make([]byte, 32)
+	secretKey.FillBytes(secret)
+	cipherText, err := aes128CTR(key[:16], iv, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(append(append([]byte{}, key[16:32]...), cipherText...))
+
+	return &BLSKeystore{
+		Crypto: BLSKeystoreCrypto{
+			KDF: BLSKeystoreModule{
+				Function: "pbkdf2",
+				Params: map[string]interface{}{
+					"dklen": 32,
+					"c":     keystorePBKDF2Iterations,
+					"prf":   "hmac-sha256",
+					"salt":  hex.EncodeToString(salt),
+				},
+			},
+			Checksum: BLSKeystoreModule{
+				Function: "sha256",
+				Params:   map[string]interface{}{},
+				Message:  hex.EncodeToString(checksum[:]),
+			},
+			Cipher: BLSKeystoreModule{
+				Function: "aes-128-ctr",
+				Params: map[string]interface{}{
+					"iv": hex.EncodeToString(iv),
+				},
+				Message: hex.EncodeToString(cipherText),
+			},
+		},
+		PublicKey: hex.EncodeToString(BLSPublicKey(secretKey)),
+		Path:      path,
+		UUID:      string(uuid.NewUUID()),
+		Version:   4,
+	}, nil
+}
+
+// Decrypt decrypts keystore secret key using password
+func (k *BLSKeystore) Decrypt(password string) (*big.Int, error) {
+	key, err := k.decryptionKey(password)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) < 32 {
+		return nil, errors.New("keystore decryption key must be at least 32 bytes")
+	}
+
+	cipherText, err := hex.DecodeString(k.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(append(append([]byte{}, key[16:32]...), cipherText...))
+	if hex.EncodeToString(checksum[:]) != k.Crypto.Checksum.Message {
+		return nil, errors.New("invalid keystore password")
+	}
+
+	if k.Crypto.Cipher.Function != "aes-128-ctr" {
+		return nil, fmt.Errorf("unsupported keystore cipher %s", k.Crypto.Cipher.Function)
+	}
+
+	iv, err := hex.DecodeString(fmt.Sprintf("%v", k.Crypto.Cipher.Params["iv"]))
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := aes128CTR(key[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(secret), nil
+}
+
+// decryptionKey derives keystore decryption key from password
+func (k *BLSKeystore) decryptionKey(password string) ([]byte, error) {
+	params := k.Crypto.KDF.Params
+
+	salt, err := hex.DecodeString(fmt.Sprintf("%v", params["salt"]))
+	if err != nil {
+		return nil, err
+	}
+
+	// json numbers are decoded as float64
+	param := func(name string) int {
+		switch v := params[name].(type) {
+		case float64:
+			return int(v)
+		case int:
+			return v
+		}
+		return 0
+	}
+
+	switch k.Crypto.KDF.Function {
+	case "pbkdf2":
+		if params["prf"] != "hmac-sha256" {
+			return nil, fmt.Errorf("unsupported keystore prf %v", params["prf"])
+		}
+		return pbkdf2.Key(processPassword(password), salt, param("c"), param("dklen"), sha256.New), nil
+	case "scrypt":
+		return scrypt.Key(processPassword(password), salt, param("n"), param("r"), param("p"), param("dklen"))
+	default:
+		return nil, fmt.Errorf("unsupported keystore kdf %s", k.Crypto.KDF.Function)
+	}
+}
+
+// processPassword returns password bytes used by key derivation functions
+// password is NFKD normalized, then C0, C1 control codes and delete are stripped
+// https://eips.ethereum.org/EIPS/eip-2335#password-requirements
+func processPassword(password string) []byte {
+	normalized := norm.NFKD.String(password)
+	return []byte(strings.Map(func(r rune) rune {
+		if r <= 0x1f || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, normalized))
+}
+
+// aes128CTR encrypts or decrypts input using aes-128-ctr cipher
+func aes128CTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
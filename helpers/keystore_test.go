@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EIP-2335 BLS keystore", func() {
+
+	secretKey, _ := new(big.Int).SetString("000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", 16)
+
+	It("should decrypt pbkdf2 test vector keystore", func() {
+		// test vector from https://eips.ethereum.org/EIPS/eip-2335#test-cases
+		content := `{
+			"crypto": {
+				"kdf": {
+					"function": "pbkdf2",
+					"params": {
+						"dklen": 32,
+						"c": 262144,
+						"prf": "hmac-sha256",
+						"salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+					},
+					"message": ""
+				},
+				"checksum": {
+					"function": "sha256",
+					"params": {},
+					"message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+				},
+				"cipher": {
+					"function": "aes-128-ctr",
+					"params": {
+						"iv": "264daa3f303d7259501c93d997d84fe6"
+					},
+					"message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+				}
+			},
+			"description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+			"pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+			"path": "m/12381/60/0/0",
+			"uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+			"version": 4
+		}`
+
+		keystore := &BLSKeystore{}
+		Expect(json.Unmarshal([]byte(content), keystore)).To(Succeed())
+
+		decrypted, err := keystore.Decrypt("𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑")
+		Expect(err).To(BeNil())
+		Expect(decrypted).To(Equal(secretKey))
+		Expect(keystore.PublicKey).To(Equal(fmt.Sprintf("%x", BLSPublicKey(decrypted))))
+
+		// password secrets created from files usually end with new line
+		decrypted, err = keystore.Decrypt("𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑\n")
+		Expect(err).To(BeNil())
+		Expect(decrypted).To(Equal(secretKey))
+	})
+
+	It("should process keystore password", func() {
+		Expect(processPassword("𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑")).To(Equal([]byte("testpassword🔑")))
+		Expect(processPassword("secret\r\n")).To(Equal([]byte("secret")))
+		Expect(processPassword("\x00se\x7fcr\u0085et\x1f")).To(Equal([]byte("secret")))
+	})
+
+	It("should encrypt and decrypt keystore", func() {
+		keystore, err := EncryptBLSKeystore(secretKey, "m/12381/3600/0/0/0", "secret")
+		Expect(err).To(BeNil())
+		Expect(keystore.Version).To(Equal(4))
+		Expect(keystore.Path).To(Equal("m/12381/3600/0/0/0"))
+		Expect(keystore.PublicKey).To(Equal("9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07"))
+
+		// keystore is decrypted after json encoding
+		content, err := json.Marshal(keystore)
+		Expect(err).To(BeNil())
+		decoded := &BLSKeystore{}
+		Expect(json.Unmarshal(content, decoded)).To(Succeed())
+
+		decrypted, err := decoded.Decrypt("secret")
+		Expect(err).To(BeNil())
+		Expect(decrypted).To(Equal(secretKey))
+
+		_, err = decoded.Decrypt("wrong")
+		Expect(err).To(MatchError("invalid keystore password"))
+	})
+
+})
@@ -0,0 +1,109 @@
+package helpers
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// hardenedOffset is BIP-32 hardened child keys index offset
+const hardenedOffset uint32 = 0x80000000
+
+// ethereumPath is BIP-44 ethereum accounts derivation path m/44'/60'/0'/0
+var ethereumPath = []uint32{44 + hardenedOffset, 60 + hardenedOffset, 0 + hardenedOffset, 0}
+
+// extendedKey is BIP-32 extended private key
+type extendedKey struct {
+	key       *big.Int
+	chainCode []byte
+}
+
+// child derives BIP-32 child private key
+func (k *extendedKey) child(index uint32) (*extendedKey, error) {
+	var data []byte
+
+	if index >= hardenedOffset {
+		data = append([]byte{0}, math.PaddedBigBytes(k.key, 32)...)
+	} else {
+		privateKey, err := crypto.ToECDSA(math.PaddedBigBytes(k.key, 32))
+		if err != nil {
+			return nil, err
+		}
+		data = crypto.CompressPubkey(&privateKey.PublicKey)
+	}
+
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	tweak := new(big.Int).SetBytes(sum[:32])
+	if tweak.Cmp(n) >= 0 {
+		return nil, errors.New("invalid child key")
+	}
+
+	key := tweak.Add(tweak, k.key)
+	key.Mod(key, n)
+	if key.Sign() == 0 {
+		return nil, errors.New("invalid child key")
+	}
+
+	return &extendedKey{key: key, chainCode: sum[32:]}, nil
+}
+
+// mnemonicSeed returns BIP-39 seed of mnemonic without passphrase
+// mnemonic words must be from BIP-39 english wordlist, and the last word must have valid checksum
+func mnemonicSeed(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) == 0 || len(words)%3 != 0 {
+		return nil, errors.New("mnemonic words count must be multiple of 3")
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(strings.Join(words, " "), "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+
+	return seed, nil
+}
+
+// DeriveMnemonicKeys derives hex private keys of count accounts from BIP-39 mnemonic
+// accounts are derived using BIP-44 ethereum derivation path m/44'/60'/0'/0/i
+func DeriveMnemonicKeys(mnemonic string, count uint) (privateKeys []string, err error) {
+	seed, err := mnemonicSeed(mnemonic)
+	if err != nil {
+		return
+	}
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	account := &extendedKey{key: new(big.Int).SetBytes(sum[:32]), chainCode: sum[32:]}
+	for _, index := range ethereumPath {
+		if account, err = account.child(index); err != nil {
+			return
+		}
+	}
+
+	for i := uint32(0); i < uint32(count); i++ {
+		var key *extendedKey
+		if key, err = account.child(i); err != nil {
+			return
+		}
+		privateKeys = append(privateKeys, hex.EncodeToString(math.PaddedBigBytes(key.key, 32)))
+	}
+
+	return
+}
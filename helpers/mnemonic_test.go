@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BIP-39 mnemonic accounts", func() {
+
+	// hardhat and anvil default development accounts
+	mnemonic := "test test test test test test test test test test test junk"
+
+	accounts := []struct {
+		address    string
+		privateKey string
+	}{
+		{
+			address:    "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			privateKey: "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		},
+		{
+			address:    "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+			privateKey: "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d",
+		},
+		{
+			address:    "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+			privateKey: "5de4111afa1a4b94908f83103eb1f1706367c2e68ca870fc3fb9a804cdab365a",
+		},
+		{
+			address:    "0x90F79bf6EB2c4f870365E785982E1f101E93b906",
+			privateKey: "7c852118294e51e653712a81e05800f419141751be58f605c371e15141b007a6",
+		},
+		{
+			address:    "0x15d34AAf54267DB7D7c367839AAf71A00a2C6A65",
+			privateKey: "47e179ec197488593b187f80a00eb0da91f1b9d0b13f8733639f19c30a34926a",
+		},
+	}
+
+	It("should derive hardhat and anvil development accounts", func() {
+		keys, err := DeriveMnemonicKeys(mnemonic, uint(len(accounts)))
+		Expect(err).To(BeNil())
+		Expect(keys).To(HaveLen(len(accounts)))
+
+		for i, account := range accounts {
+			Expect(keys[i]).To(Equal(account.privateKey))
+
+			privateKey, err := crypto.HexToECDSA(keys[i])
+			Expect(err).To(BeNil())
+			Expect(crypto.PubkeyToAddress(privateKey.PublicKey).Hex()).To(Equal(account.address))
+		}
+	})
+
+	It("should reject mnemonic with words not in english wordlist", func() {
+		_, err := DeriveMnemonicKeys("test test test test test test test test test test test kotal", 1)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should reject mnemonic with invalid checksum", func() {
+		_, err := DeriveMnemonicKeys("test test test test test test test test test test test test", 1)
+		Expect(err).NotTo(BeNil())
+	})
+
+})
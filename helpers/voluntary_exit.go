@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// voluntaryExitDomainType is DOMAIN_VOLUNTARY_EXIT domain type
+var voluntaryExitDomainType = []byte{0x04, 0x00, 0x00, 0x00}
+
+// VoluntaryExitSigningRoot returns signing root of voluntary exit of validator index at epoch
+// fork version is capella fork version, voluntary exits signed with it are valid forever as per EIP-7044
+func VoluntaryExitSigningRoot(epoch, validatorIndex uint64, forkVersion, genesisValidatorsRoot string) ([]byte, error) {
+	fork, err := hex.DecodeString(strings.TrimPrefix(forkVersion, "0x"))
+	if err != nil || len(fork) != 4 {
+		return nil, fmt.Errorf("invalid fork version %s", forkVersion)
+	}
+
+	root, err := hex.DecodeString(strings.TrimPrefix(genesisValidatorsRoot, "0x"))
+	if err != nil || len(root) != 32 {
+		return nil, fmt.Errorf("invalid genesis validators root %s", genesisValidatorsRoot)
+	}
+
+	// VoluntaryExit(epoch, validator_index) hash tree root
+	messageRoot := merkleize(uint64Root(epoch), uint64Root(validatorIndex))
+
+	domain := computeDomain(voluntaryExitDomainType, fork, root)
+
+	return merkleize(messageRoot, domain), nil
+}
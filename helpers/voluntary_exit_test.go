@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validator voluntary exit", func() {
+
+	// mainnet genesis validators root and capella fork version
+	genesisValidatorsRoot := "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+	capellaForkVersion := "0x03000000"
+
+	hash := func(a, b []byte) []byte {
+		hashed := sha256.Sum256(append(append([]byte{}, a...), b...))
+		return hashed[:]
+	}
+
+	It("should compute voluntary exit signing root", func() {
+		root, err := VoluntaryExitSigningRoot(194048, 123, capellaForkVersion, genesisValidatorsRoot)
+		Expect(err).To(BeNil())
+
+		epoch := make([]byte, 32)
+		binary.LittleEndian.PutUint64(epoch, 194048)
+		index := make([]byte, 32)
+		binary.LittleEndian.PutUint64(index, 123)
+		messageRoot := hash(epoch, index)
+
+		version := make([]byte, 32)
+		version[0] = 0x03
+		gvr, _ := hex.DecodeString(genesisValidatorsRoot[2:])
+		forkDataRoot := hash(version, gvr)
+		domain := append([]byte{0x04, 0x00, 0x00, 0x00}, forkDataRoot[:28]...)
+
+		Expect(root).To(Equal(hash(messageRoot, domain)))
+	})
+
+	It("should reject invalid fork version", func() {
+		_, err := VoluntaryExitSigningRoot(0, 0, "0x0300", genesisValidatorsRoot)
+		Expect(err).To(MatchError("invalid fork version 0x0300"))
+	})
+
+	It("should reject invalid genesis validators root", func() {
+		_, err := VoluntaryExitSigningRoot(0, 0, capellaForkVersion, "0x4b36")
+		Expect(err).To(MatchError("invalid genesis validators root 0x4b36"))
+	})
+
+})
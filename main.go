@@ -118,6 +118,48 @@ func main() {
 		}
 	}
 
+	if err = (&ethereumcontroller.TransactionManagerReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TransactionManager")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = (&ethereumv1alpha1.TransactionManager{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "TransactionManager")
+			os.Exit(1)
+		}
+	}
+
+	if err = (&ethereumcontroller.ValidatorProposalReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ValidatorProposal")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = (&ethereumv1alpha1.ValidatorProposal{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ValidatorProposal")
+			os.Exit(1)
+		}
+	}
+
+	if err = (&ethereumcontroller.NetworkReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Network")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = (&ethereumv1alpha1.Network{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Network")
+			os.Exit(1)
+		}
+	}
+
 	if err = (&ethereum2controller.BeaconNodeReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -146,6 +188,62 @@ func main() {
 		}
 	}
 
+	if err = (&ethereum2controller.RemoteSignerReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RemoteSigner")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = (&ethereum2v1alpha1.RemoteSigner{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "RemoteSigner")
+			os.Exit(1)
+		}
+	}
+
+	if err = (&ethereum2controller.ValidatorKeysReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ValidatorKeys")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = (&ethereum2v1alpha1.ValidatorKeys{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ValidatorKeys")
+			os.Exit(1)
+		}
+	}
+
+	if err = (&ethereum2controller.VoluntaryExitReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VoluntaryExit")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = (&ethereum2v1alpha1.VoluntaryExit{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "VoluntaryExit")
+			os.Exit(1)
+		}
+	}
+
+	if err = (&ethereum2controller.MEVBoostReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MEVBoost")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = (&ethereum2v1alpha1.MEVBoost{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "MEVBoost")
+			os.Exit(1)
+		}
+	}
+
 	if err = (&ipfscontroller.PeerReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),